@@ -58,4 +58,14 @@ const (
 	DESC_ATTR         = "desc"
 	PRIORITY_ATTR     = "priority"
 	TAGS_ATTR         = "tags"
+	CORRELATED_ATTR   = "correlated"
+	COUNT_ATTR        = "count"
+	SUPPRESSED_ATTR   = "suppressed"
+	OUTPUT_ATTR       = "output"
+	HASHES_ATTR       = "hashes"
+	PROC_HASH_ATTR    = "proc"
+	FILE_HASH_ATTR    = "file"
+	MD5_ATTR          = "md5"
+	SHA1_ATTR         = "sha1"
+	SHA256_ATTR       = "sha256"
 )
@@ -24,7 +24,6 @@
 package encoders
 
 import (
-	"encoding/binary"
 	"fmt"
 	"net"
 	"path"
@@ -32,7 +31,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/cespare/xxhash/v2"
 	"github.com/satta/gommunityid"
 	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
 	"github.com/sysflow-telemetry/sf-processor/core/exporter/commons"
@@ -70,6 +68,7 @@ type ECSRecord struct {
 	Process      JSONData   `json:"process,omitempty"`
 	User         JSONData   `json:"user,omitempty"`
 	Tags         []string   `json:"tags,omitempty"`
+	Message      string     `json:"message,omitempty"`
 }
 
 // ECSEncoder implements an ECS encoder for telemetry records.
@@ -104,7 +103,7 @@ func (t *ECSEncoder) Encode(recs []*flatrecord.Record) ([]commons.EncodedData, e
 // Encodes a telemetry record into an ECS representation.
 func (t *ECSEncoder) encode(rec *flatrecord.Record) *ECSRecord {
 	ecs := &ECSRecord{
-		ID:   encodeID(rec),
+		ID:   rec.ID(),
 		Host: encodeHost(rec),
 	}
 	ecs.Agent.Version = t.config.Version
@@ -139,19 +138,47 @@ func (t *ECSEncoder) encode(rec *flatrecord.Record) *ECSRecord {
 		ecs.encodeK8sEvent(rec)
 	}
 
+	// encode hashes computed by policy actions
+	if hs := rec.Ctx.GetHash(flatrecord.HASH_TYPE_PROC); hs != nil && ecs.Process != nil {
+		ecs.Process[ECS_HASH] = encodeHash(hs)
+	}
+	if hs := rec.Ctx.GetHash(flatrecord.HASH_TYPE_FILE); hs != nil && ecs.File != nil {
+		ecs.File[ECS_HASH] = encodeHash(hs)
+	}
+
 	// encode tags and policy information
 	tags := rec.Ctx.GetTags()
 	rules := rec.Ctx.GetRules()
 	if len(rules) > 0 {
 		reasons := make([]string, 0)
+		outputs := make([]string, 0)
 		priority := int(policy.Low)
+		count := 0
+		suppressed := 0
 		for _, r := range rules {
 			reasons = append(reasons, r.Name)
+			if output := rec.Ctx.GetOutput(r.Name); output != "" {
+				outputs = append(outputs, output)
+			}
 			tags = append(tags, extracTags(r.Tags)...)
-			priority = utils.Max(priority, int(r.Priority))
+			priority = utils.Max(priority, int(rec.Ctx.GetPriority(r)))
+			count = utils.Max(count, rec.Ctx.GetCount(r.Name))
+			suppressed += rec.Ctx.GetSuppressed(r.Name)
 		}
 		ecs.Event[ECS_EVENT_REASON] = strings.Join(reasons, ", ")
 		ecs.Event[ECS_EVENT_SEVERITY] = priority
+		if len(outputs) > 0 {
+			ecs.Message = strings.Join(outputs, "\n")
+		}
+		if ids := rec.Ctx.GetCorrelatedIDs(); len(ids) > 0 {
+			ecs.Event[ECS_EVENT_SFCORR] = ids
+		}
+		if count > 0 {
+			ecs.Event[ECS_EVENT_SFCOUNT] = count
+		}
+		if suppressed > 0 {
+			ecs.Event[ECS_EVENT_SFSUPP] = suppressed
+		}
 	}
 	if len(tags) > 0 {
 		ecs.Tags = tags
@@ -160,46 +187,6 @@ func (t *ECSEncoder) encode(rec *flatrecord.Record) *ECSRecord {
 	return ecs
 }
 
-var byteInt64 []byte = make([]byte, 8)
-
-// encodeID returns the ECS document identifier.
-func encodeID(rec *flatrecord.Record) string {
-	h := xxhash.New()
-	t := flatrecord.Mapper.MapStr(flatrecord.SF_TYPE)(rec)
-	h.Write([]byte(flatrecord.Mapper.MapStr(flatrecord.SF_NODE_ID)(rec)))
-	h.Write([]byte(flatrecord.Mapper.MapStr(flatrecord.SF_CONTAINER_ID)(rec)))
-	binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.TS_INT, sfgo.SYSFLOW_SRC)))
-	h.Write(byteInt64)
-	binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.TID_INT, sfgo.SYSFLOW_SRC)))
-	h.Write(byteInt64)
-	binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.PROC_OID_CREATETS_INT, sfgo.SYSFLOW_SRC)))
-	h.Write(byteInt64)
-	binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.EV_PROC_OPFLAGS_INT, sfgo.SYSFLOW_SRC)))
-	h.Write(byteInt64)
-	switch t {
-	case sfgo.TyFFStr, sfgo.TyFEStr:
-		h.Write([]byte(flatrecord.Mapper.MapStr(flatrecord.SF_FILE_OID)(rec)))
-	case sfgo.TyNFStr:
-		binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.FL_NETW_SIP_INT, sfgo.SYSFLOW_SRC)))
-		h.Write(byteInt64)
-		binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.FL_NETW_SPORT_INT, sfgo.SYSFLOW_SRC)))
-		h.Write(byteInt64)
-		binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.FL_NETW_DIP_INT, sfgo.SYSFLOW_SRC)))
-		h.Write(byteInt64)
-		binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.FL_NETW_DPORT_INT, sfgo.SYSFLOW_SRC)))
-		h.Write(byteInt64)
-		binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.FL_NETW_PROTO_INT, sfgo.SYSFLOW_SRC)))
-		h.Write(byteInt64)
-	case sfgo.TyKEStr:
-		binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.K8SE_ACTION_INT, sfgo.SYSFLOW_SRC)))
-		h.Write(byteInt64)
-		binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.K8SE_KIND_INT, sfgo.SYSFLOW_SRC)))
-		h.Write(byteInt64)
-		h.Write([]byte(flatrecord.Mapper.MapStr(flatrecord.SF_K8SE_MESSAGE)(rec)))
-	}
-	return fmt.Sprintf("%x", h.Sum(nil))
-}
-
 // encodeNetworkFlow populates the ECS representatiom of a NetworkFlow record.
 func (ecs *ECSRecord) encodeNetworkFlow(rec *flatrecord.Record) {
 	rbytes := flatrecord.Mapper.MapInt(flatrecord.SF_FLOW_RBYTES)(rec)
@@ -562,6 +549,21 @@ func encodeFile(rec *flatrecord.Record) JSONData {
 	return file
 }
 
+// encodeHash creates an ECS hash field.
+func encodeHash(hs *flatrecord.HashSet) JSONData {
+	h := JSONData{}
+	if hs.Md5 != "" {
+		h[ECS_HASH_MD5] = hs.Md5
+	}
+	if hs.Sha1 != "" {
+		h[ECS_HASH_SHA1] = hs.Sha1
+	}
+	if hs.Sha256 != "" {
+		h[ECS_HASH_SHA256] = hs.Sha256
+	}
+	return h
+}
+
 func encodeFileType(ft string) string {
 	var fileType string
 	switch ft {
@@ -52,6 +52,9 @@ const (
 	ECS_EVENT_SFRET    = "sf_ret"
 	ECS_EVENT_REASON   = "reason"
 	ECS_EVENT_SEVERITY = "severity"
+	ECS_EVENT_SFCORR   = "sf_correlated"
+	ECS_EVENT_SFCOUNT  = "sf_count"
+	ECS_EVENT_SFSUPP   = "sf_suppressed"
 
 	ECS_FILE_DIR    = "directory"
 	ECS_FILE_NAME   = "name"
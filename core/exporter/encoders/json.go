@@ -299,7 +299,19 @@ func (t *JSONEncoder) encode(rec *flatrecord.Record) (commons.EncodedData, error
 			t.writer.RawString(DESC)
 			t.writer.String(r.Desc)
 			t.writer.RawString(PRIORITY)
-			t.writer.Int64(int64(r.Priority))
+			t.writer.Int64(int64(rec.Ctx.GetPriority(r)))
+			if output := rec.Ctx.GetOutput(r.Name); output != "" {
+				t.writer.RawString(OUTPUT)
+				t.writer.String(output)
+			}
+			if count := rec.Ctx.GetCount(r.Name); count > 0 {
+				t.writer.RawString(COUNT)
+				t.writer.Int64(int64(count))
+			}
+			if suppressed := rec.Ctx.GetSuppressed(r.Name); suppressed > 0 {
+				t.writer.RawString(SUPPRESSED)
+				t.writer.Int64(int64(suppressed))
+			}
 			t.writer.RawByte(END_CURLY)
 			if num < (numRules - 1) {
 				t.writer.RawByte(COMMA)
@@ -317,6 +329,34 @@ func (t *JSONEncoder) encode(rec *flatrecord.Record) (commons.EncodedData, error
 		t.writer.RawByte(END_SQUARE)
 	}
 
+	// Encode hashes computed by policy actions
+	phs, fhs := rec.Ctx.GetHash(flatrecord.HASH_TYPE_PROC), rec.Ctx.GetHash(flatrecord.HASH_TYPE_FILE)
+	if phs != nil || fhs != nil {
+		t.writer.RawString(HASHES)
+		if phs != nil {
+			t.writeHashSet(PROC_HASH_ATTR, phs)
+		}
+		if fhs != nil {
+			if phs != nil {
+				t.writer.RawByte(COMMA)
+			}
+			t.writeHashSet(FILE_HASH_ATTR, fhs)
+		}
+		t.writer.RawByte(END_CURLY)
+	}
+
+	// Encode identifiers of correlated records
+	if ids := rec.Ctx.GetCorrelatedIDs(); len(ids) > 0 {
+		t.writer.RawString(CORRELATED)
+		for i, id := range ids {
+			if i > 0 {
+				t.writer.RawByte(COMMA)
+			}
+			t.writer.String(id)
+		}
+		t.writer.RawByte(END_SQUARE)
+	}
+
 	// Encode tags as a list of record tag context plus all rule tags
 	numTags := len(rtags) + len(rec.Ctx.GetTags())
 	if numTags > 0 {
@@ -357,6 +397,28 @@ func (t *JSONEncoder) writeAttribute(fv *flatrecord.FieldValue, fieldID int, rec
 	MapJSON(fv, t.writer, rec)
 }
 
+func (t *JSONEncoder) writeHashSet(name string, hs *flatrecord.HashSet) {
+	t.writer.RawByte(DOUBLE_QUOTE)
+	t.writer.RawString(name)
+	t.writer.RawString(QUOTE_COLON)
+	t.writer.RawByte(BEGIN_CURLY)
+	n := 0
+	for _, h := range [...][2]string{{MD5_ATTR, hs.Md5}, {SHA1_ATTR, hs.Sha1}, {SHA256_ATTR, hs.Sha256}} {
+		if h[1] == "" {
+			continue
+		}
+		if n > 0 {
+			t.writer.RawByte(COMMA)
+		}
+		t.writer.RawByte(DOUBLE_QUOTE)
+		t.writer.RawString(h[0])
+		t.writer.RawString(QUOTE_COLON)
+		t.writer.String(h[1])
+		n++
+	}
+	t.writer.RawByte(END_CURLY)
+}
+
 func (t *JSONEncoder) writeSectionBegin(section string) {
 	t.writer.RawByte(DOUBLE_QUOTE)
 	t.writer.RawString(section)
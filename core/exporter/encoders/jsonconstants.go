@@ -47,6 +47,11 @@ const (
 	DESC              = ",\"" + DESC_ATTR + "\":"
 	PRIORITY          = ",\"" + PRIORITY_ATTR + "\":"
 	TAGS              = ",\"" + TAGS_ATTR + "\":["
+	CORRELATED        = ",\"" + CORRELATED_ATTR + "\":["
+	COUNT             = ",\"" + COUNT_ATTR + "\":"
+	SUPPRESSED        = ",\"" + SUPPRESSED_ATTR + "\":"
+	OUTPUT            = ",\"" + OUTPUT_ATTR + "\":"
+	HASHES            = ",\"" + HASHES_ATTR + "\":{"
 	PERIOD            = '.'
 	EMPTY_STRING      = "\"\""
 )
@@ -38,8 +38,10 @@ require (
 	github.com/sysflow-telemetry/sf-apis/go v0.0.0-20240224034728-382270fa878c
 	github.com/tidwall/gjson v1.14.1
 	go.opentelemetry.io/proto/otlp v0.19.0
+	go.starlark.net v0.0.0-20231121155337-90ade8b19d09
 	golang.org/x/exp v0.0.0-20230206171751-46f607a40771
 	google.golang.org/protobuf v1.33.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -54,5 +56,4 @@ require (
 	github.com/tidwall/pretty v1.2.0 // indirect
 	golang.org/x/net v0.21.0 // indirect
 	golang.org/x/sys v0.17.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
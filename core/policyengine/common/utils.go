@@ -1,5 +1,11 @@
 package common
 
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
 func TrimBoundingQuotes(s string) string {
 	if len(s) > 0 && (s[0] == '"' || s[0] == '\'') {
 		s = s[1:]
@@ -9,3 +15,20 @@ func TrimBoundingQuotes(s string) string {
 	}
 	return s
 }
+
+// ParseDuration parses a time window such as 30s, 5m, 1h30m or 2d.
+// A bare number denotes seconds.
+func ParseDuration(s string) (time.Duration, error) {
+	s = TrimBoundingQuotes(strings.TrimSpace(s))
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		return time.Duration(n * float64(time.Second)), nil
+	}
+	if strings.HasSuffix(s, "d") {
+		n, err := strconv.ParseFloat(strings.TrimSuffix(s, "d"), 64)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n * float64(24*time.Hour)), nil
+	}
+	return time.ParseDuration(s)
+}
@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policyengine implements a plugin for a rules engine for telemetry records.
+package policyengine
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/control"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+// Reload recompiles the policies and swaps the policy interpreter once they compile. Policies are
+// reloaded by the policy monitor, if configured.
+func (s *PolicyEngine) Reload() error {
+	if s.policyMonitor != nil {
+		return s.policyMonitor.CheckForPolicyUpdate()
+	}
+	if s.config.PoliciesPath == sfgo.Zeros.String {
+		return control.ErrNoPolicies
+	}
+	pi, err := s.createPolicyInterpreter(s.config.PoliciesPath)
+	if err != nil {
+		logger.Error.Printf("Unable to compile local policies from directory %s, %v", s.config.PoliciesPath, err)
+		return err
+	}
+	select {
+	case s.reloadCh <- pi:
+		logger.Info.Printf("Reloaded policies from directory %s", s.config.PoliciesPath)
+		return nil
+	default:
+		return errors.New("a policy reload is already pending")
+	}
+}
+
+// Status describes the policy set loaded in the policy interpreter.
+func (s *PolicyEngine) Status() (control.Status, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.pi == nil {
+		return control.Status{}, control.ErrNoPolicies
+	}
+	src := s.config.PoliciesPath
+	switch s.config.Monitor {
+	case engine.HTTPType:
+		src = s.config.MonitorURL
+	case engine.GitType:
+		src = s.config.MonitorURL + "@" + s.config.MonitorRef
+	}
+	return control.Status{Monitor: s.config.Monitor.String(), Source: src, PolicySet: s.pi.PolicySet()}, nil
+}
+
+// Rules lists the rules loaded in the policy interpreter.
+func (s *PolicyEngine) Rules() ([]control.Rule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.pi == nil {
+		return nil, control.ErrNoPolicies
+	}
+	rules := s.pi.Rules()
+	res := make([]control.Rule, 0, len(rules))
+	for _, r := range rules {
+		rule := control.Rule{Name: r.Name, Desc: r.Desc, Priority: r.Priority.String(), Enabled: r.Enabled, Language: r.Source.Language, File: r.Source.File}
+		for _, tag := range r.Tags {
+			switch tag := tag.(type) {
+			case []string:
+				rule.Tags = append(rule.Tags, tag...)
+			default:
+				rule.Tags = append(rule.Tags, fmt.Sprintf("%v", tag))
+			}
+		}
+		res = append(res, rule)
+	}
+	return res, nil
+}
+
+// SetRuleEnabled enables or disables the rules with the given name. The setting is kept when
+// policies are reloaded.
+func (s *PolicyEngine) SetRuleEnabled(name string, enabled bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pi == nil {
+		return control.ErrNoPolicies
+	}
+	if !s.pi.SetRuleEnabled(name, enabled) {
+		return fmt.Errorf("%w: %s", control.ErrRuleNotFound, name)
+	}
+	if s.overrides == nil {
+		s.overrides = make(map[string]bool)
+	}
+	s.overrides[name] = enabled
+	logger.Info.Printf("Set rule '%s' enabled to %t", name, enabled)
+	return nil
+}
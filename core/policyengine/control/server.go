@@ -0,0 +1,251 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package control implements a local control API for the policy engine.
+package control
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+// Prefix of control addresses denoting Unix domain sockets.
+const unixPrefix = "unix:"
+
+// Maximum time for pending requests to complete when the server stops.
+const shutdownTimeout = 5 * time.Second
+
+// Errors returned by controllers.
+var (
+	ErrNoPolicies   = errors.New("no policies loaded")
+	ErrRuleNotFound = errors.New("rule not found")
+)
+
+// Controller defines the policy engine operations exposed by the control API.
+type Controller interface {
+	// Reload recompiles the policies, and swaps the policy interpreter once they compile.
+	Reload() error
+	// Status describes the loaded policy set.
+	Status() (Status, error)
+	// Rules lists the loaded rules.
+	Rules() ([]Rule, error)
+	// SetRuleEnabled enables or disables the rules with the given name.
+	SetRuleEnabled(name string, enabled bool) error
+}
+
+// Status describes the policy set loaded in the policy engine.
+type Status struct {
+	Monitor string `json:"monitor"`
+	Source  string `json:"source"`
+	engine.PolicySet
+}
+
+// Rule describes a loaded rule.
+type Rule struct {
+	Name     string   `json:"name"`
+	Desc     string   `json:"desc"`
+	Priority string   `json:"priority"`
+	Enabled  bool     `json:"enabled"`
+	Tags     []string `json:"tags,omitempty"`
+	Language string   `json:"language,omitempty"`
+	File     string   `json:"file,omitempty"`
+}
+
+// Server serves the control API over a Unix domain socket or a loopback TCP address.
+type Server struct {
+	c      Controller
+	ln     net.Listener
+	srv    *http.Server
+	socket string
+	token  string
+}
+
+// NewServer creates a control server listening on addr, which is either unix:<path> or a
+// host:port address whose host is a loopback address. Requests must carry token as a bearer
+// token; the token is required on TCP addresses, since any local process or web page can reach them.
+func NewServer(addr string, token string, c Controller) (*Server, error) {
+	s := &Server{c: c, token: token}
+	var err error
+	if strings.HasPrefix(addr, unixPrefix) {
+		s.socket = strings.TrimPrefix(addr, unixPrefix)
+		if fi, err := os.Lstat(s.socket); err == nil && fi.Mode()&os.ModeSocket != 0 {
+			os.Remove(s.socket)
+		}
+		if s.ln, err = net.Listen("unix", s.socket); err != nil {
+			return nil, err
+		}
+		if err = os.Chmod(s.socket, 0600); err != nil {
+			s.ln.Close()
+			return nil, err
+		}
+	} else {
+		if err = checkLoopback(addr); err != nil {
+			return nil, err
+		}
+		if token == "" {
+			return nil, fmt.Errorf("control address %s requires a token", addr)
+		}
+		if s.ln, err = net.Listen("tcp", addr); err != nil {
+			return nil, err
+		}
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/status", s.handleStatus)
+	mux.HandleFunc("/v1/reload", s.handleReload)
+	mux.HandleFunc("/v1/rules", s.handleRules)
+	mux.HandleFunc("/v1/rules/enable", s.handleEnable(true))
+	mux.HandleFunc("/v1/rules/disable", s.handleEnable(false))
+	s.srv = &http.Server{Handler: s.authorize(mux), ReadHeaderTimeout: 10 * time.Second}
+	return s, nil
+}
+
+// checkLoopback checks that the host of a TCP address is a loopback address.
+func checkLoopback(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("control address %s is not a loopback address", addr)
+	}
+	return nil
+}
+
+// Addr returns the address the server listens on.
+func (s *Server) Addr() net.Addr {
+	return s.ln.Addr()
+}
+
+// Start serves the control API in a new goroutine.
+func (s *Server) Start() {
+	logger.Info.Printf("Starting policy engine control API on %s", s.ln.Addr())
+	go func() {
+		if err := s.srv.Serve(s.ln); err != nil && err != http.ErrServerClosed {
+			logger.Error.Printf("Policy engine control API stopped, %v", err)
+		}
+	}()
+}
+
+// Stop stops the server, waiting for pending requests to complete.
+func (s *Server) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	err := s.srv.Shutdown(ctx)
+	if s.socket != "" {
+		os.Remove(s.socket)
+	}
+	return err
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, req *http.Request) {
+	if !allow(w, req, http.MethodGet) {
+		return
+	}
+	status, err := s.c.Status()
+	reply(w, status, err)
+}
+
+func (s *Server) handleReload(w http.ResponseWriter, req *http.Request) {
+	if !allow(w, req, http.MethodPost) {
+		return
+	}
+	reply(w, map[string]string{"status": "reloading"}, s.c.Reload())
+}
+
+func (s *Server) handleRules(w http.ResponseWriter, req *http.Request) {
+	if !allow(w, req, http.MethodGet) {
+		return
+	}
+	rules, err := s.c.Rules()
+	reply(w, rules, err)
+}
+
+func (s *Server) handleEnable(enabled bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if !allow(w, req, http.MethodPost) {
+			return
+		}
+		name := req.URL.Query().Get("name")
+		if name == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "missing rule name"})
+			return
+		}
+		reply(w, map[string]interface{}{"name": name, "enabled": enabled}, s.c.SetRuleEnabled(name, enabled))
+	}
+}
+
+// authorize rejects requests without the server's bearer token, if one is set.
+func (s *Server) authorize(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if s.token != "" {
+			auth := req.Header.Get("Authorization")
+			token := strings.TrimPrefix(auth, "Bearer ")
+			if token == auth || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+				return
+			}
+		}
+		h.ServeHTTP(w, req)
+	})
+}
+
+// allow checks the method of a request, and responds with an error if it is not allowed.
+func allow(w http.ResponseWriter, req *http.Request, method string) bool {
+	if req.Method != method {
+		w.Header().Set("Allow", method)
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return false
+	}
+	return true
+}
+
+// reply responds with v, or with err if set.
+func reply(w http.ResponseWriter, v interface{}, err error) {
+	switch {
+	case err == nil:
+		writeJSON(w, http.StatusOK, v)
+	case errors.Is(err, ErrRuleNotFound):
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, ErrNoPolicies):
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": err.Error()})
+	default:
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v) //nolint:errcheck
+}
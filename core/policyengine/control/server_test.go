@@ -0,0 +1,177 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package control implements a local control API for the policy engine.
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+func TestMain(m *testing.M) {
+	logger.InitLoggers(logger.TRACE)
+	os.Exit(m.Run())
+}
+
+// fakeController records the operations requested through the control API.
+type fakeController struct {
+	rules   map[string]bool
+	reloads int
+	err     error
+}
+
+func (c *fakeController) Reload() error {
+	c.reloads++
+	return c.err
+}
+
+func (c *fakeController) Status() (Status, error) {
+	return Status{Monitor: "none", Source: "policies", PolicySet: engine.PolicySet{Version: "v1", Files: map[string]string{"rules.yaml": "sum"}}}, c.err
+}
+
+func (c *fakeController) Rules() ([]Rule, error) {
+	var rules []Rule
+	for name, enabled := range c.rules {
+		rules = append(rules, Rule{Name: name, Priority: "low", Enabled: enabled})
+	}
+	return rules, c.err
+}
+
+func (c *fakeController) SetRuleEnabled(name string, enabled bool) error {
+	if _, ok := c.rules[name]; !ok {
+		return ErrRuleNotFound
+	}
+	c.rules[name] = enabled
+	return nil
+}
+
+func unixClient(path string) *http.Client {
+	return &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", path)
+		},
+	}}
+}
+
+func TestServer(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "control.sock")
+	c := &fakeController{rules: map[string]bool{"Shell spawned": true}}
+	s, err := NewServer("unix:"+socket, "", c)
+	assert.NoError(t, err)
+	s.Start()
+	defer s.Stop() //nolint:errcheck
+	fi, err := os.Stat(socket)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	client := unixClient(socket)
+	call := func(method, path string, v interface{}) int {
+		req, err := http.NewRequest(method, "http://control"+path, nil)
+		assert.NoError(t, err)
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		if v != nil {
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(v))
+		}
+		return resp.StatusCode
+	}
+
+	var status Status
+	assert.Equal(t, http.StatusOK, call(http.MethodGet, "/v1/status", &status))
+	assert.Equal(t, "v1", status.Version)
+	assert.Equal(t, "sum", status.Files["rules.yaml"])
+
+	assert.Equal(t, http.StatusOK, call(http.MethodPost, "/v1/rules/disable?name=Shell+spawned", nil))
+	var rules []Rule
+	assert.Equal(t, http.StatusOK, call(http.MethodGet, "/v1/rules", &rules))
+	assert.Equal(t, []Rule{{Name: "Shell spawned", Priority: "low", Enabled: false}}, rules)
+	assert.Equal(t, http.StatusOK, call(http.MethodPost, "/v1/rules/enable?name=Shell+spawned", nil))
+	assert.True(t, c.rules["Shell spawned"])
+
+	var e map[string]string
+	assert.Equal(t, http.StatusNotFound, call(http.MethodPost, "/v1/rules/disable?name=Missing", &e))
+	assert.Equal(t, ErrRuleNotFound.Error(), e["error"])
+	assert.Equal(t, http.StatusBadRequest, call(http.MethodPost, "/v1/rules/disable", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, call(http.MethodGet, "/v1/reload", nil))
+
+	assert.Equal(t, http.StatusOK, call(http.MethodPost, "/v1/reload", nil))
+	c.err = errors.New("compilation failed")
+	assert.Equal(t, http.StatusInternalServerError, call(http.MethodPost, "/v1/reload", &e))
+	assert.Equal(t, "compilation failed", e["error"])
+	assert.Equal(t, 2, c.reloads)
+	c.err = ErrNoPolicies
+	assert.Equal(t, http.StatusServiceUnavailable, call(http.MethodGet, "/v1/status", nil))
+
+	// stale sockets are replaced
+	s2, err := NewServer("unix:"+socket, "", c)
+	assert.NoError(t, err)
+	assert.NoError(t, s2.Stop())
+	assert.NoFileExists(t, socket)
+}
+
+func TestServerLoopback(t *testing.T) {
+	c := &fakeController{}
+	for _, addr := range []string{"0.0.0.0:0", ":0", "example.com:0", "missingport"} {
+		_, err := NewServer(addr, "secret", c)
+		assert.Error(t, err, addr)
+	}
+	_, err := NewServer("127.0.0.1:0", "", c)
+	assert.ErrorContains(t, err, "requires a token")
+
+	c.rules = map[string]bool{"Shell spawned": true}
+	s, err := NewServer("127.0.0.1:0", "secret", c)
+	assert.NoError(t, err)
+	s.Start()
+	defer s.Stop() //nolint:errcheck
+	url := "http://" + s.Addr().String() + "/v1/rules/disable?name=Shell+spawned"
+	call := func(auth string) int {
+		req, err := http.NewRequest(http.MethodPost, url, nil)
+		assert.NoError(t, err)
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// plain requests, such as cross-site form posts, are rejected
+	resp, err := http.Post(url, "application/x-www-form-urlencoded", nil)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, call("Bearer wrong"))
+	assert.Equal(t, http.StatusUnauthorized, call("secret"))
+	assert.True(t, c.rules["Shell spawned"])
+	assert.Equal(t, http.StatusOK, call("Bearer secret"))
+	assert.False(t, c.rules["Shell spawned"])
+}
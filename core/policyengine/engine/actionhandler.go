@@ -20,11 +20,14 @@
 package engine
 
 import (
+	"context"
 	"plugin"
+	"strings"
 
 	"github.com/sysflow-telemetry/sf-apis/go/ioutils"
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
 )
 
 // Prototype of an action function
@@ -42,30 +45,53 @@ const ActionSym = "Action"
 
 type ActionHandler[R any] struct {
 	// Map of registered actions
-	BuiltInActions     ActionMap[R]
+	BuiltInActions     map[string]source.ActionBuilder[R]
 	UserDefinedActions ActionMap[R]
+
+	// Declares the built-in actions that modify records, or nil if none does
+	modifier source.RecordModifier
+
+	// Action functions bound to rules, indexed by rule name and action reference
+	bound map[string]*boundAction[R]
+
+	// Execution options, concurrency limits, and counters, indexed by action name
+	conf  Config
+	sems  map[string]chan struct{}
+	stats map[string]*actionCounters
+
+	// Pool executing actions outside of the interpreter's workers, or nil if actions are executed inline
+	pool *actionPool
 }
 
-func NewActionHandler[R any](conf Config) *ActionHandler[R] {
-	ah := new(ActionHandler[R])
+func NewActionHandler[R any](conf Config, ctx source.Contextualizer[R]) *ActionHandler[R] {
+	ah := &ActionHandler[R]{conf: conf, sems: make(map[string]chan struct{}), stats: make(map[string]*actionCounters)}
 
 	// Register built-in actions
-	ah.registerBuiltIns()
+	ah.registerBuiltIns(conf, ctx)
 
-	// Load user-defined actions
-	ah.loadUserActions(conf.ActionDir)
+	// Load user-defined actions, unless actions are not executed
+	ah.UserDefinedActions = make(ActionMap[R])
+	if !conf.DryRun {
+		ah.loadUserActions(conf.ActionDir)
+	}
 
 	return ah
 }
 
-// Registers built-in actions
-func (ah *ActionHandler[R]) registerBuiltIns() {
-	ah.BuiltInActions = make(ActionMap[R])
+// Registers built-in actions provided by the contextualizer
+func (ah *ActionHandler[R]) registerBuiltIns(conf Config, ctx source.Contextualizer[R]) {
+	ah.BuiltInActions = make(map[string]source.ActionBuilder[R])
+	ah.bound = make(map[string]*boundAction[R])
+	if p, ok := ctx.(source.ActionProvider[R]); ok {
+		for name, b := range p.Actions(conf.Actions) {
+			ah.BuiltInActions[name] = b
+		}
+	}
+	ah.modifier, _ = ctx.(source.RecordModifier)
 }
 
 // LoadActions loads user-defined actions from path
 func (ah *ActionHandler[R]) loadUserActions(dir string) {
-	ah.UserDefinedActions = make(ActionMap[R])
 	if paths, err := ioutils.ListFilePaths(dir, ".so"); err == nil {
 		var plug *plugin.Plugin
 		for _, path := range paths {
@@ -96,37 +122,162 @@ func (ah *ActionHandler[R]) loadUserActions(dir string) {
 	}
 }
 
-// CheckActions checks whether actions rules definitions have known implementations.
-func (ah *ActionHandler[R]) CheckActions(rules []policy.Rule[R]) {
+// parseAction splits an action reference into an action name and an argument, e.g.,
+// "priority:high" into "priority" and "high".
+func parseAction(a string) (name string, arg string) {
+	if i := strings.Index(a, ":"); i >= 0 {
+		return a[:i], a[i+1:]
+	}
+	return a, ""
+}
+
+// bindingKey returns the key of the built-in action function bound to rule for reference a.
+func bindingKey(rule string, a string) string {
+	return rule + "\x1f" + a
+}
+
+// CheckActions checks whether actions rules definitions have known implementations, and binds
+// actions to rules. It returns a warning diagnostic for each unknown action, and an error
+// diagnostic for each built-in action reference with invalid arguments, and for each action that
+// may modify records but is configured to run asynchronously. User-defined actions are assumed to
+// modify records.
+func (ah *ActionHandler[R]) CheckActions(rules []policy.Rule[R]) policy.Diagnostics {
+	var diags policy.Diagnostics
 	for _, r := range rules {
 		if r.Actions == nil {
 			continue
 		}
 		for _, a := range r.Actions {
-			if _, ok := ah.BuiltInActions[a]; !ok {
-				if _, ok = ah.UserDefinedActions[a]; !ok {
-					logger.Warn.Printf("Unknown action identifier '%s' found in rule '%s'", a, r.Name)
+			name, arg := parseAction(a)
+			build, ok := ah.BuiltInActions[name]
+			if !ok && ah.conf.DryRun {
+				// user-defined actions are not loaded in dry runs
+				continue
+			}
+			if !ok {
+				if f, ok := ah.UserDefinedActions[a]; ok {
+					if ah.conf.Options(a).Mode == AsyncAction {
+						logger.Error.Printf("User-defined action '%s' found in rule '%s' may modify records and cannot run in %s mode", a, r.Name, AsyncAction)
+						diags = append(diags, policy.Diagnostic{Rule: r.Name, Severity: policy.SeverityError, Msg: "invalid action " + a + ": may modify records and cannot run in " + AsyncAction.String() + " mode"})
+						continue
+					}
+					ah.bind(r.Name, a, a, func(_ context.Context, r R) error { return f(r) }, false)
+					continue
 				}
+				logger.Warn.Printf("Unknown action identifier '%s' found in rule '%s'", a, r.Name)
+				diags = append(diags, policy.Diagnostic{Rule: r.Name, Severity: policy.SeverityWarning, Msg: "unknown action " + a})
+				continue
 			}
+			if ah.modifier != nil && ah.modifier.ModifiesRecords(name) && ah.conf.Options(name).Mode == AsyncAction {
+				logger.Error.Printf("Action '%s' found in rule '%s' modifies records and cannot run in %s mode", a, r.Name, AsyncAction)
+				diags = append(diags, policy.Diagnostic{Rule: r.Name, Severity: policy.SeverityError, Msg: "invalid action " + a + ": modifies records and cannot run in " + AsyncAction.String() + " mode"})
+				continue
+			}
+			f, err := build(r, arg)
+			if err != nil {
+				logger.Error.Printf("Invalid action '%s' found in rule '%s': %v", a, r.Name, err)
+				diags = append(diags, policy.Diagnostic{Rule: r.Name, Severity: policy.SeverityError, Msg: "invalid action " + a + ": " + err.Error()})
+				continue
+			}
+			ah.bind(r.Name, a, name, f, true)
+		}
+	}
+	return diags
+}
+
+// bind binds the function of action reference a to rule.
+func (ah *ActionHandler[R]) bind(rule string, a string, name string, f func(ctx context.Context, r R) error, cancelable bool) {
+	opts := ah.conf.Options(name)
+	if _, ok := ah.stats[name]; !ok {
+		ah.stats[name] = new(actionCounters)
+		if opts.Concurrency > 0 {
+			ah.sems[name] = make(chan struct{}, opts.Concurrency)
 		}
 	}
+	ah.bound[bindingKey(rule, a)] = &boundAction[R]{
+		ref:        a,
+		fn:         f,
+		cancelable: cancelable,
+		opts:       opts,
+		sem:        ah.sems[name],
+		stats:      ah.stats[name],
+	}
 }
 
-// HandleAction handles actions defined in rule.
-func (ah *ActionHandler[R]) HandleActions(rule policy.Rule[R], r R) {
-	if rule.Actions == nil {
+// HandleActions handles the actions defined in the rules matching record r, in rule order, and calls
+// done once the record is enriched. Blocking actions are executed before done is called, and
+// asynchronous actions are dispatched afterwards. If the action pool is running, actions are executed
+// in the pool, and the caller waits for the blocking actions, so that done is always called in the
+// caller's goroutine and records are exported in order; otherwise, actions are executed in the caller's
+// goroutine. In dry runs, no action is executed.
+func (ah *ActionHandler[R]) HandleActions(rules []policy.Rule[R], r R, done func()) {
+	if ah.conf.DryRun {
+		done()
 		return
 	}
-	for _, a := range rule.Actions {
-		action, ok := ah.BuiltInActions[a]
-		if !ok {
-			action, ok = ah.UserDefinedActions[a]
+	var blocking, async []*boundAction[R]
+	for _, rule := range rules {
+		for _, a := range rule.Actions {
+			if b, ok := ah.bound[bindingKey(rule.Name, a)]; ok {
+				if b.opts.Mode == AsyncAction {
+					async = append(async, b)
+				} else {
+					blocking = append(blocking, b)
+				}
+			}
 		}
-		if !ok {
-			continue
+	}
+	if len(blocking) > 0 {
+		finished := make(chan struct{})
+		job := func() {
+			defer close(finished)
+			for _, b := range blocking {
+				b.run(r)
+			}
 		}
-		if err := action(r); err != nil {
-			logger.Error.Println("Error in action: " + err.Error())
+		if ah.pool == nil || !ah.pool.submit(job) {
+			// apply backpressure if the pool is saturated
+			job()
 		}
+		<-finished
+	}
+	done()
+	for _, b := range async {
+		ah.dispatch(b, r)
+	}
+}
+
+// dispatch executes an asynchronous action in the pool, dropping it if the pool is saturated.
+func (ah *ActionHandler[R]) dispatch(b *boundAction[R], r R) {
+	if ah.pool == nil {
+		b.run(r)
+		return
+	}
+	if !ah.pool.submit(func() { b.run(r) }) {
+		b.stats.dropped.Add(1)
+	}
+}
+
+// Start starts the action pool.
+func (ah *ActionHandler[R]) Start() {
+	if ah.pool == nil && ah.conf.ActionPoolSize > 0 {
+		ah.pool = newActionPool(ah.conf.ActionPoolSize, ah.conf.ActionQueueSize)
+	}
+}
+
+// Stop waits for all pending actions to complete, and stops the action pool.
+func (ah *ActionHandler[R]) Stop() {
+	if ah.pool != nil {
+		ah.pool.stop()
+		ah.pool = nil
+	}
+}
+
+// Stats returns a snapshot of the execution counters of bound actions, indexed by action name.
+func (ah *ActionHandler[R]) Stats() map[string]ActionStats {
+	stats := make(map[string]ActionStats, len(ah.stats))
+	for name, c := range ah.stats {
+		stats[name] = c.snapshot()
 	}
+	return stats
 }
@@ -0,0 +1,247 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy/falco"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord/flatrecordtest"
+)
+
+func TestParseAction(t *testing.T) {
+	for _, c := range [][3]string{
+		{"hash", "hash", ""},
+		{"priority:high", "priority", "high"},
+		{"webhook:http://localhost:8080/alerts", "webhook", "http://localhost:8080/alerts"},
+	} {
+		name, arg := parseAction(c[0])
+		assert.Equal(t, c[1], name)
+		assert.Equal(t, c[2], arg)
+	}
+}
+
+func TestBuiltInActions(t *testing.T) {
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	rules, _, err := pc.Compile("../../../resources/policies/tests/actions/policy.yaml")
+	assert.NoError(t, err)
+	assert.Len(t, rules, 2)
+
+	ah := NewActionHandler[*flatrecord.Record](Config{}, flatrecord.NewContextualizer())
+	diags := ah.CheckActions(rules)
+	assert.Len(t, diags, 3)
+	assert.True(t, diags.HasErrors())
+	assert.Equal(t, policy.SeverityWarning, diags[1].Severity)
+	assert.Len(t, ah.bound, 3)
+
+	fr := flatrecordtest.NewFlatRecord(sfgo.PROC_EVT)
+	fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXE_STR] = "/bin/bash"
+	r := flatrecord.NewRecord(fr)
+	done := false
+	ah.HandleActions(rules, r, func() { done = true })
+	assert.True(t, done)
+	assert.Equal(t, policy.High, r.Ctx.GetPriority(rules[0]))
+	assert.Equal(t, policy.Low, r.Ctx.GetPriority(rules[1]))
+	assert.Equal(t, []string{"shell", "exe=/bin/bash"}, r.Ctx.GetTags())
+
+	// actions that modify records cannot run asynchronously
+	conf, err := CreateConfig(map[string]interface{}{"actions.tag.mode": "async", "actions.priority.mode": "async"})
+	assert.NoError(t, err)
+	ah = NewActionHandler[*flatrecord.Record](conf, flatrecord.NewContextualizer())
+	diags = ah.CheckActions(rules)
+	assert.Len(t, diags, 6)
+	for _, d := range diags[:4] {
+		assert.Equal(t, policy.SeverityError, d.Severity)
+		assert.Contains(t, d.Msg, "modifies records")
+	}
+	assert.Empty(t, ah.bound)
+}
+
+type actRecord struct {
+	mu    sync.Mutex
+	notes []string
+}
+
+func (r *actRecord) note(s string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.notes = append(r.notes, s)
+}
+
+func (r *actRecord) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.notes...)
+}
+
+// actContextualizer provides test actions that record notes, block until canceled, or fail.
+type actContextualizer struct {
+	source.Contextualizer[*actRecord]
+}
+
+func (c *actContextualizer) Actions(conf source.ActionConfig) map[string]source.ActionBuilder[*actRecord] {
+	note := func(rule policy.Rule[*actRecord], arg string) (func(ctx context.Context, r *actRecord) error, error) {
+		return func(ctx context.Context, r *actRecord) error { r.note(arg); return nil }, nil
+	}
+	return map[string]source.ActionBuilder[*actRecord]{
+		"note":   note,
+		"notify": note,
+		"slow": func(rule policy.Rule[*actRecord], arg string) (func(ctx context.Context, r *actRecord) error, error) {
+			return func(ctx context.Context, r *actRecord) error { <-ctx.Done(); return ctx.Err() }, nil
+		},
+		"fail": func(rule policy.Rule[*actRecord], arg string) (func(ctx context.Context, r *actRecord) error, error) {
+			return func(ctx context.Context, r *actRecord) error { return errors.New("failed") }, nil
+		},
+	}
+}
+
+func newTestActionHandler(t *testing.T, conf Config) (*ActionHandler[*actRecord], []policy.Rule[*actRecord]) {
+	ah := NewActionHandler[*actRecord](conf, &actContextualizer{source.NewDefaultContextualizer[*actRecord]()})
+	rules := []policy.Rule[*actRecord]{
+		{Name: "r1", Actions: []string{"note:a", "notify:b", "slow"}},
+		{Name: "r2", Actions: []string{"fail", "note:c"}},
+	}
+	assert.Empty(t, ah.CheckActions(rules))
+	return ah, rules
+}
+
+func TestActionPool(t *testing.T) {
+	conf, err := CreateConfig(map[string]interface{}{
+		ActionPoolSizeKey:      "2",
+		"actions.notify.mode":  "async",
+		"actions.fail.retries": "2",
+	})
+	assert.NoError(t, err)
+	conf.ActionDefaults.Timeout = 50 * time.Millisecond
+	conf.ActionOptions["slow"] = conf.ActionDefaults
+	ah, rules := newTestActionHandler(t, conf)
+	ah.Start()
+
+	r := new(actRecord)
+	exported := make(chan []string, 1)
+	ah.HandleActions(rules, r, func() { exported <- r.get() })
+	assert.Equal(t, []string{"a", "c"}, <-exported)
+	ah.Stop()
+	assert.Equal(t, []string{"a", "c", "b"}, r.get())
+
+	stats := ah.Stats()
+	assert.Equal(t, ActionStats{Runs: 1, Timeouts: 1}, stats["slow"])
+	assert.Equal(t, ActionStats{Runs: 3, Failures: 3, Retries: 2}, stats["fail"])
+	assert.Equal(t, ActionStats{Runs: 2}, stats["note"])
+	assert.Equal(t, ActionStats{Runs: 1}, stats["notify"])
+}
+
+func TestActionPoolOrder(t *testing.T) {
+	conf, err := CreateConfig(map[string]interface{}{ActionPoolSizeKey: "4"})
+	assert.NoError(t, err)
+	ah, rules := newTestActionHandler(t, conf)
+	ah.Start()
+	defer ah.Stop()
+
+	// records are exported by the caller in order, once their blocking actions complete
+	var exported []*actRecord
+	records := make([]*actRecord, 8)
+	for i := range records {
+		records[i] = new(actRecord)
+		ah.HandleActions(rules[1:], records[i], func() { exported = append(exported, records[i]) })
+		assert.Equal(t, []string{"c"}, records[i].get())
+	}
+	assert.Equal(t, records, exported)
+}
+
+func TestActionInline(t *testing.T) {
+	conf, _ := CreateConfig(map[string]interface{}{})
+	conf.ActionOptions["slow"] = ActionOptions{Timeout: time.Millisecond}
+	ah, rules := newTestActionHandler(t, conf)
+	r := new(actRecord)
+	done := false
+	ah.HandleActions(rules, r, func() { done = true })
+	assert.True(t, done)
+	assert.Equal(t, []string{"a", "b", "c"}, r.get())
+	assert.Equal(t, uint64(1), ah.Stats()["slow"].Timeouts)
+}
+
+func TestUserDefinedAction(t *testing.T) {
+	conf, _ := CreateConfig(map[string]interface{}{})
+	conf.ActionDefaults.Timeout = time.Millisecond
+	ah := NewActionHandler[*actRecord](conf, &actContextualizer{source.NewDefaultContextualizer[*actRecord]()})
+	ah.UserDefinedActions["user"] = func(r *actRecord) error {
+		time.Sleep(20 * time.Millisecond)
+		r.note("user")
+		return nil
+	}
+	rules := []policy.Rule[*actRecord]{{Name: "r", Actions: []string{"user"}}}
+	assert.Empty(t, ah.CheckActions(rules))
+	ah.Start()
+	r := new(actRecord)
+	exported := make(chan []string, 1)
+	ah.HandleActions(rules, r, func() { exported <- r.get() })
+	// user-defined actions are not abandoned on timeout, since they may still modify the record
+	assert.Equal(t, []string{"user"}, <-exported)
+	ah.Stop()
+	assert.Equal(t, ActionStats{Runs: 1}, ah.Stats()["user"])
+
+	// user-defined actions may modify records and cannot run asynchronously
+	conf.ActionOptions["user"] = ActionOptions{Mode: AsyncAction}
+	ah = NewActionHandler[*actRecord](conf, &actContextualizer{source.NewDefaultContextualizer[*actRecord]()})
+	ah.UserDefinedActions["user"] = func(r *actRecord) error { return nil }
+	diags := ah.CheckActions(rules)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, policy.SeverityError, diags[0].Severity)
+	assert.Contains(t, diags[0].Msg, "may modify records")
+	assert.Empty(t, ah.bound)
+}
+
+func TestActionOptions(t *testing.T) {
+	conf, err := CreateConfig(map[string]interface{}{
+		ActionTimeoutKey:              "3",
+		"actions.webhook.mode":        "async",
+		"actions.webhook.timeout":     "2",
+		"actions.webhook.concurrency": "4",
+		"actions.webhook.url":         "http://localhost",
+		"actions.pool.size":           "8",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 8, conf.ActionPoolSize)
+	assert.Equal(t, ActionOptions{Mode: AsyncAction, Timeout: 2 * time.Second, Concurrency: 4}, conf.Options("webhook"))
+	assert.Equal(t, ActionOptions{Mode: BlockingAction, Timeout: 3 * time.Second}, conf.Options("hash"))
+	assert.Len(t, conf.ActionOptions, 1)
+
+	_, err = CreateConfig(map[string]interface{}{"actions.webhook.mode": "asynch", ActionRetriesKey: "1"})
+	assert.ErrorContains(t, err, "invalid action mode asynch")
+	_, err = CreateConfig(map[string]interface{}{ActionModeKey: "blocked"})
+	assert.ErrorContains(t, err, "invalid action mode blocked")
+
+	// parse errors are not masked by later valid options
+	_, err = CreateConfig(map[string]interface{}{"actions.webhook.timeout": "2s", "actions.webhook.retries": "1"})
+	assert.ErrorContains(t, err, `parsing "2s"`)
+	_, err = CreateConfig(map[string]interface{}{ConcurrencyKey: "many", ProfileKey: "true"})
+	assert.ErrorContains(t, err, `parsing "many"`)
+}
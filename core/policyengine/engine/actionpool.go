@@ -0,0 +1,160 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+)
+
+// retryBackoff is the delay before the first retry of a failed action; it grows linearly with each attempt.
+const retryBackoff = 100 * time.Millisecond
+
+// actionPool is a bounded pool of workers executing action tasks.
+type actionPool struct {
+	tasks   chan func()
+	wg      sync.WaitGroup
+	pending sync.WaitGroup
+}
+
+// newActionPool creates a pool of size workers with a task queue of the given capacity.
+func newActionPool(size int, queue int) *actionPool {
+	p := &actionPool{tasks: make(chan func(), queue)}
+	p.wg.Add(size)
+	for i := 0; i < size; i++ {
+		go func() {
+			defer p.wg.Done()
+			for task := range p.tasks {
+				task()
+				p.pending.Done()
+			}
+		}()
+	}
+	return p
+}
+
+// submit queues a task, and returns false if the queue is full.
+func (p *actionPool) submit(task func()) bool {
+	p.pending.Add(1)
+	select {
+	case p.tasks <- task:
+		return true
+	default:
+		p.pending.Done()
+		return false
+	}
+}
+
+// stop waits for all queued tasks, including the tasks they submit, to complete, and stops the workers.
+func (p *actionPool) stop() {
+	p.pending.Wait()
+	close(p.tasks)
+	p.wg.Wait()
+}
+
+// ActionStats defines the execution counters of an action.
+type ActionStats struct {
+	// Number of execution attempts
+	Runs uint64
+	// Number of attempts that failed with an error
+	Failures uint64
+	// Number of attempts that timed out
+	Timeouts uint64
+	// Number of retried attempts
+	Retries uint64
+	// Number of asynchronous executions dropped because the action pool was saturated
+	Dropped uint64
+}
+
+// actionCounters holds the execution counters of an action, shared by all its bindings.
+type actionCounters struct {
+	runs, failures, timeouts, retries, dropped atomic.Uint64
+}
+
+func (c *actionCounters) snapshot() ActionStats {
+	return ActionStats{
+		Runs:     c.runs.Load(),
+		Failures: c.failures.Load(),
+		Timeouts: c.timeouts.Load(),
+		Retries:  c.retries.Load(),
+		Dropped:  c.dropped.Load(),
+	}
+}
+
+// boundAction is an action function bound to a rule.
+type boundAction[R any] struct {
+	ref   string
+	fn    func(ctx context.Context, r R) error
+	opts  ActionOptions
+	sem   chan struct{}
+	stats *actionCounters
+
+	// cancelable functions return once their context is done; others run to completion without timeout,
+	// since they may still be modifying the record when it is exported
+	cancelable bool
+}
+
+// run executes the action on r, retrying failed attempts.
+func (b *boundAction[R]) run(r R) {
+	if b.sem != nil {
+		b.sem <- struct{}{}
+		defer func() { <-b.sem }()
+	}
+	for attempt := 0; ; attempt++ {
+		b.stats.runs.Add(1)
+		err := b.call(r)
+		if err == nil {
+			return
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			b.stats.timeouts.Add(1)
+		} else {
+			b.stats.failures.Add(1)
+		}
+		if attempt >= b.opts.Retries {
+			logger.Error.Printf("Error in action '%s': %v", b.ref, err)
+			return
+		}
+		b.stats.retries.Add(1)
+		time.Sleep(time.Duration(attempt+1) * retryBackoff)
+	}
+}
+
+// call executes a single attempt of the action, enforcing its timeout if the action is cancelable.
+func (b *boundAction[R]) call(r R) error {
+	if b.opts.Timeout <= 0 || !b.cancelable {
+		return b.fn(context.Background(), r)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), b.opts.Timeout)
+	defer cancel()
+	if err := b.fn(ctx, r); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	return nil
+}
@@ -22,8 +22,14 @@
 package engine
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/common"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
 )
 
 // Configuration keys.
@@ -37,10 +43,28 @@ const (
 	BuildNumberKey       string = "buildnumber"
 	MonitorKey           string = "monitor"
 	MonitorIntervalKey   string = "monitor.interval"
+	MonitorURLKey        string = "monitor.url"
+	MonitorRefKey        string = "monitor.ref"
+	MonitorPubKeyKey     string = "monitor.pubkey"
+	MonitorCacheDirKey   string = "monitor.cachedir"
 	ConcurrencyKey       string = "concurrency"
 	ActionDirKey         string = "actiondir"
-	BenchRulesetSizeKey  string = "bench.rulesetsize"
-	BenchRuleIndexKey    string = "bench.ruleindex"
+	ScriptDirKey         string = "scriptdir"
+	StateMaxKeysKey      string = "state.maxkeys"
+	QuarantinePathKey    string = "actions.quarantine.path"
+	WebhookURLKey        string = "actions.webhook.url"
+	ActionPoolSizeKey    string = "actions.pool.size"
+	ActionQueueSizeKey   string = "actions.pool.queue"
+	ActionModeKey        string = "actions.mode"
+	ActionTimeoutKey     string = "actions.timeout"
+	ActionRetriesKey     string = "actions.retries"
+	SuppressWindowKey    string = "suppress.window"
+	SuppressGroupByKey   string = "suppress.group_by"
+	ProfileKey           string = "profile"
+	ProfileIntervalKey   string = "profile.interval"
+	ProfilePathKey       string = "profile.path"
+	ControlAddrKey       string = "control.addr"
+	ControlTokenKey      string = "control.token"
 )
 
 // Config defines a configuration object for the engine.
@@ -54,15 +78,32 @@ type Config struct {
 	BuildNumber       string
 	Monitor           MonitorType
 	MonitorInterval   time.Duration
+	MonitorURL        string
+	MonitorRef        string
+	MonitorPubKey     string
+	MonitorCacheDir   string
 	Concurrency       int
 	ActionDir         string
-	BenchRulesetSize  int
-	BenchRuleIndex    int
+	ScriptDir         string
+	StateMaxKeys      int
+	Actions           source.ActionConfig
+	ActionPoolSize    int
+	ActionQueueSize   int
+	ActionDefaults    ActionOptions
+	ActionOptions     map[string]ActionOptions
+	Suppress          policy.Suppression
+	Profile           bool
+	ProfileInterval   time.Duration
+	ProfilePath       string
+	ControlAddr       string
+	ControlToken      string
+	// Records the actions of matching rules without executing them, e.g., when replaying traces offline
+	DryRun bool
 }
 
 // CreateConfig creates a new config object from config dictionary.
 func CreateConfig(conf map[string]interface{}) (Config, error) {
-	var c Config = Config{Mode: AlertMode, Concurrency: 5, Monitor: NoneType, MonitorInterval: 30 * time.Second, ActionDir: "../resources/actions", Language: Falco, BenchRulesetSize: -1, BenchRuleIndex: -1} // default values
+	var c Config = Config{Mode: AlertMode, Concurrency: 5, Monitor: NoneType, MonitorInterval: 30 * time.Second, MonitorRef: "HEAD", ActionDir: "../resources/actions", StateMaxKeys: 10000, ActionPoolSize: 4, ActionQueueSize: 1024, ActionDefaults: ActionOptions{Timeout: 5 * time.Second}, Language: Falco, ProfileInterval: 60 * time.Second} // default values
 	var err error
 
 	if v, ok := conf[PoliciesConfigKey].(string); ok {
@@ -72,7 +113,9 @@ func CreateConfig(conf map[string]interface{}) (Config, error) {
 		c.ConfigPath = v
 	}
 	if v, ok := conf[LanguageKey].(string); ok {
-		c.Language = parseLanguage(v)
+		if c.Language, err = parseLanguage(v); err != nil {
+			return c, err
+		}
 	}
 	if v, ok := conf[ModeConfigKey].(string); ok {
 		c.Mode = parseModeConfig(v)
@@ -90,25 +133,206 @@ func CreateConfig(conf map[string]interface{}) (Config, error) {
 		c.Monitor = parseMonitorType(v)
 	}
 	if v, ok := conf[MonitorIntervalKey].(string); ok {
-		var duration int
-		duration, err = strconv.Atoi(v)
-		if err == nil {
-			c.MonitorInterval = time.Duration(duration) * time.Second
+		duration, err := strconv.Atoi(v)
+		if err != nil {
+			return c, err
 		}
+		c.MonitorInterval = time.Duration(duration) * time.Second
+	}
+	if v, ok := conf[MonitorURLKey].(string); ok {
+		c.MonitorURL = v
+	}
+	if v, ok := conf[MonitorRefKey].(string); ok {
+		c.MonitorRef = v
+	}
+	if v, ok := conf[MonitorPubKeyKey].(string); ok {
+		c.MonitorPubKey = v
+	}
+	if v, ok := conf[MonitorCacheDirKey].(string); ok {
+		c.MonitorCacheDir = v
 	}
 	if v, ok := conf[ConcurrencyKey].(string); ok {
-		c.Concurrency, err = strconv.Atoi(v)
+		if c.Concurrency, err = strconv.Atoi(v); err != nil {
+			return c, err
+		}
 	}
 	if v, ok := conf[ActionDirKey].(string); ok {
 		c.ActionDir = v
 	}
-	if v, ok := conf[BenchRulesetSizeKey].(string); ok {
-		c.BenchRulesetSize, err = strconv.Atoi(v)
+	if v, ok := conf[ScriptDirKey].(string); ok {
+		c.ScriptDir = v
+	}
+	if v, ok := conf[StateMaxKeysKey].(string); ok {
+		if c.StateMaxKeys, err = strconv.Atoi(v); err != nil {
+			return c, err
+		}
+	}
+	if v, ok := conf[QuarantinePathKey].(string); ok {
+		c.Actions.QuarantinePath = v
+	}
+	if v, ok := conf[WebhookURLKey].(string); ok {
+		c.Actions.WebhookURL = v
+	}
+	if v, ok := conf[ActionPoolSizeKey].(string); ok {
+		if c.ActionPoolSize, err = strconv.Atoi(v); err != nil {
+			return c, err
+		}
+	}
+	if v, ok := conf[ActionQueueSizeKey].(string); ok {
+		if c.ActionQueueSize, err = strconv.Atoi(v); err != nil {
+			return c, err
+		}
+	}
+	if err = c.ActionDefaults.parse(conf, ActionModeKey, ActionTimeoutKey, ActionRetriesKey, ""); err != nil {
+		return c, err
+	}
+	c.ActionOptions = make(map[string]ActionOptions)
+	for k := range conf {
+		name, ok := actionOptionName(k)
+		if !ok {
+			continue
+		}
+		if _, ok := c.ActionOptions[name]; ok {
+			continue
+		}
+		opts := c.ActionDefaults
+		prefix := actionsPrefix + name
+		if err = opts.parse(conf, prefix+modeSuffix, prefix+timeoutSuffix, prefix+retriesSuffix, prefix+concurrencySuffix); err != nil {
+			return c, err
+		}
+		c.ActionOptions[name] = opts
 	}
-	if v, ok := conf[BenchRuleIndexKey].(string); ok {
-		c.BenchRuleIndex, err = strconv.Atoi(v)
+	if v, ok := conf[SuppressWindowKey].(string); ok {
+		if c.Suppress.Window, err = common.ParseDuration(v); err != nil {
+			return c, err
+		}
 	}
-	return c, err
+	if v, ok := conf[SuppressGroupByKey].(string); ok {
+		for _, attr := range strings.Split(v, ",") {
+			if attr = strings.TrimSpace(attr); attr != "" {
+				c.Suppress.GroupBy = append(c.Suppress.GroupBy, attr)
+			}
+		}
+	}
+	if v, ok := conf[ProfileKey].(string); ok {
+		if c.Profile, err = strconv.ParseBool(v); err != nil {
+			return c, err
+		}
+	}
+	if v, ok := conf[ProfileIntervalKey].(string); ok {
+		interval, err := strconv.Atoi(v)
+		if err != nil {
+			return c, err
+		}
+		c.ProfileInterval = time.Duration(interval) * time.Second
+	}
+	if v, ok := conf[ProfilePathKey].(string); ok {
+		c.ProfilePath = v
+	}
+	if v, ok := conf[ControlAddrKey].(string); ok {
+		c.ControlAddr = v
+	}
+	if v, ok := conf[ControlTokenKey].(string); ok {
+		c.ControlToken = v
+	}
+	return c, nil
+}
+
+// Options returns the execution options of the action with the given name.
+func (c Config) Options(action string) ActionOptions {
+	if opts, ok := c.ActionOptions[action]; ok {
+		return opts
+	}
+	return c.ActionDefaults
+}
+
+// ActionMode defines whether a record is exported after an action completes.
+type ActionMode uint32
+
+// Action modes.
+const (
+	BlockingAction ActionMode = iota
+	AsyncAction
+)
+
+func (s ActionMode) String() string {
+	return [...]string{"blocking", "async"}[s]
+}
+
+func parseActionMode(s string) (ActionMode, error) {
+	switch s {
+	case BlockingAction.String():
+		return BlockingAction, nil
+	case AsyncAction.String():
+		return AsyncAction, nil
+	}
+	return BlockingAction, fmt.Errorf("invalid action mode %s, expected %s or %s", s, BlockingAction, AsyncAction)
+}
+
+// ActionOptions defines how an action is executed.
+type ActionOptions struct {
+	// Blocking actions enrich records before they are exported; asynchronous actions are
+	// dispatched after blocking actions complete, and must not modify records (actions that
+	// modify records are rejected in asynchronous mode)
+	Mode ActionMode
+	// Maximum duration of an execution attempt, or zero for no timeout
+	Timeout time.Duration
+	// Number of times a failed execution is retried
+	Retries int
+	// Maximum number of concurrent executions, or zero for no limit
+	Concurrency int
+}
+
+// Per-action option keys have the form actions.<name>.<option>.
+const (
+	actionsPrefix     = "actions."
+	modeSuffix        = ".mode"
+	timeoutSuffix     = ".timeout"
+	retriesSuffix     = ".retries"
+	concurrencySuffix = ".concurrency"
+)
+
+// actionOptionName returns the action name of a per-action option key.
+func actionOptionName(key string) (string, bool) {
+	if !strings.HasPrefix(key, actionsPrefix) {
+		return "", false
+	}
+	rest := strings.TrimPrefix(key, actionsPrefix)
+	for _, suffix := range []string{modeSuffix, timeoutSuffix, retriesSuffix, concurrencySuffix} {
+		if strings.HasSuffix(rest, suffix) {
+			name := strings.TrimSuffix(rest, suffix)
+			return name, name != "" && !strings.Contains(name, ".")
+		}
+	}
+	return "", false
+}
+
+// parse overrides action options with the values of the given keys, and returns the first parse
+// error; empty keys are ignored.
+func (o *ActionOptions) parse(conf map[string]interface{}, modeKey, timeoutKey, retriesKey, concurrencyKey string) (err error) {
+	if v, ok := conf[modeKey].(string); ok {
+		if o.Mode, err = parseActionMode(v); err != nil {
+			return err
+		}
+	}
+	if v, ok := conf[timeoutKey].(string); ok {
+		timeout, err := strconv.Atoi(v)
+		if err != nil {
+			return err
+		}
+		o.Timeout = time.Duration(timeout) * time.Second
+	}
+	if v, ok := conf[retriesKey].(string); ok {
+		if o.Retries, err = strconv.Atoi(v); err != nil {
+			return err
+		}
+	}
+	if v, ok := conf[concurrencyKey].(string); ok && concurrencyKey != "" {
+		if o.Concurrency, err = strconv.Atoi(v); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Mode type.
@@ -141,10 +365,17 @@ type MonitorType uint32
 const (
 	NoneType MonitorType = iota
 	LocalType
+	HTTPType
+	GitType
 )
 
 func (s MonitorType) String() string {
-	return [...]string{"none", "local"}[s]
+	return [...]string{"none", "local", "http", "git"}[s]
+}
+
+// IsRemote returns true if the monitor fetches policies from a remote location rather than the policies path.
+func (s MonitorType) IsRemote() bool {
+	return s == HTTPType || s == GitType
 }
 
 func parseMonitorType(s string) MonitorType {
@@ -154,6 +385,12 @@ func parseMonitorType(s string) MonitorType {
 	if LocalType.String() == s {
 		return LocalType
 	}
+	if HTTPType.String() == s {
+		return HTTPType
+	}
+	if GitType.String() == s {
+		return GitType
+	}
 	return NoneType
 }
 
@@ -164,18 +401,22 @@ type Language uint32
 const (
 	Falco Language = iota
 	Sigma
+	Mixed
 )
 
 func (s Language) String() string {
-	return [...]string{"falco", "sigma"}[s]
+	return [...]string{"falco", "sigma", "mixed"}[s]
 }
 
-func parseLanguage(s string) Language {
+func parseLanguage(s string) (Language, error) {
 	if Falco.String() == s {
-		return Falco
+		return Falco, nil
 	}
 	if Sigma.String() == s {
-		return Sigma
+		return Sigma, nil
+	}
+	if Mixed.String() == s {
+		return Mixed, nil
 	}
-	return Falco
+	return Falco, fmt.Errorf("invalid policy language %s, expected %s, %s or %s", s, Falco, Sigma, Mixed)
 }
@@ -22,7 +22,10 @@
 package engine
 
 import (
+	"log"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/paulbellamy/ratecounter"
@@ -40,16 +43,40 @@ type PolicyInterpreter[R any] struct {
 	// Configuration
 	config Config
 
-	// Prefilter
+	// Prefilter, and its rule index if supported
 	prefilter source.Prefilter[R]
+	indexer   source.PrefilterIndexer[R]
 
 	// Record contextualizer
 	ctx source.Contextualizer[R]
 
+	// Record correlator
+	cr source.Correlator[R]
+
 	// Parsed rule and filter object maps
 	rules   []policy.Rule[R]
 	filters []policy.Filter[R]
 
+	// Rules applied to records; replaced as a whole when rules are enabled or disabled at runtime
+	live   atomic.Pointer[[]policy.Rule[R]]
+	liveMu sync.Mutex
+
+	// Policy files compiled into the interpreter
+	policySet PolicySet
+
+	// Predicate program shared by all rules and filters
+	prog *policy.Program[R]
+
+	// Positions of the rules applicable to records, indexed by prefilter value, and of the
+	// rules applicable to records with prefilter values not in the index
+	index   map[string][]int
+	generic []int
+
+	// Sequence and threshold matchers, and alert suppressors, indexed by rule position
+	seqs        []*sequenceMatcher[R]
+	thresholds  []*thresholdMatcher[R]
+	suppressors []*suppressor[R]
+
 	// Worker channel and waitgroup
 	workerCh chan R
 	wg       *sync.WaitGroup
@@ -66,24 +93,40 @@ type PolicyInterpreter[R any] struct {
 	// Rate counter
 	rc       *ratecounter.RateCounter
 	lastRcTs time.Time
+
+	// Rule and filter profiler, if profiling is enabled, and its reporter's stop channel and waitgroup
+	prof       *profiler
+	profStopCh chan struct{}
+	profWg     *sync.WaitGroup
+
+	// Lookup lists referenced by rules and filters, and their watchers' stop channel and waitgroup
+	lookups      []*policy.Lookup
+	lookupStopCh chan struct{}
+	lookupWg     *sync.WaitGroup
 }
 
 // NewPolicyInterpreter constructs a new interpreter instance.
-func NewPolicyInterpreter[R any](conf Config, pc policy.PolicyCompiler[R], pf source.Prefilter[R], ctx source.Contextualizer[R], out func(R)) *PolicyInterpreter[R] {
+func NewPolicyInterpreter[R any](conf Config, pc policy.PolicyCompiler[R], pf source.Prefilter[R], ctx source.Contextualizer[R], cr source.Correlator[R], out func(R)) *PolicyInterpreter[R] {
 	pi := new(PolicyInterpreter[R])
 	pi.pc = pc
 	if pi.prefilter = pf; pf == nil {
 		pi.prefilter = source.NewDefaultPrefilter[R]()
 	}
+	pi.indexer, _ = pi.prefilter.(source.PrefilterIndexer[R])
 	if pi.ctx = ctx; ctx == nil {
 		pi.ctx = source.NewDefaultContextualizer[R]()
 	}
+	if pi.cr = cr; cr == nil {
+		pi.cr = source.NewDefaultCorrelator[R]()
+	}
 	pi.config = conf
 	pi.concurrency = conf.Concurrency
 	pi.rules = make([]policy.Rule[R], 0)
+	pi.live.Store(new([]policy.Rule[R]))
 	pi.filters = make([]policy.Filter[R], 0)
+	pi.prog = policy.NewProgram[R]()
 	pi.out = out
-	pi.ah = NewActionHandler[R](conf)
+	pi.ah = NewActionHandler[R](conf, pi.ctx)
 
 	// This should only be used for benchmarking the engine
 	if logger.IsEnabled(logger.Perf) {
@@ -97,11 +140,29 @@ func NewPolicyInterpreter[R any](conf Config, pc policy.PolicyCompiler[R], pf so
 func (pi *PolicyInterpreter[R]) StartWorkers() {
 	logger.Trace.Printf("Starting policy engine's thread pool with %d workers", pi.concurrency)
 	pi.workerCh = make(chan R, pi.concurrency)
+	pi.ah.Start()
 	pi.wg = new(sync.WaitGroup)
 	pi.wg.Add(pi.concurrency)
 	for i := 0; i < pi.concurrency; i++ {
 		go pi.worker()
 	}
+	if pi.prof != nil && pi.config.ProfileInterval > 0 {
+		pi.profStopCh = make(chan struct{})
+		pi.profWg = new(sync.WaitGroup)
+		pi.profWg.Add(1)
+		go pi.reportProfile()
+	}
+	if len(pi.lookups) > 0 {
+		pi.lookupStopCh = make(chan struct{})
+		pi.lookupWg = new(sync.WaitGroup)
+		pi.lookupWg.Add(len(pi.lookups))
+		for _, l := range pi.lookups {
+			go func(l *policy.Lookup, stop <-chan struct{}) {
+				defer pi.lookupWg.Done()
+				l.Watch(stop)
+			}(l, pi.lookupStopCh)
+		}
+	}
 }
 
 // StopWorkers stops the worker pool and waits for all tasks to finish.
@@ -109,34 +170,248 @@ func (pi *PolicyInterpreter[R]) StopWorkers() {
 	logger.Trace.Println("Stopping policy engine's thread pool")
 	close(pi.workerCh)
 	pi.wg.Wait()
+	pi.ah.Stop()
+	pi.logActionStats(logger.Trace)
+	if pi.profStopCh != nil {
+		close(pi.profStopCh)
+		pi.profWg.Wait()
+		pi.profStopCh = nil
+	}
+	if pi.prof != nil {
+		pi.exportProfile()
+	}
+	if pi.lookupStopCh != nil {
+		close(pi.lookupStopCh)
+		pi.lookupWg.Wait()
+		pi.lookupStopCh = nil
+	}
+}
+
+// ActionStats returns the execution counters of the actions bound to rules, indexed by action name.
+func (pi *PolicyInterpreter[R]) ActionStats() map[string]ActionStats {
+	return pi.ah.Stats()
+}
+
+// Profile returns a snapshot of the evaluation counters of rules and filters, and false if profiling is disabled.
+func (pi *PolicyInterpreter[R]) Profile() (Profile, bool) {
+	if pi.prof == nil {
+		return Profile{}, false
+	}
+	return pi.prof.snapshot(), true
+}
+
+// reportProfile periodically exports the profile until the reporter is stopped.
+func (pi *PolicyInterpreter[R]) reportProfile() {
+	defer pi.profWg.Done()
+	ticker := time.NewTicker(pi.config.ProfileInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			pi.exportProfile()
+		case <-pi.profStopCh:
+			return
+		}
+	}
+}
+
+// exportProfile logs the counters of the most expensive rules, and writes the profile to the configured path, if any.
+func (pi *PolicyInterpreter[R]) exportProfile() {
+	prof := pi.prof.snapshot()
+	prof.Log(logger.Info, profileLogRules)
+	if pi.config.ProfilePath != "" {
+		if err := prof.Write(pi.config.ProfilePath); err != nil {
+			logger.Error.Printf("Unable to write policy engine profile to %s: %v", pi.config.ProfilePath, err)
+		}
+	}
 }
 
-// Compile parses and interprets a set of input policies defined in paths.
+// logActionStats logs the execution counters of actions.
+func (pi *PolicyInterpreter[R]) logActionStats(l *log.Logger) {
+	stats := pi.ActionStats()
+	names := make([]string, 0, len(stats))
+	for name := range stats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s := stats[name]
+		l.Printf("Action '%s': runs %d, failures %d, timeouts %d, retries %d, dropped %d", name, s.Runs, s.Failures, s.Timeouts, s.Retries, s.Dropped)
+	}
+}
+
+// Compile parses and interprets a set of input policies defined in paths, and binds the actions of
+// rules. If compilation or action diagnostics contain errors, the returned error is the list of
+// policy.Diagnostics.
 func (pi *PolicyInterpreter[R]) Compile(paths ...string) (err error) {
 	if pi.rules, pi.filters, err = pi.pc.Compile(paths...); err != nil {
 		return err
 	}
-	if logger.IsEnabled(logger.Perf) {
-		if pi.config.BenchRuleIndex >= 0 && pi.config.BenchRuleIndex < len(pi.rules) {
-			pi.rules = append(make([]policy.Rule[R], 0), pi.rules[pi.config.BenchRuleIndex])
-		} else if pi.config.BenchRulesetSize >= 0 && pi.config.BenchRulesetSize <= len(pi.rules) {
-			pi.rules = append(make([]policy.Rule[R], 0), pi.rules[:pi.config.BenchRulesetSize]...)
+	if diags := pi.ah.CheckActions(pi.rules); diags.HasErrors() {
+		return diags
+	}
+	pi.link()
+	pi.buildIndex()
+	pi.seqs = make([]*sequenceMatcher[R], len(pi.rules))
+	pi.thresholds = make([]*thresholdMatcher[R], len(pi.rules))
+	pi.suppressors = make([]*suppressor[R], len(pi.rules))
+	for i, r := range pi.rules {
+		if r.Sequence != nil {
+			pi.seqs[i] = newSequenceMatcher(r.Sequence, pi.cr, pi.config.StateMaxKeys)
 		}
-		logger.Perf.Printf("Benchmarking %d rule(s)", len(pi.rules))
-		for _, r := range pi.rules {
-			logger.Perf.Printf("Rule Name: %s, Description: %-50s", r.Name, r.Desc)
+		if r.Threshold != nil {
+			pi.thresholds[i] = newThresholdMatcher(r.Threshold, pi.cr, pi.config.StateMaxKeys)
 		}
+		if sup := ruleSuppression(r, pi.config.Suppress); sup != nil {
+			pi.suppressors[i] = newSuppressor(sup, pi.cr, pi.config.StateMaxKeys)
+		}
+	}
+	if lp, ok := pi.pc.(policy.LookupProvider); ok {
+		pi.lookups = lp.Lookups()
+	}
+	live := append([]policy.Rule[R](nil), pi.rules...)
+	pi.live.Store(&live)
+	if pi.prof = nil; pi.config.Profile {
+		pi.prof = newProfiler(pi.rules, pi.filters)
 	}
 	logger.Info.Printf("Policy engine loaded %d rules and %d prefilters", len(pi.rules), len(pi.filters))
-	pi.ah.CheckActions(pi.rules)
 	return nil
 }
 
+// Rules returns the compiled rules, with their current enabled state.
+func (pi *PolicyInterpreter[R]) Rules() []policy.Rule[R] {
+	return append([]policy.Rule[R](nil), *pi.live.Load()...)
+}
+
+// SetRuleEnabled enables or disables the rules with the given name without recompiling policies, and
+// returns false if no such rule exists. Records being processed may still be evaluated against the
+// rules' previous state.
+func (pi *PolicyInterpreter[R]) SetRuleEnabled(name string, enabled bool) bool {
+	pi.liveMu.Lock()
+	defer pi.liveMu.Unlock()
+	rules := append([]policy.Rule[R](nil), *pi.live.Load()...)
+	found := false
+	for i := range rules {
+		if rules[i].Name == name {
+			rules[i].Enabled = enabled
+			found = true
+		}
+	}
+	if found {
+		pi.live.Store(&rules)
+	}
+	return found
+}
+
+// PolicySet returns the policy files compiled into the interpreter, if set.
+func (pi *PolicyInterpreter[R]) PolicySet() PolicySet {
+	return pi.policySet
+}
+
+// SetPolicySet records the policy files compiled into the interpreter.
+func (pi *PolicyInterpreter[R]) SetPolicySet(ps PolicySet) {
+	pi.policySet = ps
+}
+
+// link compiles the conditions of all rules and filters into a shared predicate program.
+func (pi *PolicyInterpreter[R]) link() {
+	pi.prog = policy.NewProgram[R]()
+	for i := range pi.rules {
+		pi.rules[i].Condition = pi.prog.Add(pi.rules[i].Condition)
+		if seq := pi.rules[i].Sequence; seq != nil {
+			for j := range seq.Steps {
+				seq.Steps[j] = pi.prog.Add(seq.Steps[j])
+			}
+		}
+	}
+	for i := range pi.filters {
+		pi.filters[i].Condition = pi.prog.Add(pi.filters[i].Condition)
+	}
+	logger.Trace.Printf("Policy engine compiled %d distinct predicates", pi.prog.Leaves())
+}
+
+// buildIndex indexes rules by the prefilter values of the records they may match. These combine the
+// explicit prefilter of a rule with the values inferred from its condition. Without a prefilter indexer,
+// all rules are checked against each record.
+func (pi *PolicyInterpreter[R]) buildIndex() {
+	pi.index, pi.generic = nil, nil
+	if pi.indexer == nil {
+		for i := range pi.rules {
+			pi.generic = append(pi.generic, i)
+		}
+		return
+	}
+	values := make([][]string, len(pi.rules))
+	pi.index = make(map[string][]int)
+	for i, rule := range pi.rules {
+		pf, ok := rulePrefilter(rule)
+		if !ok {
+			pi.generic = append(pi.generic, i)
+			continue
+		}
+		if len(pf) == 0 {
+			logger.Warn.Printf("Rule %s cannot match any record type", rule.Name)
+		}
+		values[i] = pf
+		for _, v := range pf {
+			pi.index[v] = nil
+		}
+	}
+	for i := range pi.rules {
+		if values[i] == nil {
+			for v := range pi.index {
+				pi.index[v] = append(pi.index[v], i)
+			}
+			continue
+		}
+		for _, v := range values[i] {
+			pi.index[v] = append(pi.index[v], i)
+		}
+	}
+	logger.Trace.Printf("Policy engine indexed %d rules by %d prefilter values", len(pi.rules)-len(pi.generic), len(pi.index))
+}
+
+// rulePrefilter returns the prefilter values of the records that a rule may match, and false if
+// it may match any record. Sequence rules are only restricted by their explicit prefilter.
+func rulePrefilter[R any](rule policy.Rule[R]) ([]string, bool) {
+	inferred, ok := []string(nil), false
+	if rule.Sequence == nil {
+		inferred, ok = rule.Condition.Prefilter()
+	}
+	if len(rule.Prefilter) == 0 {
+		return inferred, ok
+	}
+	if !ok {
+		return rule.Prefilter, true
+	}
+	values := []string{}
+	for _, v := range rule.Prefilter {
+		for _, w := range inferred {
+			if v == w {
+				values = append(values, v)
+				break
+			}
+		}
+	}
+	return values, true
+}
+
+// applicable returns the positions of the rules applicable to r, in rule order.
+func (pi *PolicyInterpreter[R]) applicable(r R) []int {
+	if pi.indexer != nil {
+		if rules, ok := pi.index[pi.indexer.Value(r)]; ok {
+			return rules
+		}
+	}
+	return pi.generic
+}
+
 // ProcessAsync queues the record for processing in the worker pool.
 func (pi *PolicyInterpreter[R]) ProcessAsync(r R) {
 	pi.workerCh <- r
 	if logger.IsEnabled(logger.Perf) && time.Since(pi.lastRcTs) > (15*time.Second) {
 		logger.Perf.Println("Policy engine rate (events/sec): ", pi.rc.Rate())
+		pi.logActionStats(logger.Perf)
 		pi.lastRcTs = time.Now()
 	}
 }
@@ -156,37 +431,109 @@ func (pi *PolicyInterpreter[R]) worker() {
 			pi.rc.Incr(1)
 		}
 
-		// Drop record if any drop rule applied
-		if pi.evalFilters(r) {
-			continue
-		}
+		pi.Process(r)
+	}
+	pi.wg.Done()
+}
 
-		// Enrich mode is non-blocking: Push record even if no rule matches
-		match := (pi.config.Mode == EnrichMode)
+// Process applies all compiled policies to a record in the caller's goroutine, enriches the record
+// if rules match, and sends it downstream. Records are processed in order, which makes it suitable
+// for replaying traces.
+func (pi *PolicyInterpreter[R]) Process(r R) {
+	// Memoize predicate results shared by rules
+	m := pi.prog.NewMemo()
+	defer pi.prog.Release(m)
+	if pi.prof != nil {
+		pi.prof.records.Add(1)
+	}
 
-		// Apply rules
-		for _, rule := range pi.rules {
-			if rule.Enabled && pi.prefilter.IsApplicable(r, rule) && rule.Condition.Eval(r) {
-				if pi.ctx != nil {
-					pi.ctx.AddRules(r, rule)
-				}
-				pi.ah.HandleActions(rule, r)
-				match = true
-			}
+	// Drop record if any drop rule applied
+	if pi.evalFilters(r, m) {
+		return
+	}
+
+	// Enrich mode is non-blocking: Push record even if no rule matches
+	match := (pi.config.Mode == EnrichMode)
+
+	// Apply rules
+	var matched []policy.Rule[R]
+	rules := *pi.live.Load()
+	for _, i := range pi.applicable(r) {
+		rule := rules[i]
+		if rule.Enabled && (pi.indexer != nil || pi.prefilter.IsApplicable(r, rule)) && pi.profileEval(i, rule, r, m) {
+			pi.ctx.AddRules(r, rule)
+			matched = append(matched, rule)
+			match = true
 		}
+	}
 
-		// Push record if a rule matches (or if mode is enrich)
+	// Push record if a rule matches (or if mode is enrich), once blocking actions have enriched it
+	pi.ah.HandleActions(matched, r, func() {
 		if match && pi.out != nil {
 			pi.out(r)
 		}
+	})
+}
+
+// profileEval evaluates the i-th rule against r, recording its evaluation time if profiling is enabled.
+func (pi *PolicyInterpreter[R]) profileEval(i int, rule policy.Rule[R], r R, m *policy.Memo) bool {
+	if pi.prof == nil {
+		return pi.eval(i, rule, r, m)
 	}
-	pi.wg.Done()
+	start := time.Now()
+	matched := pi.eval(i, rule, r, m)
+	pi.prof.rule(i, time.Since(start), matched)
+	return matched
+}
+
+// eval evaluates the i-th rule against r, updating the state of sequence and threshold rules, and
+// of alert suppressions.
+func (pi *PolicyInterpreter[R]) eval(i int, rule policy.Rule[R], r R, m *policy.Memo) bool {
+	var ids []string
+	if rule.Sequence != nil {
+		var ok bool
+		if ids, ok = pi.seqs[i].Eval(r, m); !ok {
+			return false
+		}
+	} else if !rule.Condition.EvalMemo(r, m) {
+		return false
+	}
+	if rule.Threshold != nil {
+		count, ok := pi.thresholds[i].Eval(r)
+		if !ok {
+			return false
+		}
+		pi.ctx.SetCount(r, rule.Name, count)
+	}
+	if s := pi.suppressors[i]; s != nil {
+		suppressed, ok := s.Eval(r)
+		if !ok {
+			return false
+		}
+		if suppressed > 0 {
+			pi.ctx.SetSuppressed(r, rule.Name, suppressed)
+		}
+	}
+	if len(ids) > 0 {
+		pi.ctx.AddCorrelatedIDs(r, ids...)
+	}
+	if rule.Output != nil {
+		pi.ctx.SetOutput(r, rule.Name, rule.Output.Render(r))
+	}
+	return true
 }
 
 // EvalFilters executes compiled policy filters against record r.
-func (pi *PolicyInterpreter[R]) evalFilters(r R) bool {
-	for _, f := range pi.filters {
-		if f.Enabled && f.Condition.Eval(r) {
+func (pi *PolicyInterpreter[R]) evalFilters(r R, m *policy.Memo) bool {
+	for i, f := range pi.filters {
+		if !f.Enabled {
+			continue
+		}
+		dropped := f.Condition.EvalMemo(r, m)
+		if pi.prof != nil {
+			pi.prof.filter(i, dropped)
+		}
+		if dropped {
 			return true
 		}
 	}
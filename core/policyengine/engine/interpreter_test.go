@@ -20,20 +20,24 @@ package engine
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/sysflow-telemetry/sf-apis/go/ioutils"
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy/falco"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy/sigma"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord/flatrecordtest"
 )
 
 var pi *PolicyInterpreter[*flatrecord.Record]
 
 func SetupInterpreter(m *testing.M) {
 	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
-	pi = NewPolicyInterpreter(Config{}, pc, nil, nil, nil)
+	pi = NewPolicyInterpreter(Config{}, pc, nil, nil, nil, nil)
 	os.Exit(m.Run())
 }
 
@@ -54,9 +58,91 @@ func TestCompileDist(t *testing.T) {
 func TestCompileSigma(t *testing.T) {
 	logger.Trace.Println("Running test compile")
 	pc := sigma.NewPolicyCompiler(flatrecord.NewOperations(), "../../../resources/policies/sigma/config/sysflow.yml")
-	pi = NewPolicyInterpreter(Config{}, pc, nil, nil, nil)
+	pi = NewPolicyInterpreter(Config{}, pc, nil, nil, nil, nil)
 	paths, err := ioutils.ListFilePaths("../../../resources/policies/sigma/rules/linux/process_creation/proc_creation_lnx_webshell_detection.yml", ".yml")
 	assert.NoError(t, err)
 	assert.NoError(t, pi.Compile(paths...))
 	t.Logf("Rules: %d\n", len(pi.rules))
 }
+
+func TestCompileActions(t *testing.T) {
+	// invalid action references fail compilation, whereas unknown actions are only reported
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	pi := NewPolicyInterpreter(Config{}, pc, nil, flatrecord.NewContextualizer(), nil, nil)
+	err := pi.Compile("../../../resources/policies/tests/actions/policy.yaml")
+	var diags policy.Diagnostics
+	assert.ErrorAs(t, err, &diags)
+	assert.Equal(t, 2, diags.Count(policy.SeverityError))
+
+	pc = falco.NewPolicyCompiler(flatrecord.NewOperations())
+	pi = NewPolicyInterpreter(Config{}, pc, nil, flatrecord.NewContextualizer(), nil, nil)
+	assert.NoError(t, pi.Compile("../../../resources/policies/runtimeintegrity/path.yaml"))
+}
+
+func TestPrefilterIndex(t *testing.T) {
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	pi := NewPolicyInterpreter(Config{}, pc, flatrecord.NewPrefilter(), nil, nil, nil)
+	assert.NoError(t, pi.Compile("../../../resources/policies/tests/prefilter/policy.yaml"))
+	assert.Len(t, pi.rules, 6)
+	assert.Equal(t, []int{3, 4}, pi.generic)
+	assert.Equal(t, map[string][]int{
+		"PE": {0, 2, 3, 4},
+		"NF": {1, 3, 4},
+		"FE": {2, 3, 4},
+		"FF": {2, 3, 4},
+	}, pi.index)
+}
+
+func TestSuppressAlerts(t *testing.T) {
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	var alerts []*flatrecord.Record
+	pi := NewPolicyInterpreter(Config{Mode: AlertMode, StateMaxKeys: 10}, pc, flatrecord.NewPrefilter(), flatrecord.NewContextualizer(), flatrecord.NewCorrelator(), func(r *flatrecord.Record) { alerts = append(alerts, r) })
+	assert.NoError(t, pi.Compile("../../../resources/policies/tests/unit_test_suppress.yaml"))
+
+	exec := func(exe string, ts time.Duration) *flatrecord.Record {
+		fr := flatrecordtest.NewFlatRecord(sfgo.PROC_EVT)
+		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.EV_PROC_OPFLAGS_INT] = sfgo.OP_EXEC
+		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.TS_INT] = int64(ts)
+		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXE_STR] = exe
+		return flatrecord.NewRecord(fr)
+	}
+	for _, r := range []*flatrecord.Record{
+		exec("/bin/bash", 0),
+		exec("/bin/bash", time.Minute),
+		exec("/bin/sh", 2*time.Minute),
+		exec("/bin/bash", 5*time.Minute),
+		exec("/bin/bash", 11*time.Minute),
+	} {
+		pi.Process(r)
+	}
+	assert.Len(t, alerts, 3)
+	rule := alerts[2].Ctx.GetRules()[0]
+	assert.Equal(t, 0, alerts[0].Ctx.GetSuppressed(rule.Name))
+	assert.Equal(t, 0, alerts[1].Ctx.GetSuppressed(rule.Name))
+	assert.Equal(t, 2, alerts[2].Ctx.GetSuppressed(rule.Name))
+}
+
+func TestSetRuleEnabled(t *testing.T) {
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	var alerts []*flatrecord.Record
+	pi := NewPolicyInterpreter(Config{Mode: AlertMode}, pc, flatrecord.NewPrefilter(), flatrecord.NewContextualizer(), nil, func(r *flatrecord.Record) { alerts = append(alerts, r) })
+	assert.NoError(t, pi.Compile("../../../resources/policies/tests/replay/policy.yaml"))
+
+	exec := func(exe string) *flatrecord.Record {
+		fr := flatrecordtest.NewFlatRecord(sfgo.PROC_EVT)
+		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.EV_PROC_OPFLAGS_INT] = sfgo.OP_EXEC
+		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXE_STR] = exe
+		return flatrecord.NewRecord(fr)
+	}
+	assert.False(t, pi.SetRuleEnabled("Missing rule", false))
+	assert.True(t, pi.SetRuleEnabled("Shell spawned", false))
+	assert.False(t, pi.Rules()[0].Enabled)
+	assert.True(t, pi.rules[0].Enabled)
+	pi.Process(exec("/bin/bash"))
+	pi.Process(exec("/usr/bin/curl"))
+	assert.Len(t, alerts, 1)
+
+	assert.True(t, pi.SetRuleEnabled("Shell spawned", true))
+	pi.Process(exec("/bin/bash"))
+	assert.Len(t, alerts, 2)
+}
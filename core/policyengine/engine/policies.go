@@ -0,0 +1,109 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/sysflow-telemetry/sf-apis/go/ioutils"
+)
+
+// PolicyExts lists the extensions of policy files.
+var PolicyExts = []string{".yaml", ".yml"}
+
+// IsPolicyFile returns true if path has a policy file extension.
+func IsPolicyFile(path string) bool {
+	ext := filepath.Ext(path)
+	for _, e := range PolicyExts {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+// ListPolicies lists the policy files found in dir and its subdirectories.
+func ListPolicies(dir string) ([]string, error) {
+	paths, err := ioutils.ListRecursiveFilePaths(dir, PolicyExts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("no policy files with extension .yaml or .yml found in path: " + dir)
+	}
+	return paths, nil
+}
+
+// PolicySet identifies the policy files compiled into an interpreter.
+type PolicySet struct {
+	// SHA-256 digest of the checksums and paths of the policy files
+	Version string `json:"version"`
+	// SHA-256 checksums of the policy files, indexed by path relative to the directory
+	Files map[string]string `json:"files"`
+}
+
+// NewPolicySet computes the checksums of the policy files found at paths under dir. The version
+// of the set only depends on the contents and relative paths of its files.
+func NewPolicySet(dir string, paths []string) (PolicySet, error) {
+	ps := PolicySet{Files: make(map[string]string, len(paths))}
+	for _, path := range paths {
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || rel == "." {
+			rel = filepath.Base(path)
+		}
+		sum, err := fileChecksum(path)
+		if err != nil {
+			return ps, err
+		}
+		ps.Files[filepath.ToSlash(rel)] = sum
+	}
+	names := make([]string, 0, len(ps.Files))
+	for name := range ps.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	h := sha256.New()
+	for _, name := range names {
+		io.WriteString(h, ps.Files[name]+"  "+name+"\n") //nolint:errcheck
+	}
+	ps.Version = hex.EncodeToString(h.Sum(nil))
+	return ps, nil
+}
+
+// fileChecksum returns the hex-encoded SHA-256 checksum of a file.
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
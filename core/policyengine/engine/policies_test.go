@@ -0,0 +1,56 @@
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicySet(t *testing.T) {
+	write := func(dir, name, content string) {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+	newSet := func(dir string) PolicySet {
+		paths, err := ListPolicies(dir)
+		assert.NoError(t, err)
+		ps, err := NewPolicySet(dir, paths)
+		assert.NoError(t, err)
+		return ps
+	}
+	a, b := t.TempDir(), t.TempDir()
+	write(a, "rules.yaml", "- list: a\n")
+	write(a, "sub/macros.yml", "- list: b\n")
+	write(b, "sub/macros.yml", "- list: b\n")
+	write(b, "rules.yaml", "- list: a\n")
+
+	ps := newSet(a)
+	assert.Len(t, ps.Files, 2)
+	assert.Len(t, ps.Version, 64)
+	assert.Contains(t, ps.Files, "sub/macros.yml")
+	assert.Equal(t, ps, newSet(b))
+
+	write(b, "rules.yaml", "- list: c\n")
+	assert.NotEqual(t, ps.Version, newSet(b).Version)
+	assert.Equal(t, ps.Files["sub/macros.yml"], newSet(b).Files["sub/macros.yml"])
+}
@@ -0,0 +1,236 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"encoding/json"
+	"log"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+)
+
+// Number of most expensive rules included in profile logs.
+const profileLogRules = 10
+
+// RuleStats defines the evaluation counters of a rule.
+type RuleStats struct {
+	Name string `json:"name"`
+	// Number of records against which the rule was evaluated
+	Evals uint64 `json:"evals"`
+	// Number of records that matched the rule
+	Matches uint64 `json:"matches"`
+	// Cumulative evaluation time
+	Time time.Duration `json:"time_ns"`
+	// 99th percentile of the evaluation time
+	P99 time.Duration `json:"p99_ns"`
+}
+
+// FilterStats defines the evaluation counters of a filter.
+type FilterStats struct {
+	Name string `json:"name"`
+	// Number of records against which the filter was evaluated
+	Evals uint64 `json:"evals"`
+	// Number of records dropped by the filter
+	Drops uint64 `json:"drops"`
+}
+
+// Profile defines a snapshot of the evaluation counters of rules and filters, in policy order.
+type Profile struct {
+	Records uint64        `json:"records"`
+	Rules   []RuleStats   `json:"rules"`
+	Filters []FilterStats `json:"filters"`
+}
+
+// Log logs the counters of the n rules with the highest cumulative evaluation time, or of all
+// rules if n is not positive, and of all filters.
+func (p Profile) Log(l *log.Logger, n int) {
+	rules := append([]RuleStats(nil), p.Rules...)
+	sort.SliceStable(rules, func(i, j int) bool { return rules[i].Time > rules[j].Time })
+	if n > 0 && n < len(rules) {
+		rules = rules[:n]
+	}
+	l.Printf("Policy engine profile: %d records, %d rules, %d filters", p.Records, len(p.Rules), len(p.Filters))
+	for _, s := range rules {
+		l.Printf("Rule '%s': evals %d, matches %d, time %v, p99 %v", s.Name, s.Evals, s.Matches, s.Time, s.P99)
+	}
+	for _, s := range p.Filters {
+		l.Printf("Filter '%s': evals %d, drops %d", s.Name, s.Evals, s.Drops)
+	}
+}
+
+// Write writes the profile in JSON format to path, replacing any previous profile atomically.
+func (p Profile) Write(path string) error {
+	b, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Latencies are bucketed by their power of two, and each power of two is split into histSubBuckets
+// linear sub-buckets, which bounds the relative error of quantiles by 1/histSubBuckets.
+const (
+	histSubBits    = 3
+	histSubBuckets = 1 << histSubBits
+	histBuckets    = (64 - histSubBits + 1) * histSubBuckets
+)
+
+// latencyHistogram is a lock-free histogram of evaluation times in nanoseconds.
+type latencyHistogram [histBuckets]atomic.Uint64
+
+// bucket returns the histogram bucket of a latency.
+func (h *latencyHistogram) bucket(ns uint64) int {
+	if ns < histSubBuckets {
+		return int(ns)
+	}
+	exp := bits.Len64(ns) - histSubBits - 1
+	return (exp+1)*histSubBuckets + int((ns>>exp)&(histSubBuckets-1))
+}
+
+// upper returns the largest latency of a histogram bucket.
+func (h *latencyHistogram) upper(b int) uint64 {
+	if b < histSubBuckets {
+		return uint64(b)
+	}
+	exp := b/histSubBuckets - 1
+	lower := uint64(histSubBuckets+b%histSubBuckets) << exp
+	return lower + (uint64(1) << exp) - 1
+}
+
+func (h *latencyHistogram) observe(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	h[h.bucket(uint64(d))].Add(1)
+}
+
+// quantile returns an upper bound of the q-quantile of the observed latencies.
+func (h *latencyHistogram) quantile(q float64) time.Duration {
+	var counts [histBuckets]uint64
+	var total uint64
+	for i := range h {
+		counts[i] = h[i].Load()
+		total += counts[i]
+	}
+	if total == 0 {
+		return 0
+	}
+	rank := uint64(q*float64(total) + 0.5)
+	if rank < 1 {
+		rank = 1
+	}
+	var seen uint64
+	for i, c := range counts {
+		if seen += c; seen >= rank {
+			return time.Duration(h.upper(i))
+		}
+	}
+	return time.Duration(h.upper(histBuckets - 1))
+}
+
+// ruleCounters holds the evaluation counters of a rule.
+type ruleCounters struct {
+	evals, matches, nanos atomic.Uint64
+	hist                  latencyHistogram
+}
+
+// filterCounters holds the evaluation counters of a filter.
+type filterCounters struct {
+	evals, drops atomic.Uint64
+}
+
+// profiler tracks the evaluation counters of the rules and filters of an interpreter, indexed by position.
+type profiler struct {
+	records     atomic.Uint64
+	ruleNames   []string
+	rules       []ruleCounters
+	filterNames []string
+	filters     []filterCounters
+}
+
+func newProfiler[R any](rules []policy.Rule[R], filters []policy.Filter[R]) *profiler {
+	p := &profiler{rules: make([]ruleCounters, len(rules)), filters: make([]filterCounters, len(filters))}
+	for _, r := range rules {
+		p.ruleNames = append(p.ruleNames, r.Name)
+	}
+	for _, f := range filters {
+		p.filterNames = append(p.filterNames, f.Name)
+	}
+	return p
+}
+
+// rule records an evaluation of the i-th rule.
+func (p *profiler) rule(i int, d time.Duration, matched bool) {
+	c := &p.rules[i]
+	c.evals.Add(1)
+	if matched {
+		c.matches.Add(1)
+	}
+	c.nanos.Add(uint64(d))
+	c.hist.observe(d)
+}
+
+// filter records an evaluation of the i-th filter.
+func (p *profiler) filter(i int, dropped bool) {
+	c := &p.filters[i]
+	c.evals.Add(1)
+	if dropped {
+		c.drops.Add(1)
+	}
+}
+
+// snapshot returns the current counters.
+func (p *profiler) snapshot() Profile {
+	prof := Profile{Records: p.records.Load(), Rules: make([]RuleStats, len(p.rules)), Filters: make([]FilterStats, len(p.filters))}
+	for i := range p.rules {
+		c := &p.rules[i]
+		prof.Rules[i] = RuleStats{
+			Name:    p.ruleNames[i],
+			Evals:   c.evals.Load(),
+			Matches: c.matches.Load(),
+			Time:    time.Duration(c.nanos.Load()),
+			P99:     c.hist.quantile(0.99),
+		}
+	}
+	for i := range p.filters {
+		c := &p.filters[i]
+		prof.Filters[i] = FilterStats{Name: p.filterNames[i], Evals: c.evals.Load(), Drops: c.drops.Load()}
+	}
+	return prof
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package engine
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy/falco"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord/flatrecordtest"
+)
+
+func TestLatencyHistogram(t *testing.T) {
+	h := new(latencyHistogram)
+	for b := 0; b < histBuckets; b++ {
+		assert.Equal(t, b, h.bucket(h.upper(b)))
+		if b > 0 {
+			assert.Equal(t, b, h.bucket(h.upper(b-1)+1))
+		}
+	}
+	assert.Equal(t, time.Duration(0), h.quantile(0.99))
+	for i := 1; i <= 100; i++ {
+		h.observe(time.Duration(i) * time.Microsecond)
+	}
+	p99 := h.quantile(0.99)
+	assert.GreaterOrEqual(t, p99, 99*time.Microsecond)
+	assert.LessOrEqual(t, p99, 99*time.Microsecond+99*time.Microsecond/histSubBuckets)
+	assert.GreaterOrEqual(t, h.quantile(0.5), 50*time.Microsecond)
+	assert.Less(t, h.quantile(0.5), 57*time.Microsecond)
+}
+
+func TestProfileConfig(t *testing.T) {
+	conf, err := CreateConfig(map[string]interface{}{})
+	assert.NoError(t, err)
+	assert.False(t, conf.Profile)
+	assert.Equal(t, 60*time.Second, conf.ProfileInterval)
+
+	conf, err = CreateConfig(map[string]interface{}{ProfileKey: "true", ProfileIntervalKey: "10", ProfilePathKey: "/tmp/profile.json"})
+	assert.NoError(t, err)
+	assert.True(t, conf.Profile)
+	assert.Equal(t, 10*time.Second, conf.ProfileInterval)
+	assert.Equal(t, "/tmp/profile.json", conf.ProfilePath)
+
+	_, err = CreateConfig(map[string]interface{}{ProfileKey: "yes"})
+	assert.Error(t, err)
+}
+
+func newProfileRecord(tp int64, exe string, opflags int64) *flatrecord.Record {
+	fr := flatrecordtest.NewFlatRecord(tp)
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.EV_PROC_OPFLAGS_INT] = opflags
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.FL_NETW_OPFLAGS_INT] = opflags
+	fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXE_STR] = exe
+	return flatrecord.NewRecord(fr)
+}
+
+func TestProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profile.json")
+	var alerts int
+	conf := Config{Mode: AlertMode, Profile: true, ProfileInterval: time.Millisecond, ProfilePath: path, Concurrency: 1}
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	pi := NewPolicyInterpreter(conf, pc, flatrecord.NewPrefilter(), flatrecord.NewContextualizer(), flatrecord.NewCorrelator(), func(r *flatrecord.Record) { alerts++ })
+	assert.NoError(t, pi.Compile("../../../resources/policies/tests/unit_test_profile.yaml"))
+
+	pi.StartWorkers()
+	for _, r := range []*flatrecord.Record{
+		newProfileRecord(sfgo.PROC_EVT, "/bin/bash", sfgo.OP_EXEC),
+		newProfileRecord(sfgo.PROC_EVT, "/bin/ls", sfgo.OP_EXEC),
+		newProfileRecord(sfgo.PROC_EVT, "/usr/bin/curl", sfgo.OP_EXEC),
+		newProfileRecord(sfgo.NET_FLOW, "/usr/bin/curl", sfgo.OP_CONNECT),
+	} {
+		pi.ProcessAsync(r)
+	}
+	pi.StopWorkers()
+	assert.Equal(t, 2, alerts)
+
+	prof, ok := pi.Profile()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(4), prof.Records)
+	assert.Equal(t, []FilterStats{{Name: "drop_curl", Evals: 4, Drops: 1}}, prof.Filters)
+	assert.Len(t, prof.Rules, 2)
+	assert.Equal(t, "Shell spawned", prof.Rules[0].Name)
+	assert.Equal(t, uint64(2), prof.Rules[0].Evals)
+	assert.Equal(t, uint64(1), prof.Rules[0].Matches)
+	assert.Equal(t, "Outbound connection", prof.Rules[1].Name)
+	assert.Equal(t, uint64(1), prof.Rules[1].Evals)
+	assert.Equal(t, uint64(1), prof.Rules[1].Matches)
+	for _, s := range prof.Rules {
+		assert.LessOrEqual(t, s.P99, s.Time+s.Time/histSubBuckets)
+	}
+
+	b, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	var exported Profile
+	assert.NoError(t, json.Unmarshal(b, &exported))
+	assert.Equal(t, prof.Records, exported.Records)
+	assert.Equal(t, prof.Filters, exported.Filters)
+
+	pi = NewPolicyInterpreter(Config{Mode: AlertMode}, pc, nil, nil, nil, nil)
+	assert.NoError(t, pi.Compile("../../../resources/policies/tests/unit_test_profile.yaml"))
+	_, ok = pi.Profile()
+	assert.False(t, ok)
+}
@@ -0,0 +1,165 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
+)
+
+// sequencePartial is a partial match of a sequence rule.
+type sequencePartial struct {
+	start int64
+	ids   []string
+}
+
+// sequenceState stores the partial matches of a sequence rule for a correlation key, indexed by the
+// next expected step. Each step keeps the most recently started partial match reaching it, so that
+// a key holds at most one partial match per step.
+type sequenceState struct {
+	partials []*sequencePartial
+}
+
+// empty returns true if the state holds no partial match.
+func (s *sequenceState) empty() bool {
+	for _, p := range s.partials {
+		if p != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// latest returns the start of the most recently started partial match.
+func (s *sequenceState) latest() int64 {
+	var ts int64
+	for _, p := range s.partials {
+		if p != nil && p.start > ts {
+			ts = p.start
+		}
+	}
+	return ts
+}
+
+// sequenceMatcher matches the ordered steps of a sequence rule across records.
+// Partial matches are kept in a bounded per-key state store. A record matching
+// the first step starts a new partial match without discarding those in progress.
+type sequenceMatcher[R any] struct {
+	seq *policy.Sequence[R]
+	cr  source.Correlator[R]
+	key func(R) string
+
+	mu    sync.Mutex
+	store *stateStore[*sequenceState]
+}
+
+// newSequenceMatcher creates a new sequence matcher for a sequence rule.
+func newSequenceMatcher[R any](seq *policy.Sequence[R], cr source.Correlator[R], maxKeys int) *sequenceMatcher[R] {
+	return &sequenceMatcher[R]{
+		seq:   seq,
+		cr:    cr,
+		key:   cr.Key(seq.GroupBy...),
+		store: newStateStore[*sequenceState](maxKeys),
+	}
+}
+
+// Eval advances the sequence state for record r, memoizing predicate results in memo. It returns the
+// identifiers of all records contributing to the sequence, and true, if r completes the sequence.
+func (m *sequenceMatcher[R]) Eval(r R, memo *policy.Memo) ([]string, bool) {
+	if len(m.seq.Steps) == 0 {
+		return nil, false
+	}
+	key := m.key(r)
+	if key == "" && len(m.seq.GroupBy) > 0 {
+		return nil, false
+	}
+	ts := m.cr.Timestamp(r)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.expire(ts)
+	n := len(m.seq.Steps)
+	s, ok := m.store.get(key)
+	if !ok {
+		s = &sequenceState{partials: make([]*sequencePartial, n)}
+	}
+	// advance partial matches from the last step down, so that a record advances each match once
+	var completed []string
+	for step := n - 1; step > 0; step-- {
+		p := s.partials[step]
+		if p == nil {
+			continue
+		}
+		if m.expired(p.start, ts) {
+			s.partials[step] = nil
+			continue
+		}
+		if !m.seq.Steps[step].EvalMemo(r, memo) {
+			continue
+		}
+		s.partials[step] = nil
+		ids := append(append([]string(nil), p.ids...), m.cr.ID(r))
+		if step+1 == n {
+			// other partial matches remain in progress
+			completed = ids
+			continue
+		}
+		if next := s.partials[step+1]; next == nil || next.start <= p.start {
+			s.partials[step+1] = &sequencePartial{start: p.start, ids: ids}
+		}
+	}
+	if m.seq.Steps[0].EvalMemo(r, memo) {
+		ids := []string{m.cr.ID(r)}
+		if n == 1 {
+			return ids, true
+		}
+		s.partials[1] = &sequencePartial{start: ts, ids: ids}
+	}
+	if s.empty() {
+		m.store.remove(key)
+	} else {
+		m.store.put(key, s)
+	}
+	return completed, completed != nil
+}
+
+// Len returns the number of keys with partial matches currently stored.
+func (m *sequenceMatcher[R]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.store.len()
+}
+
+// expire drops the states of keys whose partial matches are all stale from the back of the store.
+func (m *sequenceMatcher[R]) expire(ts int64) {
+	for key, s, ok := m.store.oldest(); ok && m.expired(s.latest(), ts); key, s, ok = m.store.oldest() {
+		m.store.remove(key)
+	}
+}
+
+// expired returns true if a partial match started at start is stale at ts.
+func (m *sequenceMatcher[R]) expired(start int64, ts int64) bool {
+	return m.seq.Window > 0 && time.Duration(ts-start) > m.seq.Window
+}
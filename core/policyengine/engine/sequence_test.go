@@ -0,0 +1,148 @@
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package engine
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+)
+
+type seqRecord struct {
+	id   string
+	key  string
+	kind string
+	ts   int64
+}
+
+type seqCorrelator struct{}
+
+func (c *seqCorrelator) Key(attrs ...string) func(r seqRecord) string {
+	if len(attrs) == 1 && attrs[0] == "kind" {
+		return func(r seqRecord) string { return r.kind }
+	}
+	return func(r seqRecord) string { return r.key }
+}
+
+func (c *seqCorrelator) Timestamp(r seqRecord) int64 { return r.ts }
+
+func (c *seqCorrelator) ID(r seqRecord) string { return r.id }
+
+func kind(k string) policy.Criterion[seqRecord] {
+	return policy.Criterion[seqRecord]{Pred: func(r seqRecord) bool { return r.kind == k }}
+}
+
+func newTestSequence(window time.Duration, maxKeys int) *sequenceMatcher[seqRecord] {
+	seq := &policy.Sequence[seqRecord]{
+		Steps:   []policy.Criterion[seqRecord]{kind("exec"), kind("connect")},
+		GroupBy: []string{"key"},
+		Window:  window,
+	}
+	return newSequenceMatcher[seqRecord](seq, &seqCorrelator{}, maxKeys)
+}
+
+func TestSequenceMatch(t *testing.T) {
+	m := newTestSequence(30*time.Second, 10)
+	ids, ok := m.Eval(seqRecord{id: "1", key: "a", kind: "connect", ts: 0}, nil)
+	assert.False(t, ok)
+	assert.Nil(t, ids)
+	_, ok = m.Eval(seqRecord{id: "2", key: "a", kind: "exec", ts: int64(time.Second)}, nil)
+	assert.False(t, ok)
+	_, ok = m.Eval(seqRecord{id: "3", key: "b", kind: "connect", ts: int64(2 * time.Second)}, nil)
+	assert.False(t, ok)
+	ids, ok = m.Eval(seqRecord{id: "4", key: "a", kind: "connect", ts: int64(3 * time.Second)}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"2", "4"}, ids)
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestSequenceWindow(t *testing.T) {
+	m := newTestSequence(30*time.Second, 10)
+	_, ok := m.Eval(seqRecord{id: "1", key: "a", kind: "exec", ts: 0}, nil)
+	assert.False(t, ok)
+	_, ok = m.Eval(seqRecord{id: "2", key: "a", kind: "connect", ts: int64(31 * time.Second)}, nil)
+	assert.False(t, ok)
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestSequenceRestart(t *testing.T) {
+	w := 30 * time.Second
+	m := newTestSequence(w, 10)
+	_, ok := m.Eval(seqRecord{id: "1", key: "a", kind: "exec", ts: 0}, nil)
+	assert.False(t, ok)
+	_, ok = m.Eval(seqRecord{id: "2", key: "a", kind: "exec", ts: int64(w - time.Second)}, nil)
+	assert.False(t, ok)
+	ids, ok := m.Eval(seqRecord{id: "3", key: "a", kind: "connect", ts: int64(w + time.Second)}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"2", "3"}, ids)
+
+	// a record matching the next step advances the partial match rather than restarting it
+	seq := &policy.Sequence[seqRecord]{Steps: []policy.Criterion[seqRecord]{kind("exec"), kind("exec")}, Window: w}
+	m = newSequenceMatcher[seqRecord](seq, &seqCorrelator{}, 10)
+	_, ok = m.Eval(seqRecord{id: "1", kind: "exec"}, nil)
+	assert.False(t, ok)
+	ids, ok = m.Eval(seqRecord{id: "2", kind: "exec"}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"1", "2"}, ids)
+
+	// a record matching the first step starts a new partial match without discarding those in progress
+	seq = &policy.Sequence[seqRecord]{Steps: []policy.Criterion[seqRecord]{kind("a"), kind("b"), kind("c")}, Window: w}
+	m = newSequenceMatcher[seqRecord](seq, &seqCorrelator{}, 10)
+	for i, k := range []string{"a", "b", "a"} {
+		_, ok = m.Eval(seqRecord{id: strconv.Itoa(i + 1), kind: k}, nil)
+		assert.False(t, ok)
+	}
+	ids, ok = m.Eval(seqRecord{id: "4", kind: "c"}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"1", "2", "4"}, ids)
+	assert.Equal(t, 1, m.Len())
+
+	// completing a sequence keeps the other partial matches in progress
+	_, ok = m.Eval(seqRecord{id: "5", kind: "b"}, nil)
+	assert.False(t, ok)
+	ids, ok = m.Eval(seqRecord{id: "6", kind: "c"}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"3", "5", "6"}, ids)
+	assert.Equal(t, 0, m.Len())
+
+	// the new partial match completes on its own, once the earlier one has expired
+	m = newSequenceMatcher[seqRecord](seq, &seqCorrelator{}, 10)
+	for i, k := range []string{"a", "b", "a", "b"} {
+		_, ok = m.Eval(seqRecord{id: strconv.Itoa(i + 1), kind: k, ts: int64(i) * int64(w/2)}, nil)
+		assert.False(t, ok)
+	}
+	ids, ok = m.Eval(seqRecord{id: "5", kind: "c", ts: 2 * int64(w)}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"3", "4", "5"}, ids)
+}
+
+func TestSequenceEviction(t *testing.T) {
+	m := newTestSequence(0, 2)
+	m.Eval(seqRecord{id: "1", key: "a", kind: "exec"}, nil)
+	m.Eval(seqRecord{id: "2", key: "b", kind: "exec"}, nil)
+	m.Eval(seqRecord{id: "3", key: "c", kind: "exec"}, nil)
+	assert.Equal(t, 2, m.Len())
+	_, ok := m.Eval(seqRecord{id: "4", key: "a", kind: "connect"}, nil)
+	assert.False(t, ok)
+	ids, ok := m.Eval(seqRecord{id: "5", key: "c", kind: "connect"}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"3", "5"}, ids)
+}
@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import "container/list"
+
+// stateEntry is a keyed entry of a state store.
+type stateEntry[S any] struct {
+	key   string
+	state S
+}
+
+// stateStore is a bounded store of per-key states used by stateful rules.
+// When the store is full, the least recently used state is evicted.
+// A stateStore is not safe for concurrent use.
+type stateStore[S any] struct {
+	maxKeys int
+	states  map[string]*list.Element
+	lru     *list.List
+}
+
+// newStateStore creates a new state store holding at most maxKeys states (unbounded if maxKeys <= 0).
+func newStateStore[S any](maxKeys int) *stateStore[S] {
+	return &stateStore[S]{maxKeys: maxKeys, states: make(map[string]*list.Element), lru: list.New()}
+}
+
+// get retrieves the state stored for key, marking it as recently used.
+func (s *stateStore[S]) get(key string) (S, bool) {
+	if e, ok := s.states[key]; ok {
+		s.lru.MoveToFront(e)
+		return e.Value.(*stateEntry[S]).state, true
+	}
+	var zero S
+	return zero, false
+}
+
+// put stores the state for key, evicting the least recently used state if the store is full.
+func (s *stateStore[S]) put(key string, state S) {
+	if e, ok := s.states[key]; ok {
+		e.Value.(*stateEntry[S]).state = state
+		s.lru.MoveToFront(e)
+		return
+	}
+	if s.maxKeys > 0 && s.lru.Len() >= s.maxKeys {
+		s.remove(s.lru.Back().Value.(*stateEntry[S]).key)
+	}
+	s.states[key] = s.lru.PushFront(&stateEntry[S]{key: key, state: state})
+}
+
+// remove deletes the state stored for key.
+func (s *stateStore[S]) remove(key string) {
+	if e, ok := s.states[key]; ok {
+		s.lru.Remove(e)
+		delete(s.states, key)
+	}
+}
+
+// oldest returns the least recently used state.
+func (s *stateStore[S]) oldest() (string, S, bool) {
+	if e := s.lru.Back(); e != nil {
+		se := e.Value.(*stateEntry[S])
+		return se.key, se.state, true
+	}
+	var zero S
+	return "", zero, false
+}
+
+// len returns the number of states in the store.
+func (s *stateStore[S]) len() int {
+	return s.lru.Len()
+}
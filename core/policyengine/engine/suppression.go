@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
+)
+
+// suppressionKeySep separates the attribute values of a suppression key.
+const suppressionKeySep = "\x1f"
+
+// suppressionState stores the suppression window of a rule for a correlation key.
+type suppressionState struct {
+	// timestamp of the last alert fired
+	start int64
+	// number of alerts suppressed since the last alert fired
+	suppressed int
+}
+
+// suppressor deduplicates the alerts of a rule over suppression windows.
+// Windows are kept in a bounded per-key state store.
+type suppressor[R any] struct {
+	sup  *policy.Suppression
+	cr   source.Correlator[R]
+	keys []func(R) string
+
+	mu    sync.Mutex
+	store *stateStore[*suppressionState]
+}
+
+// newSuppressor creates a new suppressor for a rule suppression.
+func newSuppressor[R any](sup *policy.Suppression, cr source.Correlator[R], maxKeys int) *suppressor[R] {
+	s := &suppressor[R]{sup: sup, cr: cr, store: newStateStore[*suppressionState](maxKeys)}
+	for _, attr := range sup.GroupBy {
+		s.keys = append(s.keys, cr.Key(attr))
+	}
+	return s
+}
+
+// key computes the suppression key of a record. Unlike correlation keys, suppression keys may
+// contain unset attributes (e.g., the container identifier of a host process).
+func (s *suppressor[R]) key(r R) string {
+	var sb strings.Builder
+	for i, k := range s.keys {
+		if i > 0 {
+			sb.WriteString(suppressionKeySep)
+		}
+		sb.WriteString(k(r))
+	}
+	return sb.String()
+}
+
+// Eval checks whether the alert fired by the rule for record r is suppressed. It returns the number of
+// alerts suppressed for the key of r since the last alert, and true, if the alert is not suppressed.
+func (s *suppressor[R]) Eval(r R) (int, bool) {
+	key := s.key(r)
+	ts := s.cr.Timestamp(r)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.store.get(key)
+	if !ok {
+		s.store.put(key, &suppressionState{start: ts})
+		return 0, true
+	}
+	if time.Duration(ts-st.start) <= s.sup.Window {
+		st.suppressed++
+		return 0, false
+	}
+	suppressed := st.suppressed
+	st.start, st.suppressed = ts, 0
+	return suppressed, true
+}
+
+// Len returns the number of windows currently stored.
+func (s *suppressor[R]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.store.len()
+}
+
+// ruleSuppression returns the suppression of a rule, which overrides the default suppression, or nil if
+// the rule's alerts are not suppressed.
+func ruleSuppression[R any](rule policy.Rule[R], def policy.Suppression) *policy.Suppression {
+	sup := &def
+	if rule.Suppress != nil {
+		sup = rule.Suppress
+	}
+	if sup.Window <= 0 {
+		return nil
+	}
+	return sup
+}
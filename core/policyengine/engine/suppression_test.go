@@ -0,0 +1,67 @@
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+)
+
+func TestSuppressor(t *testing.T) {
+	s := newSuppressor[seqRecord](&policy.Suppression{GroupBy: []string{"key"}, Window: 10 * time.Second}, &seqCorrelator{}, 10)
+	for i, c := range []struct {
+		key        string
+		ts         time.Duration
+		suppressed int
+		ok         bool
+	}{
+		{"a", 0, 0, true},
+		{"a", time.Second, 0, false},
+		{"b", 2 * time.Second, 0, true},
+		{"a", 5 * time.Second, 0, false},
+		{"a", 10 * time.Second, 0, false},
+		{"a", 11 * time.Second, 3, true},
+		{"a", 12 * time.Second, 0, false},
+		{"b", 30 * time.Second, 0, true},
+		{"", 30 * time.Second, 0, true},
+		{"", 31 * time.Second, 0, false},
+	} {
+		suppressed, ok := s.Eval(seqRecord{key: c.key, ts: int64(c.ts)})
+		assert.Equal(t, c.ok, ok, i)
+		assert.Equal(t, c.suppressed, suppressed, i)
+	}
+	assert.Equal(t, 3, s.Len())
+}
+
+func TestRuleSuppression(t *testing.T) {
+	def := policy.Suppression{GroupBy: []string{"sf.proc.exe"}, Window: time.Minute}
+	assert.Equal(t, &def, ruleSuppression(policy.Rule[seqRecord]{}, def))
+	assert.Nil(t, ruleSuppression(policy.Rule[seqRecord]{}, policy.Suppression{}))
+	assert.Nil(t, ruleSuppression(policy.Rule[seqRecord]{Suppress: &policy.Suppression{}}, def))
+	sup := &policy.Suppression{Window: time.Second}
+	assert.Equal(t, sup, ruleSuppression(policy.Rule[seqRecord]{Suppress: sup}, policy.Suppression{}))
+}
+
+func TestSuppressConfig(t *testing.T) {
+	conf, err := CreateConfig(map[string]interface{}{SuppressWindowKey: "5m", SuppressGroupByKey: "sf.proc.exe, sf.container.id"})
+	assert.NoError(t, err)
+	assert.Equal(t, policy.Suppression{Window: 5 * time.Minute, GroupBy: []string{"sf.proc.exe", "sf.container.id"}}, conf.Suppress)
+}
@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
+)
+
+// thresholdState stores the sliding window of a threshold rule for a correlation key.
+type thresholdState struct {
+	// timestamps of matching records, in arrival order
+	ts []int64
+	// last seen timestamps of distinct values
+	values map[string]int64
+}
+
+// thresholdMatcher counts the records matching a threshold rule over sliding windows.
+// Windows are kept in a bounded per-key state store.
+type thresholdMatcher[R any] struct {
+	th       *policy.Threshold
+	cr       source.Correlator[R]
+	key      func(R) string
+	distinct func(R) string
+
+	mu    sync.Mutex
+	store *stateStore[*thresholdState]
+}
+
+// newThresholdMatcher creates a new threshold matcher for a threshold rule.
+func newThresholdMatcher[R any](th *policy.Threshold, cr source.Correlator[R], maxKeys int) *thresholdMatcher[R] {
+	m := &thresholdMatcher[R]{
+		th:    th,
+		cr:    cr,
+		key:   cr.Key(th.GroupBy...),
+		store: newStateStore[*thresholdState](maxKeys),
+	}
+	if th.Distinct != "" {
+		m.distinct = cr.Key(th.Distinct)
+	}
+	return m
+}
+
+// Eval adds record r, which satisfies the rule condition, to the window of its key. It returns
+// the aggregated count, and true, if the count reaches the rule threshold. The window of the
+// key is reset once the threshold is crossed.
+func (m *thresholdMatcher[R]) Eval(r R) (int, bool) {
+	key := m.key(r)
+	if key == "" && len(m.th.GroupBy) > 0 {
+		return 0, false
+	}
+	var value string
+	if m.distinct != nil {
+		if value = m.distinct(r); value == "" {
+			return 0, false
+		}
+	}
+	ts := m.cr.Timestamp(r)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.store.get(key)
+	if !ok {
+		s = &thresholdState{}
+		if m.distinct != nil {
+			s.values = make(map[string]int64)
+		}
+		m.store.put(key, s)
+	}
+
+	var count int
+	if m.distinct != nil {
+		for v, vts := range s.values {
+			if m.expired(vts, ts) {
+				delete(s.values, v)
+			}
+		}
+		s.values[value] = ts
+		count = len(s.values)
+	} else {
+		i := 0
+		for i < len(s.ts) && m.expired(s.ts[i], ts) {
+			i++
+		}
+		s.ts = append(s.ts[i:], ts)
+		count = len(s.ts)
+	}
+
+	if count >= m.th.Count {
+		m.store.remove(key)
+		return count, true
+	}
+	return count, false
+}
+
+// Len returns the number of windows currently stored.
+func (m *thresholdMatcher[R]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.store.len()
+}
+
+func (m *thresholdMatcher[R]) expired(start int64, ts int64) bool {
+	return m.th.Window > 0 && time.Duration(ts-start) > m.th.Window
+}
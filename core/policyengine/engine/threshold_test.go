@@ -0,0 +1,71 @@
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+)
+
+func newTestThreshold(count int, window time.Duration, distinct string) *thresholdMatcher[seqRecord] {
+	th := &policy.Threshold{Count: count, GroupBy: []string{"key"}, Window: window, Distinct: distinct}
+	return newThresholdMatcher[seqRecord](th, &seqCorrelator{}, 10)
+}
+
+func TestThresholdCount(t *testing.T) {
+	m := newTestThreshold(3, 10*time.Second, "")
+	for i := 0; i < 2; i++ {
+		count, ok := m.Eval(seqRecord{key: "a", ts: int64(i) * int64(time.Second)})
+		assert.False(t, ok)
+		assert.Equal(t, i+1, count)
+	}
+	_, ok := m.Eval(seqRecord{key: "b", ts: int64(2 * time.Second)})
+	assert.False(t, ok)
+	count, ok := m.Eval(seqRecord{key: "a", ts: int64(3 * time.Second)})
+	assert.True(t, ok)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, 1, m.Len())
+}
+
+func TestThresholdWindow(t *testing.T) {
+	m := newTestThreshold(3, 10*time.Second, "")
+	m.Eval(seqRecord{key: "a", ts: 0})
+	m.Eval(seqRecord{key: "a", ts: int64(5 * time.Second)})
+	count, ok := m.Eval(seqRecord{key: "a", ts: int64(12 * time.Second)})
+	assert.False(t, ok)
+	assert.Equal(t, 2, count)
+	count, ok = m.Eval(seqRecord{key: "a", ts: int64(14 * time.Second)})
+	assert.True(t, ok)
+	assert.Equal(t, 3, count)
+}
+
+func TestThresholdDistinct(t *testing.T) {
+	m := newTestThreshold(3, 0, "kind")
+	m.Eval(seqRecord{key: "a", kind: "80"})
+	m.Eval(seqRecord{key: "a", kind: "80"})
+	count, ok := m.Eval(seqRecord{key: "a", kind: "443"})
+	assert.False(t, ok)
+	assert.Equal(t, 2, count)
+	count, ok = m.Eval(seqRecord{key: "a", kind: "22"})
+	assert.True(t, ok)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, 0, m.Len())
+}
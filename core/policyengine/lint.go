@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policyengine implements a plugin for a rules engine for telemetry records.
+package policyengine
+
+import (
+	"errors"
+
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/common"
+)
+
+// Lint compiles the policies set in conf without creating a policy interpreter, and returns a summary
+// of the compiled policies, which includes compilation diagnostics and unknown actions.
+func Lint(conf engine.Config) (policy.Summary, error) {
+	paths, err := engine.ListPolicies(conf.PoliciesPath)
+	if err != nil {
+		return policy.Summary{}, err
+	}
+	pc, ctx, err := newPolicyBackend(conf, conf.PoliciesPath)
+	if err != nil {
+		return policy.Summary{}, err
+	}
+	rules, _, err := pc.Compile(paths...)
+	var diags policy.Diagnostics
+	if err != nil && !errors.As(err, &diags) {
+		return policy.Summary{}, err
+	}
+	summary := policy.Summary{Rules: len(rules), Diagnostics: diags}
+	if s, ok := pc.(policy.Summarizer); ok {
+		summary = s.Summary()
+	}
+	ah := engine.NewActionHandler[*common.Record](conf, ctx)
+	summary.Diagnostics = append(summary.Diagnostics, ah.CheckActions(rules)...)
+	return summary, nil
+}
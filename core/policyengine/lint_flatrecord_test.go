@@ -0,0 +1,54 @@
+//go:build flatrecord
+// +build flatrecord
+
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policyengine implements a plugin for a rules engine for telemetry records.
+package policyengine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+func TestLintScripts(t *testing.T) {
+	dir := "../../resources/policies/tests/scripts"
+	conf, _ := engine.CreateConfig(map[string]interface{}{engine.PoliciesConfigKey: dir, engine.ScriptDirKey: dir})
+	summary, err := Lint(conf)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, summary.Rules)
+	assert.Empty(t, summary.Diagnostics)
+
+	conf, _ = engine.CreateConfig(map[string]interface{}{engine.PoliciesConfigKey: dir})
+	summary, err = Lint(conf)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, summary.Diagnostics)
+
+	// script errors fail linting, as they fail policy compilation in the policy engine
+	scripts := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(scripts, "bad.star"), []byte("actions = []\n"), 0600))
+	conf, _ = engine.CreateConfig(map[string]interface{}{engine.PoliciesConfigKey: dir, engine.ScriptDirKey: scripts})
+	_, err = Lint(conf)
+	assert.ErrorContains(t, err, "could not load scripts")
+}
@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policyengine implements a plugin for a rules engine for telemetry records.
+package policyengine
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+)
+
+func TestMain(m *testing.M) {
+	logger.InitLoggers(logger.TRACE)
+	os.Exit(m.Run())
+}
+
+func TestLint(t *testing.T) {
+	conf, _ := engine.CreateConfig(map[string]interface{}{engine.PoliciesConfigKey: "../../resources/policies/tests/lint"})
+	summary, err := Lint(conf)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, summary.Rules)
+	assert.False(t, summary.Diagnostics.HasErrors())
+	last := summary.Diagnostics[len(summary.Diagnostics)-1]
+	assert.Equal(t, policy.Diagnostic{Rule: "Shell spawned", Severity: policy.SeverityWarning, Msg: "unknown action unknown_action"}, last)
+
+	conf, _ = engine.CreateConfig(map[string]interface{}{engine.PoliciesConfigKey: "../../resources/policies/tests/diagnostics"})
+	summary, err = Lint(conf)
+	assert.NoError(t, err)
+	assert.True(t, summary.Diagnostics.HasErrors())
+}
+
+func TestLintMixed(t *testing.T) {
+	conf, _ := engine.CreateConfig(map[string]interface{}{engine.PoliciesConfigKey: "../../resources/policies/tests/mixed", engine.LanguageKey: "mixed",
+		engine.ConfigKey: "../../resources/policies/sigma/config/sysflow.yml"})
+	summary, err := Lint(conf)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, summary.Rules)
+	assert.Equal(t, 1, summary.Filters)
+	assert.Equal(t, 1, summary.Macros)
+	assert.False(t, summary.Diagnostics.HasErrors())
+
+	_, err = engine.CreateConfig(map[string]interface{}{engine.LanguageKey: "falcon"})
+	assert.ErrorContains(t, err, "invalid policy language falcon")
+}
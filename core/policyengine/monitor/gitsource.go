@@ -0,0 +1,142 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package monitor implements a policy monitor for the policy engine.
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+// Timeout of git commands.
+const gitTimeout = 2 * time.Minute
+
+// gitSource fetches policy sets from a ref of a Git repository, given as a local path or a file:// URL.
+// The repository is mirrored in the monitor's cache directory, and policy sets are versioned by commit.
+type gitSource struct {
+	url  string
+	ref  string
+	repo string
+
+	// Commit of the last policy set fetched
+	last string
+}
+
+func newGitSource(config engine.Config) (*gitSource, error) {
+	if config.MonitorURL == "" {
+		return nil, errors.New("git policy monitor requires a repository URL")
+	}
+	if err := checkLocalRepo(config.MonitorURL); err != nil {
+		return nil, err
+	}
+	if err := checkRef(config.MonitorRef); err != nil {
+		return nil, err
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		return nil, errors.New("git policy monitor requires the git command")
+	}
+	return &gitSource{url: config.MonitorURL, ref: config.MonitorRef, repo: filepath.Join(cacheDir(config), "repo.git")}, nil
+}
+
+// checkLocalRepo checks that a repository URL is a local path or a file:// URL. Git treats other URLs,
+// and scp-like addresses in which a colon precedes the first slash, as remote repositories.
+func checkLocalRepo(url string) error {
+	if i := strings.Index(url, "://"); i >= 0 {
+		if url[:i] != "file" {
+			return fmt.Errorf("git policy monitor requires a local repository path or file:// URL, got %s", url)
+		}
+		return nil
+	}
+	if i := strings.Index(url, ":"); i >= 0 && !strings.Contains(url[:i], "/") {
+		return fmt.Errorf("git policy monitor requires a local repository path or file:// URL, got %s", url)
+	}
+	return nil
+}
+
+// checkRef checks that a ref is set and cannot be mistaken for an option by git commands.
+func checkRef(ref string) error {
+	if ref == "" || strings.HasPrefix(ref, "-") {
+		return fmt.Errorf("git policy monitor requires a ref not starting with '-', got '%s'", ref)
+	}
+	return nil
+}
+
+func (s *gitSource) String() string {
+	return s.url + "@" + s.ref
+}
+
+// fetch updates the mirror, resolves the ref, and extracts the tree of its commit into dir if the
+// commit changed since the last fetch.
+func (s *gitSource) fetch(dir string) (string, bool, error) {
+	if _, err := os.Stat(s.repo); os.IsNotExist(err) {
+		if _, err := git("", "clone", "--mirror", "--quiet", "--", s.url, s.repo); err != nil {
+			return "", false, err
+		}
+	} else if _, err := git(s.repo, "fetch", "--prune", "--quiet", "origin"); err != nil {
+		return "", false, err
+	}
+	out, err := git(s.repo, "rev-parse", "--verify", "--quiet", s.ref+"^{commit}")
+	if err != nil {
+		return "", false, fmt.Errorf("unable to resolve ref %s in %s: %v", s.ref, s.url, err)
+	}
+	commit := strings.TrimSpace(string(out))
+	if commit == s.last {
+		return commit, false, nil
+	}
+	archive, err := git(s.repo, "archive", "--format=tar", commit)
+	if err != nil {
+		return "", false, err
+	}
+	if err := extractBundle(bytes.NewReader(archive), dir); err != nil {
+		return "", false, err
+	}
+	s.last = commit
+	return commit, true, nil
+}
+
+// git runs a git command on repo, or outside of a repository if repo is empty, and returns its output.
+func git(repo string, args ...string) ([]byte, error) {
+	if repo != "" {
+		args = append([]string{"--git-dir", repo}, args...)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gitTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			err = errors.New(msg)
+		}
+		return nil, fmt.Errorf("git %s: %v", strings.Join(args, " "), err)
+	}
+	return out, nil
+}
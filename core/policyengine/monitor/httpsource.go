@@ -0,0 +1,132 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package monitor implements a policy monitor for the policy engine.
+package monitor
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+// Extension appended to the bundle URL to obtain the URL of its detached signature.
+const signatureExt = ".sig"
+
+// Timeout of bundle and signature requests.
+const httpTimeout = 30 * time.Second
+
+// httpSource fetches signed policy bundles from an HTTP(S) URL. A bundle is a tar archive, optionally
+// gzip-compressed, signed with an Ed25519 key; its detached signature is served at the bundle URL
+// with extension .sig. Bundles are versioned by their SHA-256 digest.
+type httpSource struct {
+	url    string
+	key    ed25519.PublicKey
+	client *http.Client
+
+	// ETag and version of the last bundle fetched
+	etag string
+	last string
+}
+
+func newHTTPSource(config engine.Config) (*httpSource, error) {
+	u, err := url.Parse(config.MonitorURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid policy bundle URL '%s'", config.MonitorURL)
+	}
+	if config.MonitorPubKey == "" {
+		return nil, errors.New("http policy monitor requires a public key for verifying policy bundles")
+	}
+	key, err := loadPublicKey(config.MonitorPubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &httpSource{url: config.MonitorURL, key: key, client: &http.Client{Timeout: httpTimeout}}, nil
+}
+
+func (s *httpSource) String() string {
+	return s.url
+}
+
+// fetch downloads the bundle unless the server reports that it has not been modified since the last
+// fetch, verifies its signature, and extracts it into dir.
+func (s *httpSource) fetch(dir string) (string, bool, error) {
+	bundle, etag, modified, err := s.get(s.url, s.etag)
+	if err != nil || !modified {
+		return s.last, false, err
+	}
+	sig, _, _, err := s.get(s.url+signatureExt, "")
+	if err != nil {
+		return "", false, fmt.Errorf("unable to fetch policy bundle signature: %v", err)
+	}
+	if err := verifySignature(s.key, bundle, sig); err != nil {
+		return "", false, err
+	}
+	sum := sha256.Sum256(bundle)
+	version := hex.EncodeToString(sum[:])
+	if version == s.last {
+		s.etag = etag
+		return version, false, nil
+	}
+	if err := extractBundle(bytes.NewReader(bundle), dir); err != nil {
+		return "", false, err
+	}
+	s.etag, s.last = etag, version
+	return version, true, nil
+}
+
+// get retrieves the content and ETag of a URL. If etag is set, the request is conditional, and
+// modified is false if the server responds that the content has not changed.
+func (s *httpSource) get(u string, etag string) (body []byte, newEtag string, modified bool, err error) {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, "", false, err
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, "", false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified && etag != "" {
+		return nil, etag, false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", false, fmt.Errorf("GET %s returned status %s", u, resp.Status)
+	}
+	body, err = io.ReadAll(io.LimitReader(resp.Body, maxBundleSize+1))
+	if err != nil {
+		return nil, "", false, err
+	}
+	if len(body) > maxBundleSize {
+		return nil, "", false, fmt.Errorf("GET %s exceeds %d bytes", u, maxBundleSize)
+	}
+	return body, resp.Header.Get("ETag"), true, nil
+}
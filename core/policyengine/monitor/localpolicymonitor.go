@@ -25,7 +25,9 @@ import (
 	"crypto/sha256"
 	"errors"
 	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -35,29 +37,35 @@ import (
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
 )
 
+// Time during which file events are collected before policies are checked for changes.
+var eventSettleTime = 10 * time.Second
+
 // LocalPolicyMonitor is an object that monitors the local policy file
-// directory for changes and compiles a new policy engine if changes occur.
+// directory and its subdirectories for changes and compiles a new policy
+// engine if changes occur.
 type LocalPolicyMonitor[R any] struct {
 	config      engine.Config
 	interChan   chan *engine.PolicyInterpreter[R]
 	watcher     *fsnotify.Watcher
+	dirs        map[string]bool
 	started     bool
 	done        chan bool
 	policies    map[string][]byte
-	createInter func() (*engine.PolicyInterpreter[R], error)
+	createInter func(dir string) (*engine.PolicyInterpreter[R], error)
 	out         func(R)
 }
 
 // NewLocalPolicyMonitor returns a new policy monitor object given an engine configuration.
-func NewLocalPolicyMonitor[R any](config engine.Config, createInter func() (*engine.PolicyInterpreter[R], error), out func(R)) (PolicyMonitor[R], error) {
+func NewLocalPolicyMonitor[R any](config engine.Config, createInter func(dir string) (*engine.PolicyInterpreter[R], error), out func(R)) (PolicyMonitor[R], error) {
 	lpm := &LocalPolicyMonitor[R]{config: config, interChan: make(chan *engine.PolicyInterpreter[R], 10), started: false,
-		done: make(chan bool), policies: make(map[string][]byte), createInter: createInter, out: out}
+		done: make(chan bool), dirs: make(map[string]bool), policies: make(map[string][]byte), createInter: createInter, out: out}
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
 		logger.Error.Printf("Unable to create policy watcher object %v", err)
 		return nil, err
 	}
 	lpm.watcher = watcher
+	lpm.calculateChecksum() //nolint:errcheck
 	err = lpm.CheckForPolicyUpdate()
 	if err != nil {
 		return nil, err
@@ -73,30 +81,62 @@ func (p *LocalPolicyMonitor[R]) GetInterpreterChan() chan *engine.PolicyInterpre
 
 func (p *LocalPolicyMonitor[R]) dequeueFileEvents() int {
 	count := 0
-	i := 0
-	for i < 1000 {
+	timeout := time.After(eventSettleTime)
+	for {
 		select {
 		case ev := <-p.watcher.Events:
 			logger.Trace.Printf("Queued Event %#v, Operation: %s\n", ev, ev.Op.String())
-			if hasModifiedYaml(ev) {
+			if p.handleEvent(ev) {
 				count++
 			}
-		default:
-			time.Sleep(10 * time.Millisecond)
-			i++
+		case <-timeout:
+			return count
 		}
 	}
-	return count
 }
 
-func hasModifiedYaml(event fsnotify.Event) bool {
-	result := false
-	if (event.Op == fsnotify.Create || event.Op == fsnotify.Remove ||
-		event.Op == fsnotify.Write || event.Op == fsnotify.Rename) && (strings.HasSuffix(event.Name, ".yaml") ||
-		strings.HasSuffix(event.Name, ".yml")) {
-		result = true
+// handleEvent returns true if a file event may change the policies. Directories created in the watched
+// tree are watched in turn, and directories removed from it may have contained policy files.
+func (p *LocalPolicyMonitor[R]) handleEvent(event fsnotify.Event) bool {
+	if event.Op&(fsnotify.Create|fsnotify.Remove|fsnotify.Write|fsnotify.Rename) == 0 {
+		return false
+	}
+	if event.Op&fsnotify.Create != 0 {
+		if fi, err := os.Stat(event.Name); err == nil && fi.IsDir() {
+			p.addWatches(event.Name) //nolint:errcheck
+			return true
+		}
+	}
+	if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 && p.dirs[event.Name] {
+		for dir := range p.dirs {
+			if dir == event.Name || strings.HasPrefix(dir, event.Name+string(filepath.Separator)) {
+				p.watcher.Remove(dir) //nolint:errcheck
+				delete(p.dirs, dir)
+			}
+		}
+		return true
 	}
-	return result
+	return engine.IsPolicyFile(event.Name)
+}
+
+// addWatches watches root and its subdirectories, or root alone if it is a file.
+func (p *LocalPolicyMonitor[R]) addWatches(root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != root && !d.IsDir() {
+			return nil
+		}
+		if err := p.watcher.Add(path); err != nil {
+			logger.Error.Printf("Unable to add watch to %s, %v", path, err)
+			return err
+		}
+		if d.IsDir() {
+			p.dirs[path] = true
+		}
+		return nil
+	})
 }
 
 func checksum(path string) ([]byte, error) {
@@ -116,13 +156,13 @@ func checksum(path string) ([]byte, error) {
 }
 
 func (p *LocalPolicyMonitor[R]) calculateChecksum() (bool, []string, error) {
-	paths, err := ioutils.ListFilePaths(p.config.PoliciesPath, ".yaml")
+	paths, err := ioutils.ListRecursiveFilePaths(p.config.PoliciesPath, engine.PolicyExts...)
 	if err != nil {
 		return false, nil, err
 	}
 	if len(paths) == 0 {
 		p.policies = make(map[string][]byte)
-		return false, make([]string, 0), errors.New("no policy files with extension .yaml found in policy directory: " + p.config.PoliciesPath)
+		return false, make([]string, 0), errors.New("no policy files with extension .yaml or .yml found in policy directory: " + p.config.PoliciesPath)
 	}
 	newPolicies := make(map[string][]byte)
 	changes := false
@@ -149,11 +189,14 @@ func (p *LocalPolicyMonitor[R]) calculateChecksum() (bool, []string, error) {
 	return changes, paths, nil
 }
 
-// StartMonitor starts a thread to monitor the local policy directory.
+// StartMonitor starts a thread to monitor the local policy directory and its subdirectories.
 func (p *LocalPolicyMonitor[R]) StartMonitor() error {
 	if p.started {
 		return nil
 	}
+	if err := p.addWatches(p.config.PoliciesPath); err != nil {
+		return err
+	}
 	go func() {
 		for {
 			yamlCount := 0
@@ -164,7 +207,7 @@ func (p *LocalPolicyMonitor[R]) StartMonitor() error {
 			// watch for events
 			case event := <-p.watcher.Events:
 				logger.Trace.Printf("Event: %#v, Operation: %s\n", event, event.Op.String())
-				if hasModifiedYaml(event) {
+				if p.handleEvent(event) {
 					yamlCount++
 				}
 				yamlCount += p.dequeueFileEvents()
@@ -191,10 +234,6 @@ func (p *LocalPolicyMonitor[R]) StartMonitor() error {
 		}
 	}()
 	p.started = true
-	if err := p.watcher.Add(p.config.PoliciesPath); err != nil {
-		logger.Error.Printf("Unable to add watch to directory %s, %v", p.config.PoliciesPath, err)
-		return err
-	}
 	return nil
 }
 
@@ -207,15 +246,11 @@ func (p *LocalPolicyMonitor[R]) StopMonitor() error {
 
 // CheckForPolicyUpdate creates a new policy engine based on updated policies.
 func (p *LocalPolicyMonitor[R]) CheckForPolicyUpdate() error {
-	paths, err := ioutils.ListFilePaths(p.config.PoliciesPath, ".yaml")
-	if err != nil {
+	if _, err := engine.ListPolicies(p.config.PoliciesPath); err != nil {
 		return err
 	}
-	if len(paths) == 0 {
-		return errors.New("no policy files with extension .yaml found in policy directory: " + p.config.PoliciesPath)
-	}
 	logger.Info.Println("Creating new policy interpreter")
-	pi, err := p.createInter()
+	pi, err := p.createInter(p.config.PoliciesPath)
 	if err != nil {
 		logger.Error.Printf("Unable to create a new policy interpreter using policy files in directory %s. Not using new policy files. %v", p.config.PoliciesPath, err)
 		return err
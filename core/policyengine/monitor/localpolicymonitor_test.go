@@ -0,0 +1,70 @@
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package monitor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+func TestLocalPolicyMonitor(t *testing.T) {
+	eventSettleTime = 100 * time.Millisecond
+	dir := t.TempDir()
+	sub := filepath.Join(dir, "rules", "linux")
+	assert.NoError(t, os.MkdirAll(sub, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(sub, "rules.yml"), []byte(goodPolicy), 0600))
+
+	pm, err := NewPolicyMonitor(engine.Config{Monitor: engine.LocalType, PoliciesPath: dir}, createInter, nil)
+	assert.NoError(t, err)
+	<-pm.GetInterpreterChan()
+	assert.NoError(t, pm.StartMonitor())
+	defer pm.StopMonitor() //nolint:errcheck
+
+	next := func(timeout time.Duration) bool {
+		select {
+		case <-pm.GetInterpreterChan():
+			return true
+		case <-time.After(timeout):
+			return false
+		}
+	}
+
+	// policy files in subdirectories are watched
+	assert.NoError(t, os.WriteFile(filepath.Join(sub, "rules.yml"), []byte(goodPolicy+"  tags: [test]\n"), 0600))
+	assert.True(t, next(5*time.Second))
+
+	// new subdirectories are watched
+	added := filepath.Join(dir, "rules", "k8s")
+	assert.NoError(t, os.Mkdir(added, 0755))
+	assert.False(t, next(time.Second))
+	assert.NoError(t, os.WriteFile(filepath.Join(added, "k8s.yaml"), []byte(goodPolicy), 0600))
+	assert.True(t, next(5*time.Second))
+
+	// files that are not policies are ignored
+	assert.NoError(t, os.WriteFile(filepath.Join(added, "README.md"), []byte("rules"), 0600))
+	assert.False(t, next(time.Second))
+
+	// removing a subdirectory removes its policies
+	assert.NoError(t, os.RemoveAll(added))
+	assert.True(t, next(5*time.Second))
+}
@@ -27,7 +27,8 @@ import (
 )
 
 // PolicyMonitor is an interface representing policy monitor objects.
-// Currently the interface supports a local directory policy monitor.
+// Currently the interface supports a local directory policy monitor, and remote
+// monitors pulling policy bundles over HTTP or policy sets from Git repositories.
 type PolicyMonitor[R any] interface {
 	GetInterpreterChan() chan *engine.PolicyInterpreter[R]
 	StartMonitor() error
@@ -36,9 +37,23 @@ type PolicyMonitor[R any] interface {
 }
 
 // NewPolicyMonitor creates a new policy monitor based on the engine configuration.
-func NewPolicyMonitor[R any](config engine.Config, createInter func() (*engine.PolicyInterpreter[R], error), out func(R)) (PolicyMonitor[R], error) {
-	if config.Monitor == engine.LocalType {
+// createInter compiles the policies found in a directory into a new policy interpreter.
+func NewPolicyMonitor[R any](config engine.Config, createInter func(dir string) (*engine.PolicyInterpreter[R], error), out func(R)) (PolicyMonitor[R], error) {
+	switch config.Monitor {
+	case engine.LocalType:
 		return NewLocalPolicyMonitor(config, createInter, out)
+	case engine.HTTPType:
+		src, err := newHTTPSource(config)
+		if err != nil {
+			return nil, err
+		}
+		return NewRemotePolicyMonitor[R](config, src, createInter, out)
+	case engine.GitType:
+		src, err := newGitSource(config)
+		if err != nil {
+			return nil, err
+		}
+		return NewRemotePolicyMonitor[R](config, src, createInter, out)
 	}
 	return nil, errors.New("Policy monitor of type: " + config.Monitor.String() + " is not supported.")
 }
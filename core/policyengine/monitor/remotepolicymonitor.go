@@ -0,0 +1,206 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package monitor implements a policy monitor for the policy engine.
+package monitor
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+// Name of the file recording the version of the last policy set compiled successfully.
+const currentVersionFile = "current"
+
+// policySource fetches policy sets from a remote location.
+type policySource interface {
+	// fetch writes the latest policy set into dir and returns its version, or returns false if the
+	// policy set has not changed since the last fetch.
+	fetch(dir string) (version string, changed bool, err error)
+	String() string
+}
+
+// cacheDir returns the directory in which remote policy sets are stored.
+func cacheDir(config engine.Config) string {
+	if config.MonitorCacheDir != "" {
+		return config.MonitorCacheDir
+	}
+	return filepath.Join(os.TempDir(), "sf-processor", "policies")
+}
+
+// RemotePolicyMonitor is an object that periodically fetches policy sets from a remote
+// source and compiles a new policy engine if the policy set changes. New policy sets are
+// staged and compiled before the policy engine is swapped; if a policy set fails to compile,
+// the engine keeps running the last policy set that compiled successfully.
+type RemotePolicyMonitor[R any] struct {
+	config      engine.Config
+	src         policySource
+	dir         string
+	version     string
+	interChan   chan *engine.PolicyInterpreter[R]
+	mu          sync.Mutex
+	started     bool
+	done        chan bool
+	createInter func(dir string) (*engine.PolicyInterpreter[R], error)
+	out         func(R)
+}
+
+// NewRemotePolicyMonitor returns a new remote policy monitor object given an engine configuration
+// and a policy source. If the initial policy set cannot be fetched or compiled, the monitor falls
+// back to the last policy set compiled successfully, if any.
+func NewRemotePolicyMonitor[R any](config engine.Config, src policySource, createInter func(dir string) (*engine.PolicyInterpreter[R], error), out func(R)) (PolicyMonitor[R], error) {
+	rpm := &RemotePolicyMonitor[R]{config: config, src: src, dir: cacheDir(config), interChan: make(chan *engine.PolicyInterpreter[R], 10),
+		done: make(chan bool), createInter: createInter, out: out}
+	if err := os.MkdirAll(rpm.dir, 0755); err != nil {
+		logger.Error.Printf("Unable to create policy cache directory %s, %v", rpm.dir, err)
+		return nil, err
+	}
+	if b, err := os.ReadFile(filepath.Join(rpm.dir, currentVersionFile)); err == nil {
+		rpm.version = strings.TrimSpace(string(b))
+	}
+	if err := rpm.CheckForPolicyUpdate(); err != nil {
+		if err := rpm.rollback(); err != nil {
+			return nil, err
+		}
+	}
+	return rpm, nil
+}
+
+// GetInterpreterChan returns a channel of the policy engine after they have been built.
+// This channel can be checked for policy engines that are ready to be used.
+func (p *RemotePolicyMonitor[R]) GetInterpreterChan() chan *engine.PolicyInterpreter[R] {
+	return p.interChan
+}
+
+// StartMonitor starts a thread to poll the remote policy source at the monitor interval.
+func (p *RemotePolicyMonitor[R]) StartMonitor() error {
+	if p.started {
+		return nil
+	}
+	go func() {
+		ticker := time.NewTicker(p.config.MonitorInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.done:
+				logger.Trace.Printf("Policy monitor received done event... exiting...")
+				return
+			case <-ticker.C:
+				p.CheckForPolicyUpdate() //nolint:errcheck
+			}
+		}
+	}()
+	p.started = true
+	return nil
+}
+
+// StopMonitor sends a signal to exit the monitor thread.
+func (p *RemotePolicyMonitor[R]) StopMonitor() error {
+	if p.started {
+		p.started = false
+		p.done <- true
+	}
+	return nil
+}
+
+// CheckForPolicyUpdate fetches the policy set from the remote source and, if it changed, creates a new
+// policy engine based on it. A new policy set is moved to its version directory before it is compiled,
+// so that the paths resolved by the policy engine, such as those of lookup lists, remain valid; it is
+// recorded as the last good set once it compiles, and removed otherwise.
+func (p *RemotePolicyMonitor[R]) CheckForPolicyUpdate() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	staging, err := os.MkdirTemp(p.dir, ".staging-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(staging)
+	version, changed, err := p.src.fetch(staging)
+	if err != nil {
+		logger.Error.Printf("Unable to fetch policies from %s, %v", p.src, err)
+		return err
+	}
+	if !changed {
+		logger.Trace.Printf("No policy changes found in %s", p.src)
+		return nil
+	}
+	dir := filepath.Join(p.dir, version)
+	if version != p.version {
+		os.RemoveAll(dir)
+		if err := os.Rename(staging, dir); err != nil {
+			logger.Error.Printf("Unable to store policy set %s in %s, %v", version, p.dir, err)
+			return err
+		}
+	}
+	logger.Info.Printf("Creating new policy interpreter for policy set %s from %s", version, p.src)
+	pi, err := p.createInter(dir)
+	if err != nil {
+		logger.Error.Printf("Unable to create a new policy interpreter using policy set %s from %s. Keeping policy set %s. %v", version, p.src, p.version, err)
+		if version != p.version {
+			os.RemoveAll(dir)
+		}
+		return err
+	}
+	if version != p.version {
+		p.commit(version)
+	}
+	p.push(pi)
+	return nil
+}
+
+// commit records a stored policy set as the last good set, and removes the previous one.
+func (p *RemotePolicyMonitor[R]) commit(version string) {
+	if err := writeFile(filepath.Join(p.dir, currentVersionFile), strings.NewReader(version)); err != nil {
+		logger.Error.Printf("Unable to record policy set %s as current, %v", version, err)
+	} else if p.version != "" {
+		os.RemoveAll(filepath.Join(p.dir, p.version))
+	}
+	p.version = version
+}
+
+// rollback creates a new policy engine based on the last good policy set.
+func (p *RemotePolicyMonitor[R]) rollback() error {
+	if p.version == "" {
+		return errors.New("no policy set available from " + p.src.String())
+	}
+	logger.Info.Printf("Rolling back to policy set %s", p.version)
+	pi, err := p.createInter(filepath.Join(p.dir, p.version))
+	if err != nil {
+		logger.Error.Printf("Unable to create a new policy interpreter using policy set %s. %v", p.version, err)
+		return err
+	}
+	p.push(pi)
+	return nil
+}
+
+func (p *RemotePolicyMonitor[R]) push(pi *engine.PolicyInterpreter[R]) {
+	select {
+	case p.interChan <- pi:
+		logger.Info.Printf("Pushed new policy interpreter on channel")
+	default:
+		logger.Error.Printf("Unable to push new policy interpreter to policy thread.")
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package monitor
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy/falco"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord"
+)
+
+const (
+	goodPolicy = "- rule: Shell spawned\n  desc: test\n  condition: sf.type=PE and sf.proc.name=bash\n  priority: low\n"
+	badPolicy  = "- rule: Shell spawned\n  desc: test\n  condition: sf.type=PE and and\n  priority: low\n"
+)
+
+func TestMain(m *testing.M) {
+	logger.InitLoggers(logger.TRACE)
+	os.Exit(m.Run())
+}
+
+func createInter(dir string) (*engine.PolicyInterpreter[*flatrecord.Record], error) {
+	paths, err := engine.ListPolicies(dir)
+	if err != nil {
+		return nil, err
+	}
+	pi := engine.NewPolicyInterpreter(engine.Config{}, falco.NewPolicyCompiler(flatrecord.NewOperations()), nil, nil, nil, nil)
+	return pi, pi.Compile(paths...)
+}
+
+func newBundle(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+	for name, content := range files {
+		assert.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, tw.Close())
+	assert.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+// bundleServer serves a policy bundle and its signature, with ETag support.
+type bundleServer struct {
+	mu     sync.Mutex
+	bundle []byte
+	sig    []byte
+	status int
+	gets   int
+}
+
+func (s *bundleServer) set(bundle []byte, sig []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bundle, s.sig = bundle, sig
+}
+
+func (s *bundleServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		return
+	}
+	switch req.URL.Path {
+	case "/policies.tgz":
+		etag := fmt.Sprintf(`"%x"`, sha256.Sum256(s.bundle))
+		if req.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		s.gets++
+		w.Header().Set("ETag", etag)
+		w.Write(s.bundle) //nolint:errcheck
+	case "/policies.tgz.sig":
+		w.Write(s.sig) //nolint:errcheck
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func TestHTTPPolicyMonitor(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	assert.NoError(t, err)
+	keyPath := filepath.Join(t.TempDir(), "key.pem")
+	assert.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+
+	bundle := newBundle(t, map[string]string{"policies/rules.yaml": goodPolicy})
+	srv := &bundleServer{bundle: bundle, sig: []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(priv, bundle)))}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	config := engine.Config{Monitor: engine.HTTPType, MonitorURL: ts.URL + "/policies.tgz", MonitorPubKey: keyPath, MonitorCacheDir: t.TempDir()}
+	_, err = NewPolicyMonitor(engine.Config{Monitor: engine.HTTPType, MonitorURL: config.MonitorURL}, createInter, nil)
+	assert.Error(t, err)
+	pm, err := NewPolicyMonitor(config, createInter, nil)
+	assert.NoError(t, err)
+	assert.Len(t, pm.GetInterpreterChan(), 1)
+	<-pm.GetInterpreterChan()
+	version := pm.(*RemotePolicyMonitor[*flatrecord.Record]).version
+	assert.DirExists(t, filepath.Join(config.MonitorCacheDir, version))
+
+	// unmodified bundles are not downloaded again
+	assert.NoError(t, pm.CheckForPolicyUpdate())
+	assert.Len(t, pm.GetInterpreterChan(), 0)
+	assert.Equal(t, 1, srv.gets)
+
+	// bundles with invalid signatures, and policy sets that fail to compile, are rejected
+	bad := newBundle(t, map[string]string{"rules.yaml": badPolicy, "extra.yaml": goodPolicy})
+	srv.set(bad, ed25519.Sign(priv, bundle))
+	assert.Error(t, pm.CheckForPolicyUpdate())
+	srv.set(bad, ed25519.Sign(priv, bad))
+	assert.Error(t, pm.CheckForPolicyUpdate())
+	assert.Len(t, pm.GetInterpreterChan(), 0)
+	assert.Equal(t, version, pm.(*RemotePolicyMonitor[*flatrecord.Record]).version)
+
+	// a new monitor rolls back to the last good set if the source is unavailable
+	srv.status = http.StatusInternalServerError
+	pm, err = NewPolicyMonitor(config, createInter, nil)
+	assert.NoError(t, err)
+	assert.Len(t, pm.GetInterpreterChan(), 1)
+	_, err = NewPolicyMonitor(engine.Config{Monitor: engine.HTTPType, MonitorURL: config.MonitorURL, MonitorPubKey: keyPath, MonitorCacheDir: t.TempDir()}, createInter, nil)
+	assert.Error(t, err)
+
+	// a valid update replaces the last good set
+	srv.status = 0
+	update := newBundle(t, map[string]string{"rules.yml.yaml": goodPolicy})
+	srv.set(update, ed25519.Sign(priv, update))
+	assert.NoError(t, pm.CheckForPolicyUpdate())
+	<-pm.GetInterpreterChan()
+	<-pm.GetInterpreterChan()
+	assert.NotEqual(t, version, pm.(*RemotePolicyMonitor[*flatrecord.Record]).version)
+	assert.NoDirExists(t, filepath.Join(config.MonitorCacheDir, version))
+}
+
+func TestGitPolicyMonitor(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	repo := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", repo, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		out, err := cmd.CombinedOutput()
+		assert.NoError(t, err, string(out))
+	}
+	commit := func(content string) {
+		assert.NoError(t, os.WriteFile(filepath.Join(repo, "rules.yaml"), []byte(content), 0600))
+		run("add", "rules.yaml")
+		run("commit", "--quiet", "-m", "update")
+	}
+	run("init", "--quiet")
+	commit(goodPolicy)
+
+	config := engine.Config{Monitor: engine.GitType, MonitorURL: "file://" + repo, MonitorRef: "HEAD", MonitorCacheDir: t.TempDir()}
+	pm, err := NewPolicyMonitor(config, createInter, nil)
+	assert.NoError(t, err)
+	<-pm.GetInterpreterChan()
+	version := pm.(*RemotePolicyMonitor[*flatrecord.Record]).version
+	assert.FileExists(t, filepath.Join(config.MonitorCacheDir, version, "rules.yaml"))
+
+	assert.NoError(t, pm.CheckForPolicyUpdate())
+	assert.Len(t, pm.GetInterpreterChan(), 0)
+
+	commit(badPolicy)
+	assert.Error(t, pm.CheckForPolicyUpdate())
+	assert.Len(t, pm.GetInterpreterChan(), 0)
+	assert.NoError(t, pm.CheckForPolicyUpdate())
+
+	commit(goodPolicy + "  tags: [test]\n")
+	assert.NoError(t, pm.CheckForPolicyUpdate())
+	assert.Len(t, pm.GetInterpreterChan(), 1)
+	assert.NotEqual(t, version, pm.(*RemotePolicyMonitor[*flatrecord.Record]).version)
+
+	_, err = NewPolicyMonitor(engine.Config{Monitor: engine.GitType, MonitorURL: "file://" + repo, MonitorRef: "missing", MonitorCacheDir: t.TempDir()}, createInter, nil)
+	assert.Error(t, err)
+
+	// policy sets are compiled from their version directory, which outlives the compilation, including
+	// when a new monitor fetches the current policy set again
+	var compiled []string
+	recordInter := func(dir string) (*engine.PolicyInterpreter[*flatrecord.Record], error) {
+		compiled = append(compiled, dir)
+		return createInter(dir)
+	}
+	pm, err = NewPolicyMonitor(config, recordInter, nil)
+	assert.NoError(t, err)
+	version = pm.(*RemotePolicyMonitor[*flatrecord.Record]).version
+	commit(goodPolicy + "  tags: [update]\n")
+	assert.NoError(t, pm.CheckForPolicyUpdate())
+	assert.Len(t, compiled, 2)
+	assert.Equal(t, filepath.Join(config.MonitorCacheDir, version), compiled[0])
+	assert.Equal(t, filepath.Join(config.MonitorCacheDir, pm.(*RemotePolicyMonitor[*flatrecord.Record]).version), compiled[1])
+	assert.NoDirExists(t, compiled[0])
+	assert.FileExists(t, filepath.Join(compiled[1], "rules.yaml"))
+}
+
+func TestCheckLocalRepo(t *testing.T) {
+	for _, url := range []string{"/srv/policies.git", "policies", "./a:b", "file:///srv/policies.git"} {
+		assert.NoError(t, checkLocalRepo(url), url)
+	}
+	for _, url := range []string{"https://example.com/policies.git", "ssh://git@example.com/policies.git", "git@example.com:policies.git", "ext::sh -c touch% /tmp/x"} {
+		assert.Error(t, checkLocalRepo(url), url)
+	}
+	_, err := NewPolicyMonitor(engine.Config{Monitor: engine.GitType, MonitorURL: "https://example.com/policies.git", MonitorCacheDir: t.TempDir()}, createInter, nil)
+	assert.Error(t, err)
+}
+
+func TestCheckRef(t *testing.T) {
+	for _, ref := range []string{"HEAD", "main", "refs/tags/v1.0", "3f2a9c1"} {
+		assert.NoError(t, checkRef(ref), ref)
+	}
+	for _, ref := range []string{"", "-", "--output=/tmp/x", "-h"} {
+		assert.Error(t, checkRef(ref), ref)
+	}
+	_, err := NewPolicyMonitor(engine.Config{Monitor: engine.GitType, MonitorURL: "/srv/policies.git", MonitorRef: "--all", MonitorCacheDir: t.TempDir()}, createInter, nil)
+	assert.Error(t, err)
+}
+
+func TestExtractBundle(t *testing.T) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	assert.NoError(t, tw.WriteHeader(&tar.Header{Name: "../escape.yaml", Mode: 0644, Typeflag: tar.TypeReg}))
+	assert.NoError(t, tw.Close())
+	assert.Error(t, extractBundle(bytes.NewReader(buf.Bytes()), t.TempDir()))
+
+	dir := t.TempDir()
+	assert.NoError(t, extractBundle(bytes.NewReader(newBundle(t, map[string]string{"a/b.yaml": goodPolicy})), dir))
+	b, err := os.ReadFile(filepath.Join(dir, "a", "b.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, goodPolicy, string(b))
+}
+
+func TestVerifySignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	bundle := []byte("bundle")
+	sig := ed25519.Sign(priv, bundle)
+	encoded := base64.StdEncoding.EncodeToString(sig)
+	assert.NoError(t, verifySignature(pub, bundle, sig))
+	assert.NoError(t, verifySignature(pub, bundle, []byte(encoded[:76]+"\n"+encoded[76:]+"\n")))
+	assert.Error(t, verifySignature(pub, []byte("other"), sig))
+	assert.Error(t, verifySignature(pub, bundle, []byte("not a signature")))
+}
@@ -24,3 +24,24 @@ type PolicyCompiler[R any] interface {
 	// Compile reads one or more input policy files, parses, and translates them to internal criteria objects.
 	Compile(paths ...string) ([]Rule[R], []Filter[R], error)
 }
+
+// Summary describes the definitions and diagnostics of compiled policies.
+type Summary struct {
+	Rules       int
+	Filters     int
+	Macros      int
+	Lists       int
+	Diagnostics Diagnostics
+}
+
+// Summarizer is an optional interface implemented by policy compilers that describe the policies they compiled.
+type Summarizer interface {
+	// Summary returns the summary of the last compilation, including diagnostics of unused definitions.
+	Summary() Summary
+}
+
+// LookupProvider is an optional interface implemented by policy compilers that load lookup lists.
+type LookupProvider interface {
+	// Lookups returns the lookup lists loaded in the last compilation.
+	Lookups() []*Lookup
+}
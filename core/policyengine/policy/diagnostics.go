@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy implements input policy translation for the rules engine.
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+)
+
+// Severity denotes the severity of a policy diagnostic.
+type Severity int
+
+// Severity enumeration, in increasing order of severity.
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityError
+)
+
+// String returns the string representation of a severity instance.
+func (s Severity) String() string {
+	return [...]string{"info", "warning", "error"}[s]
+}
+
+// Diagnostic describes an issue found while compiling a policy.
+type Diagnostic struct {
+	File     string
+	Line     int
+	Column   int
+	Rule     string
+	Severity Severity
+	Msg      string
+}
+
+// Error returns the string representation of a diagnostic.
+func (d Diagnostic) Error() string {
+	var sb strings.Builder
+	if d.File != "" {
+		sb.WriteString(d.File)
+		if d.Line > 0 {
+			fmt.Fprintf(&sb, ":%d:%d", d.Line, d.Column)
+		}
+		sb.WriteString(": ")
+	}
+	sb.WriteString(d.Severity.String())
+	sb.WriteString(": ")
+	if d.Rule != "" {
+		fmt.Fprintf(&sb, "rule '%s': ", d.Rule)
+	}
+	sb.WriteString(d.Msg)
+	return sb.String()
+}
+
+// Diagnostics is a list of diagnostics, which policy compilers return as an error
+// when compilation errors are found.
+type Diagnostics []Diagnostic
+
+// Error returns the string representation of a diagnostics list.
+func (ds Diagnostics) Error() string {
+	msgs := make([]string, 0, len(ds))
+	for _, d := range ds {
+		msgs = append(msgs, d.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Add appends diagnostic d to the list, unless an identical diagnostic has been reported.
+func (ds *Diagnostics) Add(d Diagnostic) {
+	for _, e := range *ds {
+		if e.File == d.File && e.Line == d.Line && e.Column == d.Column && e.Msg == d.Msg {
+			return
+		}
+	}
+	*ds = append(*ds, d)
+}
+
+// HasErrors checks whether the list contains diagnostics of error severity.
+func (ds Diagnostics) HasErrors() bool {
+	return ds.Count(SeverityError) > 0
+}
+
+// Count returns the number of diagnostics of severity s in the list.
+func (ds Diagnostics) Count(s Severity) int {
+	n := 0
+	for _, d := range ds {
+		if d.Severity == s {
+			n++
+		}
+	}
+	return n
+}
+
+// Log writes the diagnostics to the error and warning loggers, and informational diagnostics to
+// the trace logger.
+func (ds Diagnostics) Log() {
+	for _, d := range ds {
+		switch d.Severity {
+		case SeverityError:
+			logger.Error.Println(d.Error())
+		case SeverityInfo:
+			logger.Trace.Println(d.Error())
+		default:
+			logger.Warn.Println(d.Error())
+		}
+	}
+}
@@ -0,0 +1,45 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy implements input policy translation for the rules engine.
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnostics(t *testing.T) {
+	var ds Diagnostics
+	ds.Add(Diagnostic{File: "a.yaml", Line: 3, Column: 5, Rule: "r", Severity: SeverityWarning, Msg: "unknown field x"})
+	assert.False(t, ds.HasErrors())
+	ds.Add(Diagnostic{File: "a.yaml", Line: 4, Column: 1, Severity: SeverityError, Msg: "undefined macro m"})
+	ds.Add(Diagnostic{File: "a.yaml", Line: 4, Column: 1, Severity: SeverityError, Msg: "undefined macro m"})
+	assert.Len(t, ds, 2)
+	assert.True(t, ds.HasErrors())
+	assert.Equal(t, "a.yaml:3:5: warning: rule 'r': unknown field x\na.yaml:4:1: error: undefined macro m", ds.Error())
+
+	ds.Add(Diagnostic{File: "a.yaml", Line: 5, Column: 1, Rule: "r", Severity: SeverityInfo, Msg: "exception e applied"})
+	assert.Equal(t, 1, ds.Count(SeverityWarning))
+	assert.Equal(t, 1, ds.Count(SeverityError))
+	assert.Equal(t, 1, ds.Count(SeverityInfo))
+	assert.Equal(t, "a.yaml:5:1: info: rule 'r': exception e applied", ds[2].Error())
+	assert.True(t, SeverityInfo < SeverityWarning && SeverityWarning < SeverityError)
+}
@@ -22,9 +22,12 @@ package falco
 
 import (
 	"errors"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
@@ -50,8 +53,36 @@ type PolicyCompiler[R any] struct {
 	filters []policy.Filter[R]
 
 	// Accessory parsing maps
-	lists     map[string][]string
-	macroCtxs map[string]parser.IExpressionContext
+	lists      map[string][]string
+	lookups    map[string]*policy.Lookup
+	macroCtxs  map[string][]condCtx
+	exceptions map[string]map[string]*exception
+
+	// Declarations of lists and macros
+	listDecls  map[string]*decl
+	macroDecls map[string]*decl
+
+	// Indicates whether definitions are being pre-processed
+	preprocessing bool
+
+	// Name of the rule being parsed
+	rule string
+
+	// Diagnostics reported during compilation
+	diags policy.Diagnostics
+}
+
+// condCtx denotes a macro or rule condition, which may be appended to a previous definition
+// either conjunctively or disjunctively.
+type condCtx struct {
+	and  bool
+	expr parser.IExpressionContext
+}
+
+// decl denotes the declaration of a list or macro, and whether it is referenced by rules or filters.
+type decl struct {
+	ctx  antlr.ParserRuleContext
+	used bool
 }
 
 // NewPolicyCompiler constructs a new compiler instance.
@@ -61,83 +92,282 @@ func NewPolicyCompiler[R any](ops source.Operations[R]) policy.PolicyCompiler[R]
 	pc.rules = make([]policy.Rule[R], 0)
 	pc.filters = make([]policy.Filter[R], 0)
 	pc.lists = make(map[string][]string)
-	pc.macroCtxs = make(map[string]parser.IExpressionContext)
+	pc.lookups = make(map[string]*policy.Lookup)
+	pc.macroCtxs = make(map[string][]condCtx)
+	pc.exceptions = make(map[string]map[string]*exception)
+	pc.listDecls = make(map[string]*decl)
+	pc.macroDecls = make(map[string]*decl)
 	return pc
 }
 
-// Compile parses and interprets an input policy defined in path.
-func (pc *PolicyCompiler[R]) compile(path string) error {
+// policyFile holds the parsing state of a policy file.
+type policyFile struct {
+	path         string
+	parser       *parser.SfplParser
+	lexerErrors  *errorhandler.SfplErrorListener
+	parserErrors *errorhandler.SfplErrorListener
+}
+
+// preprocess parses the definitions of an input policy defined in path.
+func (pc *PolicyCompiler[R]) preprocess(path string) (*policyFile, error) {
 	// Setup the input
 	is, err := antlr.NewFileStream(path)
 	if err != nil {
 		logger.Error.Println("Error reading policy from path", path)
-		return err
+		return nil, err
 	}
+	f := &policyFile{path: path}
 
 	// Create the Lexer
-	lexerErrors := &errorhandler.SfplErrorListener{}
+	f.lexerErrors = &errorhandler.SfplErrorListener{}
 	lexer := parser.NewSfplLexer(is)
 	lexer.RemoveErrorListeners()
-	lexer.AddErrorListener(lexerErrors)
+	lexer.AddErrorListener(f.lexerErrors)
 	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
 
 	// Create the Parser
-	parserErrors := &errorhandler.SfplErrorListener{}
-	p := parser.NewSfplParser(stream)
-	p.RemoveErrorListeners()
-	p.AddErrorListener(parserErrors)
+	f.parserErrors = &errorhandler.SfplErrorListener{}
+	f.parser = parser.NewSfplParser(stream)
+	f.parser.RemoveErrorListeners()
+	f.parser.AddErrorListener(f.parserErrors)
 
 	// Pre-processing (to deal with usage before definitions of macros and lists)
-	antlr.ParseTreeWalkerDefault.Walk(pc, p.Defs())
-	p.GetInputStream().Seek(0)
+	pc.preprocessing = true
+	antlr.ParseTreeWalkerDefault.Walk(pc, f.parser.Defs())
+	pc.preprocessing = false
+	return f, nil
+}
 
+// Compile parses and interprets an input policy previously pre-processed.
+func (pc *PolicyCompiler[R]) compile(f *policyFile) {
 	// Parse the policy
-	antlr.ParseTreeWalkerDefault.Walk(pc, p.Policy())
-
-	errFound := false
-	if len(lexerErrors.Errors) > 0 {
-		logger.Error.Printf("Lexer %d errors found\n", len(lexerErrors.Errors))
-		for _, e := range lexerErrors.Errors {
-			logger.Error.Println("\t", e.Error())
-		}
-		errFound = true
-	}
-	if len(parserErrors.Errors) > 0 {
-		logger.Error.Printf("Parser %d errors found\n", len(parserErrors.Errors))
-		for _, e := range parserErrors.Errors {
-			logger.Error.Println("\t", e.Error())
+	f.parser.GetInputStream().Seek(0)
+	antlr.ParseTreeWalkerDefault.Walk(pc, f.parser.Policy())
+
+	// Report syntax errors
+	for _, errs := range [][]error{f.lexerErrors.Errors, f.parserErrors.Errors} {
+		for _, e := range errs {
+			d := policy.Diagnostic{File: f.path, Severity: policy.SeverityError, Msg: e.Error()}
+			if se, ok := e.(*errorhandler.SfplSyntaxError); ok {
+				d.Line, d.Column, d.Msg = se.Line(), se.Column()+1, se.Msg()
+			}
+			pc.diags.Add(d)
 		}
-		errFound = true
 	}
-
-	if errFound {
-		return errors.New("errors found during compilation of policies. check logs for detail")
-	}
-
-	return nil
 }
 
 // Compile parses a set of input policies defined in paths.
+// Definitions of lists and macros are pre-processed for all policies before compiling rules, so
+// that they can be used and appended to across policy files. If errors are found, the returned
+// error is the list of policy.Diagnostics reported during compilation.
 func (pc *PolicyCompiler[R]) Compile(paths ...string) ([]policy.Rule[R], []policy.Filter[R], error) {
+	files := make([]*policyFile, 0, len(paths))
 	for _, path := range paths {
-		logger.Trace.Println("Parsing policy file ", path)
-		if err := pc.compile(path); err != nil {
+		logger.Trace.Println("Pre-processing policy file ", path)
+		f, err := pc.preprocess(path)
+		if err != nil {
 			return nil, nil, err
 		}
+		files = append(files, f)
+	}
+	for _, f := range files {
+		logger.Trace.Println("Parsing policy file ", f.path)
+		pc.compile(f)
+	}
+	pc.diags.Log()
+	if pc.diags.HasErrors() {
+		return nil, nil, pc.diags
 	}
 	return pc.rules, pc.filters, nil
 }
 
+// Summary returns the summary of the last compilation, reporting lists and macros that are
+// not referenced by any rule or filter as warnings.
+func (pc *PolicyCompiler[R]) Summary() policy.Summary {
+	pc.reportUnused("list", pc.listDecls)
+	pc.reportUnused("macro", pc.macroDecls)
+	return policy.Summary{
+		Rules:       len(pc.rules),
+		Filters:     len(pc.filters),
+		Macros:      len(pc.macroCtxs),
+		Lists:       len(pc.lists) + len(pc.lookups),
+		Diagnostics: pc.diags,
+	}
+}
+
+// Lookups returns the lookup lists loaded in the last compilation, in alphabetical order.
+func (pc *PolicyCompiler[R]) Lookups() []*policy.Lookup {
+	names := make([]string, 0, len(pc.lookups))
+	for name := range pc.lookups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	lookups := make([]*policy.Lookup, 0, len(names))
+	for _, name := range names {
+		lookups = append(lookups, pc.lookups[name])
+	}
+	return lookups
+}
+
+// reportUnused warns about declarations that are not referenced, in alphabetical order.
+func (pc *PolicyCompiler[R]) reportUnused(kind string, decls map[string]*decl) {
+	names := make([]string, 0, len(decls))
+	for name, d := range decls {
+		if !d.used {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pc.warnf(decls[name].ctx, "unused %s %s", kind, name)
+	}
+}
+
 // ExitList is called when production list is exited.
 func (pc *PolicyCompiler[R]) ExitPlist(ctx *parser.PlistContext) {
+	if !pc.preprocessing {
+		return
+	}
 	logger.Trace.Println("Parsing list ", ctx.GetText())
-	pc.lists[ctx.ID().GetText()] = pc.extractListFromItems(ctx.Items())
+	name := ctx.ID().GetText()
+	if ctx.Lookup() != nil {
+		if l := pc.getLookup(name, ctx.Lookup().(*parser.LookupContext)); l != nil {
+			delete(pc.lists, name)
+			pc.lookups[name] = l
+			pc.listDecls[name] = &decl{ctx: ctx}
+		}
+		return
+	}
+	items := pc.extractListFromItems(ctx.Items())
+	if pc.getAppendFlag(ctx.Fappend()) {
+		if _, ok := pc.lookups[name]; ok {
+			pc.errorf(ctx, "cannot append to lookup list %s", name)
+			return
+		}
+		l, ok := pc.lists[name]
+		if !ok {
+			pc.errorf(ctx, "cannot append to undefined list %s", name)
+			return
+		}
+		pc.lists[name] = append(l, items...)
+		return
+	}
+	delete(pc.lookups, name)
+	pc.lists[name] = items
+	pc.listDecls[name] = &decl{ctx: ctx}
+}
+
+// getLookup loads the lookup list declared in ctx. Relative lookup paths are resolved against the
+// directory of the policy file.
+func (pc *PolicyCompiler[R]) getLookup(name string, ctx *parser.LookupContext) *policy.Lookup {
+	var path, format string
+	var column int
+	var interval time.Duration
+	for _, ictx := range ctx.AllThresholdattr() {
+		actx := ictx.(*parser.ThresholdattrContext)
+		k := common.TrimBoundingQuotes(actx.GetChild(0).(antlr.ParseTree).GetText())
+		v := common.TrimBoundingQuotes(actx.GetChild(2).(antlr.ParseTree).GetText())
+		switch k {
+		case LookupPath:
+			path = v
+		case LookupFormat:
+			format = strings.ToLower(v)
+		case LookupColumn:
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				pc.errorf(actx, "invalid lookup column %s", v)
+				return nil
+			}
+			column = n
+		case LookupReload:
+			d, err := common.ParseDuration(v)
+			if err != nil {
+				pc.errorf(actx, "invalid lookup reload interval %s", v)
+				return nil
+			}
+			interval = d
+		default:
+			pc.warnf(actx, "unrecognized lookup attribute %s", k)
+		}
+	}
+	if path == "" {
+		pc.errorf(ctx, "lookup list %s does not define a path", name)
+		return nil
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(ctx.GetStart().GetInputStream().GetSourceName()), path)
+	}
+	l, err := policy.NewLookup(name, path, format, column, interval)
+	if err != nil {
+		pc.errorf(ctx, "%v", err)
+		return nil
+	}
+	return l
 }
 
 // ExitMacro is called when production macro is exited.
 func (pc *PolicyCompiler[R]) ExitPmacro(ctx *parser.PmacroContext) {
+	if !pc.preprocessing {
+		return
+	}
 	logger.Trace.Println("Parsing macro ", ctx.GetText())
-	pc.macroCtxs[ctx.ID().GetText()] = ctx.Expression()
+	name := ctx.ID().GetText()
+	c := pc.getCondCtx(ctx.Condop(), ctx.Expression(), false)
+	if pc.getAppendFlag(ctx.Fappend()) {
+		m, ok := pc.macroCtxs[name]
+		if !ok {
+			pc.errorf(ctx, "cannot append to undefined macro %s", name)
+			return
+		}
+		pc.macroCtxs[name] = append(m, c)
+		return
+	}
+	if ctx.Condop() != nil {
+		pc.errorf(ctx, "unexpected operator %s in condition of macro %s", ctx.Condop().GetText(), name)
+	}
+	pc.macroCtxs[name] = []condCtx{c}
+	pc.macroDecls[name] = &decl{ctx: ctx}
+}
+
+// EnterParule is called when production rule append is entered.
+func (pc *PolicyCompiler[R]) EnterParule(ctx *parser.ParuleContext) {
+	pc.rule = pc.getOffChannelText(ctx.Text())
+}
+
+// ExitParule is called when production rule append is exited.
+func (pc *PolicyCompiler[R]) ExitParule(ctx *parser.ParuleContext) {
+	defer func() { pc.rule = "" }()
+	if pc.preprocessing {
+		return
+	}
+	logger.Trace.Println("Parsing rule append ", ctx.GetText())
+	name := pc.rule
+	if !pc.getAppendFlag(ctx.Fappend(0)) {
+		pc.errorf(ctx, "rule must define a description, or set append to true")
+		return
+	}
+	i := pc.findRule(name)
+	if i < 0 {
+		pc.errorf(ctx, "cannot append to undefined rule")
+		return
+	}
+	r := &pc.rules[i]
+	var op parser.ICondopContext
+	for _, child := range ctx.GetChildren() {
+		switch c := child.(type) {
+		case parser.ICondopContext:
+			op = c
+		case parser.IExpressionContext:
+			if r.Sequence != nil {
+				pc.errorf(ctx, "cannot append a condition to a sequence rule")
+				return
+			}
+			r.Condition = pc.appendCondition(r.Condition, pc.getCondCtx(op, c, true))
+			op = nil
+		case parser.IExceptionsContext:
+			pc.applyExceptions(r, c)
+		}
+	}
 }
 
 // ExitFilter is called when production filter is exited.
@@ -151,28 +381,165 @@ func (pc *PolicyCompiler[R]) ExitPfilter(ctx *parser.PfilterContext) {
 	pc.filters = append(pc.filters, f)
 }
 
-// ExitFilter is called when production filter is exited.
+// EnterPrule is called when production rule is entered.
+func (pc *PolicyCompiler[R]) EnterPrule(ctx *parser.PruleContext) {
+	pc.rule = pc.getOffChannelText(ctx.Text(0))
+}
+
+// ExitPrule is called when production rule is exited.
 func (pc *PolicyCompiler[R]) ExitPrule(ctx *parser.PruleContext) {
 	logger.Trace.Println("Parsing rule ", ctx.GetText())
+	defer func() { pc.rule = "" }()
 	r := policy.Rule[R]{
-		Name:      pc.getOffChannelText(ctx.Text(0)),
+		Name:      pc.rule,
 		Desc:      pc.getOffChannelText(ctx.Text(1)),
-		Condition: pc.visitExpression(ctx.Expression()),
 		Actions:   pc.getActions(ctx),
 		Tags:      pc.getTags(ctx),
 		Priority:  pc.getPriority(ctx),
 		Prefilter: pc.getPrefilter(ctx),
 		Enabled:   ctx.ENABLED(0) == nil || pc.getEnabledFlag(ctx.Enabled(0)),
+		Source:    policy.Source{Language: Language, File: ctx.GetStart().GetInputStream().GetSourceName()},
+	}
+	if ctx.Sequence() != nil {
+		r.Sequence = pc.getSequence(ctx)
+		r.Condition = policy.Any(r.Sequence.Steps)
+		for i, e := range ctx.Sequence().(*parser.SequenceContext).AllExpression() {
+			pc.checkReachable(e, r.Sequence.Steps[i], r.Prefilter)
+		}
+	} else {
+		r.Condition = pc.visitExpression(ctx.Expression())
+		pc.checkReachable(ctx.Expression(), r.Condition, r.Prefilter)
+		if ctx.GROUPBY(0) != nil || ctx.WINDOW(0) != nil {
+			pc.warnf(ctx, "attributes group_by and window are only applicable to sequence rules, ignoring them")
+		}
+	}
+	if ctx.Threshold(0) != nil {
+		r.Threshold = pc.getThreshold(ctx.Threshold(0).(*parser.ThresholdContext))
+	}
+	if ctx.Suppress(0) != nil {
+		r.Suppress = pc.getSuppression(ctx.Suppress(0).(*parser.SuppressContext))
+	}
+	pc.exceptions[r.Name] = make(map[string]*exception)
+	if ctx.Exceptions(0) != nil {
+		pc.applyExceptions(&r, ctx.Exceptions(0))
+	}
+	if ctx.OUTPUT(0) != nil {
+		r.Output = policy.NewTemplate(pc.getOutput(ctx.Text(2)), pc.ops.MapStr)
+	}
+	if pc.findRule(r.Name) >= 0 {
+		pc.warnf(ctx, "rule is already defined, set append to true to extend it")
 	}
 	pc.rules = append(pc.rules, r)
 }
 
+func (pc *PolicyCompiler[R]) getThreshold(ctx *parser.ThresholdContext) *policy.Threshold {
+	th := &policy.Threshold{Count: 1, GroupBy: make([]string, 0)}
+	for _, ictx := range ctx.AllThresholdattr() {
+		actx := ictx.(*parser.ThresholdattrContext)
+		k := common.TrimBoundingQuotes(actx.GetChild(0).(antlr.ParseTree).GetText())
+		v := actx.GetChild(2).(antlr.ParseTree).GetText()
+		switch k {
+		case ThresholdCount:
+			if n, err := strconv.Atoi(common.TrimBoundingQuotes(v)); err == nil && n > 0 {
+				th.Count = n
+			} else {
+				pc.warnf(actx, "unrecognized threshold count %s, deferring to %d", v, th.Count)
+			}
+		case ThresholdWindow:
+			if d, err := common.ParseDuration(v); err == nil {
+				th.Window = d
+			} else {
+				pc.warnf(actx, "unrecognized window value %s, threshold will not expire", v)
+			}
+		case ThresholdGroupBy:
+			th.GroupBy = append(th.GroupBy, pc.extractList(v)...)
+		case ThresholdDistinct:
+			th.Distinct = common.TrimBoundingQuotes(v)
+		default:
+			pc.warnf(actx, "unrecognized threshold attribute %s", k)
+		}
+	}
+	return th
+}
+
+func (pc *PolicyCompiler[R]) getSuppression(ctx *parser.SuppressContext) *policy.Suppression {
+	s := &policy.Suppression{GroupBy: make([]string, 0)}
+	for _, ictx := range ctx.AllThresholdattr() {
+		actx := ictx.(*parser.ThresholdattrContext)
+		k := common.TrimBoundingQuotes(actx.GetChild(0).(antlr.ParseTree).GetText())
+		v := actx.GetChild(2).(antlr.ParseTree).GetText()
+		switch k {
+		case SuppressWindow:
+			if d, err := common.ParseDuration(v); err == nil {
+				s.Window = d
+			} else {
+				pc.warnf(actx, "unrecognized window value %s, alerts will not be suppressed", v)
+			}
+		case SuppressGroupBy:
+			s.GroupBy = append(s.GroupBy, pc.extractList(v)...)
+		default:
+			pc.warnf(actx, "unrecognized suppress attribute %s", k)
+		}
+	}
+	return s
+}
+
+func (pc *PolicyCompiler[R]) getSequence(ctx *parser.PruleContext) *policy.Sequence[R] {
+	seq := &policy.Sequence[R]{GroupBy: make([]string, 0)}
+	for _, e := range ctx.Sequence().(*parser.SequenceContext).AllExpression() {
+		seq.Steps = append(seq.Steps, pc.visitExpression(e))
+	}
+	if ictx := ctx.Groupby(0); ictx != nil {
+		seq.GroupBy = append(seq.GroupBy, pc.extractList(ictx.GetText())...)
+	}
+	if ictx := ctx.Window(0); ictx != nil {
+		w := ictx.GetText()
+		if d, err := common.ParseDuration(w); err == nil {
+			seq.Window = d
+		} else {
+			pc.warnf(ictx, "unrecognized window value %s, sequence will not expire", w)
+		}
+	}
+	return seq
+}
+
+func (pc *PolicyCompiler[R]) getAppendFlag(ctx parser.IFappendContext) bool {
+	if ctx == nil {
+		return false
+	}
+	flag := common.TrimBoundingQuotes(ctx.GetText())
+	if b, err := strconv.ParseBool(flag); err == nil {
+		return b
+	}
+	pc.warnf(ctx, "unrecognized append flag %s", flag)
+	return false
+}
+
+// getCondCtx creates a condition context, in which a leading operator (if any) determines how the
+// condition is appended to a previous definition. Otherwise, and is used as the default operator.
+func (pc *PolicyCompiler[R]) getCondCtx(op parser.ICondopContext, expr parser.IExpressionContext, and bool) condCtx {
+	if op != nil {
+		and = op.(*parser.CondopContext).AND() != nil
+	}
+	return condCtx{and: and, expr: expr}
+}
+
+// findRule returns the index of the last rule defined with name, or -1 if the rule is undefined.
+func (pc *PolicyCompiler[R]) findRule(name string) int {
+	for i := len(pc.rules) - 1; i >= 0; i-- {
+		if pc.rules[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
 func (pc *PolicyCompiler[R]) getEnabledFlag(ctx parser.IEnabledContext) bool {
 	flag := common.TrimBoundingQuotes(ctx.GetText())
 	if b, err := strconv.ParseBool(flag); err == nil {
 		return b
 	}
-	logger.Warn.Println("Unrecognized enabled flag: ", flag)
+	pc.warnf(ctx, "unrecognized enabled flag %s", flag)
 	return true
 }
 
@@ -183,6 +550,15 @@ func (pc *PolicyCompiler[R]) getOffChannelText(ctx parser.ITextContext) string {
 	return ctx.GetStart().GetInputStream().GetTextFromInterval(&interval)
 }
 
+// getOutput retrieves the output format of a rule, folding multi-line (block) outputs into a single line.
+func (pc *PolicyCompiler[R]) getOutput(ctx parser.ITextContext) string {
+	lines := strings.Split(common.TrimBoundingQuotes(pc.getOffChannelText(ctx)), "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimSpace(l)
+	}
+	return strings.Join(lines, " ")
+}
+
 func (pc *PolicyCompiler[R]) getTags(ctx *parser.PruleContext) []policy.EnrichmentTag {
 	var tags = make([]policy.EnrichmentTag, 0)
 	ictx := ctx.Tags(0)
@@ -230,7 +606,7 @@ func (pc *PolicyCompiler[R]) getPriority(ctx *parser.PruleContext) policy.Priori
 		case FPriorityEmergency:
 			return policy.High
 		default:
-			logger.Warn.Printf("Unrecognized priority value %s. Deferring to %s\n", p, policy.Low.String())
+			pc.warnf(ictx, "unrecognized priority value %s, deferring to %s", p, policy.Low.String())
 		}
 	}
 	return policy.Low
@@ -285,15 +661,38 @@ func (pc *PolicyCompiler[R]) extractListFromAtoms(ctxs []parser.IAtomContext) []
 func (pc *PolicyCompiler[R]) reduceList(sl string) []string {
 	s := []string{}
 	if l, ok := pc.lists[sl]; ok {
+		pc.listDecls[sl].used = true
 		for _, v := range l {
 			s = append(s, pc.reduceList(v)...)
 		}
-	} else {
+	} else if _, ok := pc.lookups[sl]; !ok {
 		s = append(s, common.TrimBoundingQuotes(sl))
 	}
 	return s
 }
 
+// extractLookupsFromAtoms returns the lookup lists referenced by a list of atoms, directly or through lists.
+func (pc *PolicyCompiler[R]) extractLookupsFromAtoms(ctxs []parser.IAtomContext) []*policy.Lookup {
+	var lookups []*policy.Lookup
+	for _, v := range ctxs {
+		lookups = append(lookups, pc.reduceLookups(v.GetText())...)
+	}
+	return lookups
+}
+
+// reduceLookups returns the lookup lists referenced by sl, directly or through lists.
+func (pc *PolicyCompiler[R]) reduceLookups(sl string) []*policy.Lookup {
+	if l, ok := pc.lookups[sl]; ok {
+		pc.listDecls[sl].used = true
+		return []*policy.Lookup{l}
+	}
+	var lookups []*policy.Lookup
+	for _, v := range pc.lists[sl] {
+		lookups = append(lookups, pc.reduceLookups(v)...)
+	}
+	return lookups
+}
+
 func (pc *PolicyCompiler[R]) visitExpression(ctx parser.IExpressionContext) policy.Criterion[R] {
 	orCtx := ctx.GetChild(0).(parser.IOr_expressionContext)
 	orPreds := make([]policy.Criterion[R], 0)
@@ -313,58 +712,149 @@ func (pc *PolicyCompiler[R]) visitExpression(ctx parser.IExpressionContext) poli
 	return policy.Any(orPreds)
 }
 
+// visitConditions visits a condition along with its appended conditions.
+func (pc *PolicyCompiler[R]) visitConditions(cs []condCtx) policy.Criterion[R] {
+	c := pc.visitExpression(cs[0].expr)
+	for _, a := range cs[1:] {
+		c = pc.appendCondition(c, a)
+	}
+	return c
+}
+
+// appendCondition extends criterion c with an appended condition.
+func (pc *PolicyCompiler[R]) appendCondition(c policy.Criterion[R], a condCtx) policy.Criterion[R] {
+	if a.and {
+		return c.And(pc.visitExpression(a.expr))
+	}
+	return c.Or(pc.visitExpression(a.expr))
+}
+
 func (pc *PolicyCompiler[R]) visitTerm(ctx parser.ITermContext) policy.Criterion[R] {
 	termCtx := ctx.(*parser.TermContext)
 	if termCtx.Variable() != nil {
 		if m, ok := pc.macroCtxs[termCtx.GetText()]; ok {
-			return pc.visitExpression(m)
+			pc.macroDecls[termCtx.GetText()].used = true
+			return pc.visitConditions(m)
 		}
-		logger.Error.Println("Unrecognized reference ", termCtx.GetText())
+		pc.errorf(termCtx, "undefined macro %s", termCtx.GetText())
 	} else if termCtx.NOT() != nil {
 		return pc.visitTerm(termCtx.GetChild(1).(parser.ITermContext)).Not()
 	} else if opCtx, ok := termCtx.Unary_operator().(*parser.Unary_operatorContext); ok {
 		lop := termCtx.Atom(0).(*parser.AtomContext).GetText()
 		if opCtx.EXISTS() != nil {
-			return policy.First(pc.ops.Exists(lop))
+			pc.checkField(termCtx, lop)
+			c, err := pc.ops.Exists(lop)
+			return pc.first(termCtx, c, err)
+		}
+		var class source.Operator
+		if opCtx.ISPRIVATE() != nil {
+			class = source.IsPrivate
+		} else if opCtx.ISLOOPBACK() != nil {
+			class = source.IsLoopback
+		} else if opCtx.ISLINKLOCAL() != nil {
+			class = source.IsLinkLocal
+		} else {
+			pc.errorf(opCtx, "unrecognized unary operator %s", opCtx.GetText())
+			return policy.False[R]()
 		}
-		logger.Error.Println("Unrecognized unary operator ", opCtx.GetText())
+		pc.checkField(termCtx, lop)
+		c, err := pc.inCIDR(lop, source.IPClasses[class])
+		return pc.first(termCtx, c, err)
 	} else if opCtx, ok := termCtx.Binary_operator().(*parser.Binary_operatorContext); ok {
 		lop := termCtx.Atom(0).(*parser.AtomContext).GetText()
 		rop := termCtx.Atom(1).(*parser.AtomContext).GetText()
 		if opCtx.CONTAINS() != nil {
-			return policy.First(pc.ops.Compare(lop, rop, source.Contains))
+			return pc.compare(termCtx, lop, rop, source.Contains)
 		} else if opCtx.ICONTAINS() != nil {
-			return policy.First(pc.ops.Compare(lop, rop, source.IContains))
+			return pc.compare(termCtx, lop, rop, source.IContains)
 		} else if opCtx.STARTSWITH() != nil {
-			return policy.First(pc.ops.Compare(lop, rop, source.Startswith))
+			return pc.compare(termCtx, lop, rop, source.Startswith)
 		} else if opCtx.ENDSWITH() != nil {
-			return policy.First(pc.ops.Compare(lop, rop, source.Endswith))
+			return pc.compare(termCtx, lop, rop, source.Endswith)
 		} else if opCtx.EQ() != nil {
-			return policy.First(pc.ops.Compare(lop, rop, source.Eq))
+			return pc.compare(termCtx, lop, rop, source.Eq)
 		} else if opCtx.NEQ() != nil {
-			return policy.First(pc.ops.Compare(lop, rop, source.Eq)).Not()
-		} else if opCtx.GT() != nil {
-			return policy.First(pc.ops.Compare(lop, rop, source.Gt))
+			return pc.compare(termCtx, lop, rop, source.Eq).Not()
+		} else if opCtx.GLOB() != nil {
+			pc.checkField(termCtx, lop)
+			c, err := pc.ops.Glob(lop, common.TrimBoundingQuotes(rop))
+			return pc.first(termCtx, c, err)
+		} else if opCtx.REGEX() != nil {
+			pc.checkField(termCtx, lop)
+			c, err := pc.ops.RegExp(lop, common.TrimBoundingQuotes(rop))
+			return pc.first(termCtx, c, err)
+		}
+		pc.checkNumeric(termCtx, opCtx.GetText(), lop, rop)
+		if opCtx.GT() != nil {
+			return pc.compare(termCtx, lop, rop, source.Gt)
 		} else if opCtx.GE() != nil {
-			return policy.First(pc.ops.Compare(lop, rop, source.GEq))
+			return pc.compare(termCtx, lop, rop, source.GEq)
 		} else if opCtx.LT() != nil {
-			return policy.First(pc.ops.Compare(lop, rop, source.Lt))
+			return pc.compare(termCtx, lop, rop, source.Lt)
 		} else if opCtx.LE() != nil {
-			return policy.First(pc.ops.Compare(lop, rop, source.LEq))
+			return pc.compare(termCtx, lop, rop, source.LEq)
 		}
-		logger.Error.Println("Unrecognized binary operator ", opCtx.GetText())
+		pc.errorf(opCtx, "unrecognized binary operator %s", opCtx.GetText())
 	} else if termCtx.Expression() != nil {
 		return pc.visitExpression(termCtx.Expression())
 	} else if termCtx.IN() != nil {
-		lop := termCtx.Atom(0).(*parser.AtomContext).GetText()
-		rop := termCtx.AllAtom()[1:]
-		return policy.First(pc.ops.FoldAny(lop, pc.extractListFromAtoms(rop), source.Eq))
+		return pc.foldList(termCtx, source.Eq)
 	} else if termCtx.PMATCH() != nil {
-		lop := termCtx.Atom(0).(*parser.AtomContext).GetText()
-		rop := termCtx.AllAtom()[1:]
-		return policy.First(pc.ops.FoldAny(lop, pc.extractListFromAtoms(rop), source.Contains))
+		return pc.foldList(termCtx, source.Contains)
+	} else if termCtx.INCIDR() != nil {
+		return pc.foldList(termCtx, source.InCIDR)
 	} else {
-		logger.Warn.Println("Unrecognized term ", termCtx.GetText())
+		pc.errorf(termCtx, "unrecognized term %s", termCtx.GetText())
 	}
 	return policy.False[R]()
 }
+
+// foldList creates a disjunctive criterion for a list operator, over the values of the list operands and
+// of the lookup lists they reference.
+func (pc *PolicyCompiler[R]) foldList(ctx *parser.TermContext, op source.Operator) policy.Criterion[R] {
+	lop := ctx.Atom(0).(*parser.AtomContext).GetText()
+	rop := ctx.AllAtom()[1:]
+	pc.checkField(ctx, lop)
+	values := pc.extractListFromAtoms(rop)
+	lookups := pc.extractLookupsFromAtoms(rop)
+	var c policy.Criterion[R]
+	var err error
+	if op == source.InCIDR {
+		c, err = pc.inCIDR(lop, values)
+	} else {
+		c, err = pc.ops.FoldAny(lop, values, op)
+	}
+	if len(lookups) == 0 {
+		return pc.first(ctx, c, err)
+	}
+	lops, ok := pc.ops.(source.LookupOperations[R])
+	if !ok {
+		pc.errorf(ctx, "lookup lists are not supported by the policy source")
+		return policy.False[R]()
+	}
+	criteria := make([]policy.Criterion[R], 0, len(lookups)+1)
+	if len(values) > 0 {
+		criteria = append(criteria, pc.first(ctx, c, err))
+	}
+	for _, l := range lookups {
+		c, err := lops.FoldLookup(lop, l, op)
+		criteria = append(criteria, pc.first(ctx, c, err))
+	}
+	return policy.Any(criteria)
+}
+
+// inCIDR creates a criterion for a predicate that holds if an IP address of attr belongs to any of a list of networks.
+func (pc *PolicyCompiler[R]) inCIDR(attr string, cidrs []string) (policy.Criterion[R], error) {
+	ops, ok := pc.ops.(source.NetOperations[R])
+	if !ok {
+		return policy.False[R](), errors.New("network operators are not supported by the policy source")
+	}
+	return ops.InCIDR(attr, cidrs)
+}
+
+// compare creates a criterion for a binary predicate, checking that its left operand is a known attribute.
+func (pc *PolicyCompiler[R]) compare(ctx antlr.ParserRuleContext, lop string, rop string, op source.Operator) policy.Criterion[R] {
+	pc.checkField(ctx, lop)
+	c, err := pc.ops.Compare(lop, rop, op)
+	return pc.first(ctx, c, err)
+}
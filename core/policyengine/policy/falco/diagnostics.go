@@ -0,0 +1,130 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package falco implements a frontend for (extended) Falco rules engine.
+package falco
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/common"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy/falco/lang/parser"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
+)
+
+// report records a diagnostic located at the first token of ctx.
+func (pc *PolicyCompiler[R]) report(ctx antlr.ParserRuleContext, sev policy.Severity, format string, args ...interface{}) {
+	d := policy.Diagnostic{Rule: pc.rule, Severity: sev, Msg: fmt.Sprintf(format, args...)}
+	if tk := ctx.GetStart(); tk != nil {
+		d.File = tk.GetInputStream().GetSourceName()
+		d.Line, d.Column = tk.GetLine(), tk.GetColumn()+1
+	}
+	pc.diags.Add(d)
+}
+
+// errorf reports a compilation error, which causes the compilation to fail.
+func (pc *PolicyCompiler[R]) errorf(ctx antlr.ParserRuleContext, format string, args ...interface{}) {
+	pc.report(ctx, policy.SeverityError, format, args...)
+}
+
+// warnf reports a compilation warning.
+func (pc *PolicyCompiler[R]) warnf(ctx antlr.ParserRuleContext, format string, args ...interface{}) {
+	pc.report(ctx, policy.SeverityWarning, format, args...)
+}
+
+// infof reports an informational diagnostic, such as the application of a rule exception.
+func (pc *PolicyCompiler[R]) infof(ctx antlr.ParserRuleContext, format string, args ...interface{}) {
+	pc.report(ctx, policy.SeverityInfo, format, args...)
+}
+
+// first returns criterion c, or reports err and returns a false criterion if err is not nil.
+func (pc *PolicyCompiler[R]) first(ctx antlr.ParserRuleContext, c policy.Criterion[R], err error) policy.Criterion[R] {
+	if err != nil {
+		pc.errorf(ctx, "%v", err)
+		return policy.False[R]()
+	}
+	return c
+}
+
+// checkField warns if attr is not an attribute of the source schema, and returns its kind.
+// Unknown attributes are not errors, since Falco policies may reference fields that are not
+// supported by the source; such comparisons never match.
+func (pc *PolicyCompiler[R]) checkField(ctx antlr.ParserRuleContext, attr string) source.FieldKind {
+	schema, ok := pc.ops.(source.Schema)
+	if !ok {
+		return source.AnyKind
+	}
+	kind, ok := schema.Field(attr)
+	if !ok {
+		pc.warnf(ctx, "unknown field %s", attr)
+	}
+	return kind
+}
+
+// checkNumeric reports an error if the operands of a numerical comparison are not integers.
+func (pc *PolicyCompiler[R]) checkNumeric(ctx antlr.ParserRuleContext, op string, lattr string, rattr string) {
+	if pc.checkField(ctx, lattr) == source.StrKind {
+		pc.errorf(ctx, "operator %s cannot be applied to string field %s", op, lattr)
+		return
+	}
+	if schema, ok := pc.ops.(source.Schema); ok {
+		if kind, ok := schema.Field(rattr); ok {
+			if kind == source.StrKind {
+				pc.errorf(ctx, "operator %s cannot be applied to string field %s", op, rattr)
+			}
+			return
+		}
+	}
+	if _, err := strconv.ParseInt(common.TrimBoundingQuotes(rattr), 10, 64); err != nil {
+		pc.errorf(ctx, "operator %s expects a numeric value, found %s", op, rattr)
+	}
+}
+
+// checkReachable warns if no record type can satisfy criterion c, compiled from condition ctx, given the
+// prefilter of the rule. The record types satisfying c are those inferred by the source for the prefilter
+// index of the policy engine, so that both analyses agree. Rules with errors are not checked, since their
+// invalid predicates never match.
+func (pc *PolicyCompiler[R]) checkReachable(ctx parser.IExpressionContext, c policy.Criterion[R], prefilter []string) {
+	for _, d := range pc.diags {
+		if d.Rule == pc.rule && d.Severity == policy.SeverityError {
+			return
+		}
+	}
+	values, ok := c.Prefilter()
+	if !ok {
+		if len(prefilter) == 0 {
+			return
+		}
+		values = prefilter
+	}
+	for _, v := range values {
+		if len(prefilter) == 0 {
+			return
+		}
+		for _, w := range prefilter {
+			if v == w {
+				return
+			}
+		}
+	}
+	pc.warnf(ctx, "rule is unreachable: no record type satisfies its condition and prefilter")
+}
@@ -0,0 +1,264 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package falco implements a frontend for (extended) Falco rules engine.
+package falco
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/common"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy/falco/lang/parser"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
+)
+
+// exception holds the definition of a named rule exception.
+type exception struct {
+	name   string
+	fields []string
+	comps  []string
+	// single indicates that fields is a single attribute, whose values are scalars.
+	single bool
+}
+
+// applyExceptions compiles the exceptions of rule r into negated criteria, which are conjunctively
+// appended to the rule condition. Exceptions already defined for r can be extended with new values.
+func (pc *PolicyCompiler[R]) applyExceptions(r *policy.Rule[R], ctx parser.IExceptionsContext) {
+	defs, ok := pc.exceptions[r.Name]
+	if !ok {
+		defs = make(map[string]*exception)
+		pc.exceptions[r.Name] = defs
+	}
+	for _, ictx := range ctx.(*parser.ExceptionsContext).AllException() {
+		ectx := ictx.(*parser.ExceptionContext)
+		name := common.TrimBoundingQuotes(ectx.Atom(0).GetText())
+		e, defined := defs[name]
+		if ectx.FIELDS(0) != nil {
+			if defined {
+				pc.errorf(ectx, "exception %s is already defined", name)
+				continue
+			}
+			if e = pc.getException(ectx, name); e == nil {
+				continue
+			}
+			defs[name] = e
+		} else if !defined {
+			pc.errorf(ectx, "exception %s does not define fields", name)
+			continue
+		}
+		for _, vctx := range ectx.AllExvalues() {
+			c, ok := pc.visitException(e, vctx.(*parser.ExvaluesContext))
+			if !ok {
+				continue
+			}
+			if r.Sequence != nil {
+				for i := range r.Sequence.Steps {
+					r.Sequence.Steps[i] = r.Sequence.Steps[i].And(c.Not())
+				}
+				r.Condition = policy.Any(r.Sequence.Steps)
+			} else {
+				r.Condition = r.Condition.And(c.Not())
+			}
+		}
+	}
+}
+
+// getException parses the fields and comparison operators of an exception.
+func (pc *PolicyCompiler[R]) getException(ctx *parser.ExceptionContext, name string) *exception {
+	e := &exception{name: name}
+	if ictx := ctx.Items(0); ictx != nil {
+		e.fields = pc.extractListFromItems(ictx)
+	} else {
+		e.fields = []string{common.TrimBoundingQuotes(ctx.Atom(1).GetText())}
+		e.single = true
+	}
+	if ictx := ctx.Comps(0); ictx != nil {
+		for _, c := range ictx.(*parser.CompsContext).AllCompop() {
+			e.comps = append(e.comps, strings.ToLower(c.GetText()))
+		}
+	} else if ictx := ctx.Compop(0); ictx != nil {
+		e.comps = []string{strings.ToLower(ictx.GetText())}
+	} else {
+		comp := ExceptionEq
+		if e.single {
+			comp = ExceptionIn
+		}
+		for range e.fields {
+			e.comps = append(e.comps, comp)
+		}
+	}
+	if len(e.comps) != len(e.fields) {
+		pc.errorf(ctx, "exception %s defines %d fields and %d comparison operators", name, len(e.fields), len(e.comps))
+		return nil
+	}
+	return e
+}
+
+// visitException creates a criterion matching any of the value tuples of exception e, and reports
+// the exception in the compile diagnostics.
+func (pc *PolicyCompiler[R]) visitException(e *exception, ctx *parser.ExvaluesContext) (policy.Criterion[R], bool) {
+	tuples := make([][][]string, 0)
+	for _, tctx := range ctx.AllExtuple() {
+		t := tctx.(*parser.ExtupleContext)
+		if e.single {
+			if t.Atom() == nil {
+				pc.errorf(t, "exception %s expects scalar values", e.name)
+				return policy.False[R](), false
+			}
+			if !pc.checkNoLookups(t, e, t.Atom().GetText()) {
+				return policy.False[R](), false
+			}
+			tuples = append(tuples, [][]string{pc.reduceList(t.Atom().GetText())})
+			continue
+		}
+		if len(t.AllExvalue()) != len(e.fields) {
+			pc.errorf(t, "exception %s expects tuples of %d values, found %s", e.name, len(e.fields), t.GetText())
+			return policy.False[R](), false
+		}
+		tuple := make([][]string, 0, len(e.fields))
+		for i, vctx := range t.AllExvalue() {
+			v := vctx.(*parser.ExvalueContext)
+			if v.Items() != nil {
+				tuple = append(tuple, pc.extractListFromItems(v.Items()))
+			} else if e.comps[i] == ExceptionIn || e.comps[i] == ExceptionPmatch || e.comps[i] == ExceptionInCIDR {
+				if !pc.checkNoLookups(v, e, v.Atom().GetText()) {
+					return policy.False[R](), false
+				}
+				tuple = append(tuple, pc.reduceList(v.Atom().GetText()))
+			} else {
+				tuple = append(tuple, []string{common.TrimBoundingQuotes(v.Atom().GetText())})
+			}
+		}
+		tuples = append(tuples, tuple)
+	}
+	if len(tuples) == 0 {
+		return policy.False[R](), false
+	}
+
+	// single-field equality exceptions are folded into a single list comparison
+	if len(e.fields) == 1 && (e.comps[0] == ExceptionEq || e.comps[0] == ExceptionIn) {
+		values := make([]string, 0, len(tuples))
+		for _, t := range tuples {
+			values = append(values, t[0]...)
+		}
+		c, err := pc.ops.FoldAny(e.fields[0], values, source.Eq)
+		if err != nil {
+			pc.errorf(ctx, "exception %s: %v", e.name, err)
+			return policy.False[R](), false
+		}
+		pc.reportException(ctx, e, tuples)
+		return c, true
+	}
+
+	preds := make([]policy.Criterion[R], 0, len(tuples))
+	for _, t := range tuples {
+		terms := make([]policy.Criterion[R], 0, len(e.fields))
+		for i, field := range e.fields {
+			c, err := pc.compareException(field, e.comps[i], t[i])
+			if err != nil {
+				pc.errorf(ctx, "exception %s: %v", e.name, err)
+				return policy.False[R](), false
+			}
+			terms = append(terms, c)
+		}
+		preds = append(preds, policy.All(terms))
+	}
+	pc.reportException(ctx, e, tuples)
+	return policy.Any(preds), true
+}
+
+// reportException reports the fields and value tuples of an exception applied to the current rule.
+func (pc *PolicyCompiler[R]) reportException(ctx antlr.ParserRuleContext, e *exception, tuples [][][]string) {
+	values := make([]string, 0, len(tuples))
+	for _, t := range tuples {
+		vs := make([]string, 0, len(t))
+		for _, v := range t {
+			if len(v) == 1 {
+				vs = append(vs, v[0])
+			} else {
+				vs = append(vs, "["+strings.Join(v, ", ")+"]")
+			}
+		}
+		values = append(values, "("+strings.Join(vs, ", ")+")")
+	}
+	pc.infof(ctx, "exception %s applied on fields (%s) with values %s", e.name, strings.Join(e.fields, ", "), strings.Join(values, ", "))
+}
+
+// compareException creates a criterion comparing an attribute with a list of values.
+func (pc *PolicyCompiler[R]) compareException(attr string, comp string, values []string) (policy.Criterion[R], error) {
+	switch comp {
+	case ExceptionEq, ExceptionIn:
+		return pc.ops.FoldAny(attr, values, source.Eq)
+	case ExceptionNeq:
+		c, err := pc.ops.FoldAny(attr, values, source.Eq)
+		return c.Not(), err
+	case ExceptionContains, ExceptionPmatch:
+		return pc.ops.FoldAny(attr, values, source.Contains)
+	case ExceptionIContains:
+		return pc.ops.FoldAny(attr, values, source.IContains)
+	case ExceptionStartswith:
+		return pc.ops.FoldAny(attr, values, source.Startswith)
+	case ExceptionEndswith:
+		return pc.ops.FoldAny(attr, values, source.Endswith)
+	case ExceptionInCIDR:
+		return pc.inCIDR(attr, values)
+	case ExceptionGlob, ExceptionRegex:
+		preds := make([]policy.Criterion[R], 0, len(values))
+		for _, v := range values {
+			var c policy.Criterion[R]
+			var err error
+			if comp == ExceptionGlob {
+				c, err = pc.ops.Glob(attr, v)
+			} else {
+				c, err = pc.ops.RegExp(attr, v)
+			}
+			if err != nil {
+				return policy.False[R](), err
+			}
+			preds = append(preds, c)
+		}
+		return policy.Any(preds), nil
+	}
+	if len(values) != 1 {
+		return policy.False[R](), fmt.Errorf("operator %s expects a single value", comp)
+	}
+	switch comp {
+	case ExceptionLt:
+		return pc.ops.Compare(attr, values[0], source.Lt)
+	case ExceptionLe:
+		return pc.ops.Compare(attr, values[0], source.LEq)
+	case ExceptionGt:
+		return pc.ops.Compare(attr, values[0], source.Gt)
+	case ExceptionGe:
+		return pc.ops.Compare(attr, values[0], source.GEq)
+	}
+	return policy.False[R](), fmt.Errorf("unrecognized operator %s", comp)
+}
+
+// checkNoLookups reports an error if an exception value references a lookup list.
+func (pc *PolicyCompiler[R]) checkNoLookups(ctx antlr.ParserRuleContext, e *exception, value string) bool {
+	if len(pc.reduceLookups(value)) > 0 {
+		pc.errorf(ctx, "exception %s references lookup list %s, lookup lists are not supported in exceptions", e.name, value)
+		return false
+	}
+	return true
+}
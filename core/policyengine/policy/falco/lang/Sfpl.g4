@@ -19,21 +19,35 @@ WARNEVTTYPE: 'warn_evttypes';
 SKIPUNKNOWN: 'skip-if-unknown-filter';
 FAPPEND: 'append';
 REQ: 'required_engine_version';
+SEQUENCE: 'sequence';
+GROUPBY: 'group_by';
+WINDOW: 'window';
+THRESHOLD: 'threshold';
+SUPPRESS: 'suppress';
+EXCEPTIONS: 'exceptions';
+FIELDS: 'fields';
+COMPS: 'comps';
+VALUES: 'values';
+LOOKUP: 'lookup';
 
 policy
-	: (prule | pfilter | pmacro | plist | preq)+ EOF
+	: (prule | parule | pfilter | pmacro | plist | preq)+ EOF
 	;
 
 defs
-	: (srule | sfilter | pmacro | plist | preq)* EOF
+	: (srule | parule | sfilter | pmacro | plist | preq)* EOF
 	;
 
 prule			
-	: DECL RULE DEF text DESC DEF text COND DEF expression (OUTPUT DEF text | ACTIONS DEF actions | PRIORITY DEF severity | TAGS DEF tags | PREFILTER DEF prefilter | ENABLED DEF enabled | WARNEVTTYPE DEF warnevttype | SKIPUNKNOWN DEF skipunknown)*
+	: DECL RULE DEF text DESC DEF text (COND DEF expression | SEQUENCE DEF sequence) (OUTPUT DEF text | ACTIONS DEF actions | PRIORITY DEF severity | TAGS DEF tags | PREFILTER DEF prefilter | ENABLED DEF enabled | WARNEVTTYPE DEF warnevttype | SKIPUNKNOWN DEF skipunknown | GROUPBY DEF groupby | WINDOW DEF window | THRESHOLD DEF threshold | SUPPRESS DEF suppress | EXCEPTIONS DEF exceptions)*
 	;
 
 srule
-	: DECL RULE DEF text DESC DEF text COND DEF expression (OUTPUT DEF text | ACTIONS DEF actions | PRIORITY DEF severity | TAGS DEF tags | PREFILTER DEF prefilter | ENABLED DEF enabled | WARNEVTTYPE DEF warnevttype | SKIPUNKNOWN DEF skipunknown)*
+	: DECL RULE DEF text DESC DEF text (COND DEF expression | SEQUENCE DEF sequence) (OUTPUT DEF text | ACTIONS DEF actions | PRIORITY DEF severity | TAGS DEF tags | PREFILTER DEF prefilter | ENABLED DEF enabled | WARNEVTTYPE DEF warnevttype | SKIPUNKNOWN DEF skipunknown | GROUPBY DEF groupby | WINDOW DEF window | THRESHOLD DEF threshold | SUPPRESS DEF suppress | EXCEPTIONS DEF exceptions)*
+	;
+
+parule
+	: DECL RULE DEF text (COND DEF condop? expression | EXCEPTIONS DEF exceptions | FAPPEND DEF fappend)+
 	;
 
 pfilter
@@ -49,11 +63,11 @@ drop_keyword
 	;
 
 pmacro
-	: DECL MACRO DEF ID COND DEF expression (FAPPEND DEF fappend)?
+	: DECL MACRO DEF ID (COND DEF condop? expression (FAPPEND DEF fappend)? | FAPPEND DEF fappend COND DEF condop? expression)
 	;
 
 plist
-	: DECL LIST DEF ID ITEMS DEF items 
+	: DECL LIST DEF ID (ITEMS DEF items (FAPPEND DEF fappend)? | FAPPEND DEF fappend ITEMS DEF items | LOOKUP DEF lookup)
 	;
 
 preq
@@ -77,7 +91,7 @@ term
 	| NOT term
 	| atom unary_operator 
 	| atom binary_operator atom 
-	| atom (IN|PMATCH) LPAREN (atom|items) (LISTSEP (atom|items))* RPAREN 
+	| atom (IN|PMATCH|INCIDR) LPAREN (atom|items) (LISTSEP (atom|items))* RPAREN 
 	| LPAREN expression RPAREN
 	;
 
@@ -97,6 +111,71 @@ prefilter
 	: items
 	;
 
+sequence
+	: (DECL expression)+
+	;
+
+groupby
+	: items
+	;
+
+window
+	: atom
+	;
+
+threshold
+	: LBRACE thresholdattr (LISTSEP thresholdattr)* RBRACE
+	| thresholdattr+
+	;
+
+suppress
+	: LBRACE thresholdattr (LISTSEP thresholdattr)* RBRACE
+	| thresholdattr+
+	;
+
+lookup
+	: LBRACE thresholdattr (LISTSEP thresholdattr)* RBRACE
+	| thresholdattr+
+	;
+
+thresholdattr
+	: (ID | WINDOW | GROUPBY) DEF (atom | items)
+	;
+
+exceptions
+	: (DECL exception)+
+	;
+
+exception
+	: NAME DEF atom (FIELDS DEF (items | atom) | COMPS DEF (comps | compop) | VALUES DEF exvalues)*
+	;
+
+comps
+	: LBRACK compop (LISTSEP compop)* RBRACK
+	;
+
+compop
+	: binary_operator
+	| IN
+	| PMATCH
+	| INCIDR
+	;
+
+exvalues
+	: LBRACK (extuple (LISTSEP extuple)*)? (LISTSEP)? RBRACK
+	| (DECL extuple)+
+	;
+
+extuple
+	: LBRACK (exvalue (LISTSEP exvalue)*)? (LISTSEP)? RBRACK
+	| atom
+	;
+
+exvalue
+	: atom
+	| items
+	;
+
 severity
 	: SEVERITY
 	;
@@ -117,6 +196,11 @@ fappend
 	: atom
 	;
 
+condop
+	: AND
+	| OR
+	;
+
 variable
 	: ID
 	;		
@@ -132,7 +216,7 @@ atom
 	;
 
 text
-	: ({!(p.GetCurrentToken().GetText() == "desc" ||
+	: ({!((p.GetCurrentToken().GetText() == "desc" ||
 	      p.GetCurrentToken().GetText() == "condition" ||
 	      p.GetCurrentToken().GetText() == "actions" ||
 	      p.GetCurrentToken().GetText() == "output" ||
@@ -142,7 +226,14 @@ text
 		  p.GetCurrentToken().GetText() == "enabled" ||
 		  p.GetCurrentToken().GetText() == "warn_evttypes" ||
 		  p.GetCurrentToken().GetText() == "skip-if-unknown-filter" ||
-		  p.GetCurrentToken().GetText() == "append" )}? .)+
+		  p.GetCurrentToken().GetText() == "sequence" ||
+		  p.GetCurrentToken().GetText() == "group_by" ||
+		  p.GetCurrentToken().GetText() == "window" ||
+		  p.GetCurrentToken().GetText() == "threshold" ||
+		  p.GetCurrentToken().GetText() == "suppress" ||
+		  p.GetCurrentToken().GetText() == "exceptions" ||
+		  p.GetCurrentToken().GetText() == "append") &&
+		  p.GetTokenStream().LT(2).GetTokenType() == SfplParserDEF)}? .)+
 	;
 
 binary_operator 
@@ -156,10 +247,15 @@ binary_operator
 	| ICONTAINS
 	| STARTSWITH
 	| ENDSWITH
+	| GLOB
+	| REGEX
 	;
 
 unary_operator 
 	: EXISTS
+	| ISPRIVATE
+	| ISLOOPBACK
+	| ISLINKLOCAL
 	;
 
 AND 
@@ -217,6 +313,14 @@ STARTSWITH
 ENDSWITH
 	: 'endswith'
 	;
+
+GLOB
+	: 'glob'
+	;
+
+REGEX
+	: 'regex'
+	;
 	
 PMATCH
 	: 'pmatch'
@@ -226,6 +330,22 @@ EXISTS
 	: 'exists'
 	;
 
+INCIDR
+	: 'in_cidr'
+	;
+
+ISPRIVATE
+	: 'is_private'
+	;
+
+ISLOOPBACK
+	: 'is_loopback'
+	;
+
+ISLINKLOCAL
+	: 'is_link_local'
+	;
+
 LBRACK 
 	: '['
 	;
@@ -234,6 +354,14 @@ RBRACK
 	: ']'
 	;
 
+LBRACE
+	: '{'
+	;
+
+RBRACE
+	: '}'
+	;
+
 LPAREN 
 	: '('
 	;
@@ -18,6 +18,21 @@ func (s *SfplSyntaxError) Error() string {
 	return fmt.Sprintf("line: %d  column: %d %s", s.line, s.column, s.msg)
 }
 
+// Line returns the line of the syntax error
+func (s *SfplSyntaxError) Line() int {
+	return s.line
+}
+
+// Column returns the column of the syntax error
+func (s *SfplSyntaxError) Column() int {
+	return s.column
+}
+
+// Msg returns the message of the syntax error
+func (s *SfplSyntaxError) Msg() string {
+	return s.msg
+}
+
 // SfplErrorListener monitors errors during the policy parsing process
 // and stores them in an error list
 type SfplErrorListener struct {
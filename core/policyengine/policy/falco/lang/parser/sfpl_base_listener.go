@@ -44,6 +44,12 @@ func (s *BaseSfplListener) EnterSrule(ctx *SruleContext) {}
 // ExitSrule is called when production srule is exited.
 func (s *BaseSfplListener) ExitSrule(ctx *SruleContext) {}
 
+// EnterParule is called when production parule is entered.
+func (s *BaseSfplListener) EnterParule(ctx *ParuleContext) {}
+
+// ExitParule is called when production parule is exited.
+func (s *BaseSfplListener) ExitParule(ctx *ParuleContext) {}
+
 // EnterPfilter is called when production pfilter is entered.
 func (s *BaseSfplListener) EnterPfilter(ctx *PfilterContext) {}
 
@@ -128,6 +134,90 @@ func (s *BaseSfplListener) EnterPrefilter(ctx *PrefilterContext) {}
 // ExitPrefilter is called when production prefilter is exited.
 func (s *BaseSfplListener) ExitPrefilter(ctx *PrefilterContext) {}
 
+// EnterSequence is called when production sequence is entered.
+func (s *BaseSfplListener) EnterSequence(ctx *SequenceContext) {}
+
+// ExitSequence is called when production sequence is exited.
+func (s *BaseSfplListener) ExitSequence(ctx *SequenceContext) {}
+
+// EnterGroupby is called when production groupby is entered.
+func (s *BaseSfplListener) EnterGroupby(ctx *GroupbyContext) {}
+
+// ExitGroupby is called when production groupby is exited.
+func (s *BaseSfplListener) ExitGroupby(ctx *GroupbyContext) {}
+
+// EnterWindow is called when production window is entered.
+func (s *BaseSfplListener) EnterWindow(ctx *WindowContext) {}
+
+// ExitWindow is called when production window is exited.
+func (s *BaseSfplListener) ExitWindow(ctx *WindowContext) {}
+
+// EnterThreshold is called when production threshold is entered.
+func (s *BaseSfplListener) EnterThreshold(ctx *ThresholdContext) {}
+
+// ExitThreshold is called when production threshold is exited.
+func (s *BaseSfplListener) ExitThreshold(ctx *ThresholdContext) {}
+
+// EnterSuppress is called when production suppress is entered.
+func (s *BaseSfplListener) EnterSuppress(ctx *SuppressContext) {}
+
+// ExitSuppress is called when production suppress is exited.
+func (s *BaseSfplListener) ExitSuppress(ctx *SuppressContext) {}
+
+// EnterLookup is called when production lookup is entered.
+func (s *BaseSfplListener) EnterLookup(ctx *LookupContext) {}
+
+// ExitLookup is called when production lookup is exited.
+func (s *BaseSfplListener) ExitLookup(ctx *LookupContext) {}
+
+// EnterThresholdattr is called when production thresholdattr is entered.
+func (s *BaseSfplListener) EnterThresholdattr(ctx *ThresholdattrContext) {}
+
+// ExitThresholdattr is called when production thresholdattr is exited.
+func (s *BaseSfplListener) ExitThresholdattr(ctx *ThresholdattrContext) {}
+
+// EnterExceptions is called when production exceptions is entered.
+func (s *BaseSfplListener) EnterExceptions(ctx *ExceptionsContext) {}
+
+// ExitExceptions is called when production exceptions is exited.
+func (s *BaseSfplListener) ExitExceptions(ctx *ExceptionsContext) {}
+
+// EnterException is called when production exception is entered.
+func (s *BaseSfplListener) EnterException(ctx *ExceptionContext) {}
+
+// ExitException is called when production exception is exited.
+func (s *BaseSfplListener) ExitException(ctx *ExceptionContext) {}
+
+// EnterComps is called when production comps is entered.
+func (s *BaseSfplListener) EnterComps(ctx *CompsContext) {}
+
+// ExitComps is called when production comps is exited.
+func (s *BaseSfplListener) ExitComps(ctx *CompsContext) {}
+
+// EnterCompop is called when production compop is entered.
+func (s *BaseSfplListener) EnterCompop(ctx *CompopContext) {}
+
+// ExitCompop is called when production compop is exited.
+func (s *BaseSfplListener) ExitCompop(ctx *CompopContext) {}
+
+// EnterExvalues is called when production exvalues is entered.
+func (s *BaseSfplListener) EnterExvalues(ctx *ExvaluesContext) {}
+
+// ExitExvalues is called when production exvalues is exited.
+func (s *BaseSfplListener) ExitExvalues(ctx *ExvaluesContext) {}
+
+// EnterExtuple is called when production extuple is entered.
+func (s *BaseSfplListener) EnterExtuple(ctx *ExtupleContext) {}
+
+// ExitExtuple is called when production extuple is exited.
+func (s *BaseSfplListener) ExitExtuple(ctx *ExtupleContext) {}
+
+// EnterExvalue is called when production exvalue is entered.
+func (s *BaseSfplListener) EnterExvalue(ctx *ExvalueContext) {}
+
+// ExitExvalue is called when production exvalue is exited.
+func (s *BaseSfplListener) ExitExvalue(ctx *ExvalueContext) {}
+
 // EnterSeverity is called when production severity is entered.
 func (s *BaseSfplListener) EnterSeverity(ctx *SeverityContext) {}
 
@@ -158,6 +248,12 @@ func (s *BaseSfplListener) EnterFappend(ctx *FappendContext) {}
 // ExitFappend is called when production fappend is exited.
 func (s *BaseSfplListener) ExitFappend(ctx *FappendContext) {}
 
+// EnterCondop is called when production condop is entered.
+func (s *BaseSfplListener) EnterCondop(ctx *CondopContext) {}
+
+// ExitCondop is called when production condop is exited.
+func (s *BaseSfplListener) ExitCondop(ctx *CondopContext) {}
+
 // EnterVariable is called when production variable is entered.
 func (s *BaseSfplListener) EnterVariable(ctx *VariableContext) {}
 
@@ -23,6 +23,10 @@ func (v *BaseSfplVisitor) VisitSrule(ctx *SruleContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSfplVisitor) VisitParule(ctx *ParuleContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSfplVisitor) VisitPfilter(ctx *PfilterContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -79,6 +83,62 @@ func (v *BaseSfplVisitor) VisitPrefilter(ctx *PrefilterContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSfplVisitor) VisitSequence(ctx *SequenceContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSfplVisitor) VisitGroupby(ctx *GroupbyContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSfplVisitor) VisitWindow(ctx *WindowContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSfplVisitor) VisitThreshold(ctx *ThresholdContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSfplVisitor) VisitSuppress(ctx *SuppressContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSfplVisitor) VisitLookup(ctx *LookupContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSfplVisitor) VisitThresholdattr(ctx *ThresholdattrContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSfplVisitor) VisitExceptions(ctx *ExceptionsContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSfplVisitor) VisitException(ctx *ExceptionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSfplVisitor) VisitComps(ctx *CompsContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSfplVisitor) VisitCompop(ctx *CompopContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSfplVisitor) VisitExvalues(ctx *ExvaluesContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSfplVisitor) VisitExtuple(ctx *ExtupleContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSfplVisitor) VisitExvalue(ctx *ExvalueContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSfplVisitor) VisitSeverity(ctx *SeverityContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -99,6 +159,10 @@ func (v *BaseSfplVisitor) VisitFappend(ctx *FappendContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSfplVisitor) VisitCondop(ctx *CondopContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSfplVisitor) VisitVariable(ctx *VariableContext) interface{} {
 	return v.VisitChildren(ctx)
 }
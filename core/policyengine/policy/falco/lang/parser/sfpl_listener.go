@@ -19,6 +19,9 @@ type SfplListener interface {
 	// EnterSrule is called when entering the srule production.
 	EnterSrule(c *SruleContext)
 
+	// EnterParule is called when entering the parule production.
+	EnterParule(c *ParuleContext)
+
 	// EnterPfilter is called when entering the pfilter production.
 	EnterPfilter(c *PfilterContext)
 
@@ -61,6 +64,48 @@ type SfplListener interface {
 	// EnterPrefilter is called when entering the prefilter production.
 	EnterPrefilter(c *PrefilterContext)
 
+	// EnterSequence is called when entering the sequence production.
+	EnterSequence(c *SequenceContext)
+
+	// EnterGroupby is called when entering the groupby production.
+	EnterGroupby(c *GroupbyContext)
+
+	// EnterWindow is called when entering the window production.
+	EnterWindow(c *WindowContext)
+
+	// EnterThreshold is called when entering the threshold production.
+	EnterThreshold(c *ThresholdContext)
+
+	// EnterSuppress is called when entering the suppress production.
+	EnterSuppress(c *SuppressContext)
+
+	// EnterLookup is called when entering the lookup production.
+	EnterLookup(c *LookupContext)
+
+	// EnterThresholdattr is called when entering the thresholdattr production.
+	EnterThresholdattr(c *ThresholdattrContext)
+
+	// EnterExceptions is called when entering the exceptions production.
+	EnterExceptions(c *ExceptionsContext)
+
+	// EnterException is called when entering the exception production.
+	EnterException(c *ExceptionContext)
+
+	// EnterComps is called when entering the comps production.
+	EnterComps(c *CompsContext)
+
+	// EnterCompop is called when entering the compop production.
+	EnterCompop(c *CompopContext)
+
+	// EnterExvalues is called when entering the exvalues production.
+	EnterExvalues(c *ExvaluesContext)
+
+	// EnterExtuple is called when entering the extuple production.
+	EnterExtuple(c *ExtupleContext)
+
+	// EnterExvalue is called when entering the exvalue production.
+	EnterExvalue(c *ExvalueContext)
+
 	// EnterSeverity is called when entering the severity production.
 	EnterSeverity(c *SeverityContext)
 
@@ -76,6 +121,9 @@ type SfplListener interface {
 	// EnterFappend is called when entering the fappend production.
 	EnterFappend(c *FappendContext)
 
+	// EnterCondop is called when entering the condop production.
+	EnterCondop(c *CondopContext)
+
 	// EnterVariable is called when entering the variable production.
 	EnterVariable(c *VariableContext)
 
@@ -103,6 +151,9 @@ type SfplListener interface {
 	// ExitSrule is called when exiting the srule production.
 	ExitSrule(c *SruleContext)
 
+	// ExitParule is called when exiting the parule production.
+	ExitParule(c *ParuleContext)
+
 	// ExitPfilter is called when exiting the pfilter production.
 	ExitPfilter(c *PfilterContext)
 
@@ -145,6 +196,48 @@ type SfplListener interface {
 	// ExitPrefilter is called when exiting the prefilter production.
 	ExitPrefilter(c *PrefilterContext)
 
+	// ExitSequence is called when exiting the sequence production.
+	ExitSequence(c *SequenceContext)
+
+	// ExitGroupby is called when exiting the groupby production.
+	ExitGroupby(c *GroupbyContext)
+
+	// ExitWindow is called when exiting the window production.
+	ExitWindow(c *WindowContext)
+
+	// ExitThreshold is called when exiting the threshold production.
+	ExitThreshold(c *ThresholdContext)
+
+	// ExitSuppress is called when exiting the suppress production.
+	ExitSuppress(c *SuppressContext)
+
+	// ExitLookup is called when exiting the lookup production.
+	ExitLookup(c *LookupContext)
+
+	// ExitThresholdattr is called when exiting the thresholdattr production.
+	ExitThresholdattr(c *ThresholdattrContext)
+
+	// ExitExceptions is called when exiting the exceptions production.
+	ExitExceptions(c *ExceptionsContext)
+
+	// ExitException is called when exiting the exception production.
+	ExitException(c *ExceptionContext)
+
+	// ExitComps is called when exiting the comps production.
+	ExitComps(c *CompsContext)
+
+	// ExitCompop is called when exiting the compop production.
+	ExitCompop(c *CompopContext)
+
+	// ExitExvalues is called when exiting the exvalues production.
+	ExitExvalues(c *ExvaluesContext)
+
+	// ExitExtuple is called when exiting the extuple production.
+	ExitExtuple(c *ExtupleContext)
+
+	// ExitExvalue is called when exiting the exvalue production.
+	ExitExvalue(c *ExvalueContext)
+
 	// ExitSeverity is called when exiting the severity production.
 	ExitSeverity(c *SeverityContext)
 
@@ -160,6 +253,9 @@ type SfplListener interface {
 	// ExitFappend is called when exiting the fappend production.
 	ExitFappend(c *FappendContext)
 
+	// ExitCondop is called when exiting the condop production.
+	ExitCondop(c *CondopContext)
+
 	// ExitVariable is called when exiting the variable production.
 	ExitVariable(c *VariableContext)
 
@@ -15,180 +15,326 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 56, 338,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 74, 626,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
 	18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23, 9, 23,
 	4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9, 28, 4,
-	29, 9, 29, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 6, 2, 64, 10, 2, 13, 2, 14, 2,
-	65, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 75, 10, 3, 12, 3, 14,
-	3, 78, 11, 3, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3,
+	29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33, 4, 34,
+	9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38, 4, 39, 9,
+	39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 4, 43, 9, 43, 4, 44, 9, 44,
+	4, 45, 9, 45, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 6, 2, 97, 10, 2, 13,
+	2, 14, 2, 98, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 109,
+	10, 3, 12, 3, 14, 3, 112, 11, 3, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3,
+	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 5, 4, 129, 10, 4, 3,
 	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3,
 	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3,
-	4, 3, 4, 3, 4, 7, 4, 116, 10, 4, 12, 4, 14, 4, 119, 11, 4, 3, 5, 3, 5,
+	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3,
+	4, 3, 4, 3, 4, 7, 4, 170, 10, 4, 12, 4, 14, 4, 173, 11, 4, 3, 5, 3, 5,
+	3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 5, 5,
+	188, 10, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5,
 	3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5,
 	3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5,
-	3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 7, 5, 155, 10, 5, 12, 5,
-	14, 5, 158, 11, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6,
-	3, 6, 5, 6, 170, 10, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7,
-	3, 7, 3, 7, 5, 7, 182, 10, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9,
-	3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 5, 9, 196, 10, 9, 3, 10, 3, 10, 3, 10, 3,
-	10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 12,
-	3, 12, 3, 13, 3, 13, 3, 13, 7, 13, 216, 10, 13, 12, 13, 14, 13, 219, 11,
-	13, 3, 14, 3, 14, 3, 14, 7, 14, 224, 10, 14, 12, 14, 14, 14, 227, 11, 14,
-	3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3,
-	15, 3, 15, 3, 15, 3, 15, 3, 15, 5, 15, 244, 10, 15, 3, 15, 3, 15, 3, 15,
-	5, 15, 249, 10, 15, 7, 15, 251, 10, 15, 12, 15, 14, 15, 254, 11, 15, 3,
-	15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 5, 15, 262, 10, 15, 3, 16, 3, 16,
-	3, 16, 3, 16, 7, 16, 268, 10, 16, 12, 16, 14, 16, 271, 11, 16, 5, 16, 273,
-	10, 16, 3, 16, 5, 16, 276, 10, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3,
-	17, 7, 17, 284, 10, 17, 12, 17, 14, 17, 287, 11, 17, 5, 17, 289, 10, 17,
-	3, 17, 5, 17, 292, 10, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3, 18, 7,
-	18, 300, 10, 18, 12, 18, 14, 18, 303, 11, 18, 5, 18, 305, 10, 18, 3, 18,
-	5, 18, 308, 10, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 20, 3, 20, 3, 21, 3,
-	21, 3, 22, 3, 22, 3, 23, 3, 23, 3, 24, 3, 24, 3, 25, 3, 25, 3, 26, 3, 26,
-	3, 27, 3, 27, 6, 27, 330, 10, 27, 13, 27, 14, 27, 331, 3, 28, 3, 28, 3,
-	29, 3, 29, 3, 29, 2, 2, 30, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24,
-	26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 2, 6, 3,
-	2, 4, 5, 4, 2, 31, 31, 36, 36, 5, 2, 25, 25, 27, 27, 48, 52, 4, 2, 25,
-	30, 32, 35, 2, 358, 2, 63, 3, 2, 2, 2, 4, 76, 3, 2, 2, 2, 6, 81, 3, 2,
-	2, 2, 8, 120, 3, 2, 2, 2, 10, 159, 3, 2, 2, 2, 12, 171, 3, 2, 2, 2, 14,
-	183, 3, 2, 2, 2, 16, 185, 3, 2, 2, 2, 18, 197, 3, 2, 2, 2, 20, 205, 3,
-	2, 2, 2, 22, 210, 3, 2, 2, 2, 24, 212, 3, 2, 2, 2, 26, 220, 3, 2, 2, 2,
-	28, 261, 3, 2, 2, 2, 30, 263, 3, 2, 2, 2, 32, 279, 3, 2, 2, 2, 34, 295,
-	3, 2, 2, 2, 36, 311, 3, 2, 2, 2, 38, 313, 3, 2, 2, 2, 40, 315, 3, 2, 2,
-	2, 42, 317, 3, 2, 2, 2, 44, 319, 3, 2, 2, 2, 46, 321, 3, 2, 2, 2, 48, 323,
-	3, 2, 2, 2, 50, 325, 3, 2, 2, 2, 52, 329, 3, 2, 2, 2, 54, 333, 3, 2, 2,
-	2, 56, 335, 3, 2, 2, 2, 58, 64, 5, 6, 4, 2, 59, 64, 5, 10, 6, 2, 60, 64,
-	5, 16, 9, 2, 61, 64, 5, 18, 10, 2, 62, 64, 5, 20, 11, 2, 63, 58, 3, 2,
-	2, 2, 63, 59, 3, 2, 2, 2, 63, 60, 3, 2, 2, 2, 63, 61, 3, 2, 2, 2, 63, 62,
-	3, 2, 2, 2, 64, 65, 3, 2, 2, 2, 65, 63, 3, 2, 2, 2, 65, 66, 3, 2, 2, 2,
-	66, 67, 3, 2, 2, 2, 67, 68, 7, 2, 2, 3, 68, 3, 3, 2, 2, 2, 69, 75, 5, 8,
-	5, 2, 70, 75, 5, 12, 7, 2, 71, 75, 5, 16, 9, 2, 72, 75, 5, 18, 10, 2, 73,
-	75, 5, 20, 11, 2, 74, 69, 3, 2, 2, 2, 74, 70, 3, 2, 2, 2, 74, 71, 3, 2,
-	2, 2, 74, 72, 3, 2, 2, 2, 74, 73, 3, 2, 2, 2, 75, 78, 3, 2, 2, 2, 76, 74,
-	3, 2, 2, 2, 76, 77, 3, 2, 2, 2, 77, 79, 3, 2, 2, 2, 78, 76, 3, 2, 2, 2,
-	79, 80, 7, 2, 2, 3, 80, 5, 3, 2, 2, 2, 81, 82, 7, 43, 2, 2, 82, 83, 7,
-	3, 2, 2, 83, 84, 7, 44, 2, 2, 84, 85, 5, 52, 27, 2, 85, 86, 7, 11, 2, 2,
-	86, 87, 7, 44, 2, 2, 87, 88, 5, 52, 27, 2, 88, 89, 7, 10, 2, 2, 89, 90,
-	7, 44, 2, 2, 90, 117, 5, 22, 12, 2, 91, 92, 7, 13, 2, 2, 92, 93, 7, 44,
-	2, 2, 93, 116, 5, 52, 27, 2, 94, 95, 7, 12, 2, 2, 95, 96, 7, 44, 2, 2,
-	96, 116, 5, 32, 17, 2, 97, 98, 7, 14, 2, 2, 98, 99, 7, 44, 2, 2, 99, 116,
-	5, 38, 20, 2, 100, 101, 7, 15, 2, 2, 101, 102, 7, 44, 2, 2, 102, 116, 5,
-	34, 18, 2, 103, 104, 7, 16, 2, 2, 104, 105, 7, 44, 2, 2, 105, 116, 5, 36,
-	19, 2, 106, 107, 7, 17, 2, 2, 107, 108, 7, 44, 2, 2, 108, 116, 5, 40, 21,
-	2, 109, 110, 7, 18, 2, 2, 110, 111, 7, 44, 2, 2, 111, 116, 5, 42, 22, 2,
-	112, 113, 7, 19, 2, 2, 113, 114, 7, 44, 2, 2, 114, 116, 5, 44, 23, 2, 115,
-	91, 3, 2, 2, 2, 115, 94, 3, 2, 2, 2, 115, 97, 3, 2, 2, 2, 115, 100, 3,
-	2, 2, 2, 115, 103, 3, 2, 2, 2, 115, 106, 3, 2, 2, 2, 115, 109, 3, 2, 2,
-	2, 115, 112, 3, 2, 2, 2, 116, 119, 3, 2, 2, 2, 117, 115, 3, 2, 2, 2, 117,
-	118, 3, 2, 2, 2, 118, 7, 3, 2, 2, 2, 119, 117, 3, 2, 2, 2, 120, 121, 7,
-	43, 2, 2, 121, 122, 7, 3, 2, 2, 122, 123, 7, 44, 2, 2, 123, 124, 5, 52,
-	27, 2, 124, 125, 7, 11, 2, 2, 125, 126, 7, 44, 2, 2, 126, 127, 5, 52, 27,
-	2, 127, 128, 7, 10, 2, 2, 128, 129, 7, 44, 2, 2, 129, 156, 5, 22, 12, 2,
-	130, 131, 7, 13, 2, 2, 131, 132, 7, 44, 2, 2, 132, 155, 5, 52, 27, 2, 133,
-	134, 7, 12, 2, 2, 134, 135, 7, 44, 2, 2, 135, 155, 5, 32, 17, 2, 136, 137,
-	7, 14, 2, 2, 137, 138, 7, 44, 2, 2, 138, 155, 5, 38, 20, 2, 139, 140, 7,
-	15, 2, 2, 140, 141, 7, 44, 2, 2, 141, 155, 5, 34, 18, 2, 142, 143, 7, 16,
-	2, 2, 143, 144, 7, 44, 2, 2, 144, 155, 5, 36, 19, 2, 145, 146, 7, 17, 2,
-	2, 146, 147, 7, 44, 2, 2, 147, 155, 5, 40, 21, 2, 148, 149, 7, 18, 2, 2,
-	149, 150, 7, 44, 2, 2, 150, 155, 5, 42, 22, 2, 151, 152, 7, 19, 2, 2, 152,
-	153, 7, 44, 2, 2, 153, 155, 5, 44, 23, 2, 154, 130, 3, 2, 2, 2, 154, 133,
-	3, 2, 2, 2, 154, 136, 3, 2, 2, 2, 154, 139, 3, 2, 2, 2, 154, 142, 3, 2,
-	2, 2, 154, 145, 3, 2, 2, 2, 154, 148, 3, 2, 2, 2, 154, 151, 3, 2, 2, 2,
-	155, 158, 3, 2, 2, 2, 156, 154, 3, 2, 2, 2, 156, 157, 3, 2, 2, 2, 157,
-	9, 3, 2, 2, 2, 158, 156, 3, 2, 2, 2, 159, 160, 7, 43, 2, 2, 160, 161, 5,
-	14, 8, 2, 161, 162, 7, 44, 2, 2, 162, 163, 7, 48, 2, 2, 163, 164, 7, 10,
-	2, 2, 164, 165, 7, 44, 2, 2, 165, 169, 5, 22, 12, 2, 166, 167, 7, 17, 2,
-	2, 167, 168, 7, 44, 2, 2, 168, 170, 5, 40, 21, 2, 169, 166, 3, 2, 2, 2,
-	169, 170, 3, 2, 2, 2, 170, 11, 3, 2, 2, 2, 171, 172, 7, 43, 2, 2, 172,
-	173, 5, 14, 8, 2, 173, 174, 7, 44, 2, 2, 174, 175, 7, 48, 2, 2, 175, 176,
-	7, 10, 2, 2, 176, 177, 7, 44, 2, 2, 177, 181, 5, 22, 12, 2, 178, 179, 7,
-	17, 2, 2, 179, 180, 7, 44, 2, 2, 180, 182, 5, 40, 21, 2, 181, 178, 3, 2,
-	2, 2, 181, 182, 3, 2, 2, 2, 182, 13, 3, 2, 2, 2, 183, 184, 9, 2, 2, 2,
-	184, 15, 3, 2, 2, 2, 185, 186, 7, 43, 2, 2, 186, 187, 7, 6, 2, 2, 187,
-	188, 7, 44, 2, 2, 188, 189, 7, 48, 2, 2, 189, 190, 7, 10, 2, 2, 190, 191,
-	7, 44, 2, 2, 191, 195, 5, 22, 12, 2, 192, 193, 7, 20, 2, 2, 193, 194, 7,
-	44, 2, 2, 194, 196, 5, 46, 24, 2, 195, 192, 3, 2, 2, 2, 195, 196, 3, 2,
-	2, 2, 196, 17, 3, 2, 2, 2, 197, 198, 7, 43, 2, 2, 198, 199, 7, 7, 2, 2,
-	199, 200, 7, 44, 2, 2, 200, 201, 7, 48, 2, 2, 201, 202, 7, 9, 2, 2, 202,
-	203, 7, 44, 2, 2, 203, 204, 5, 30, 16, 2, 204, 19, 3, 2, 2, 2, 205, 206,
-	7, 43, 2, 2, 206, 207, 7, 21, 2, 2, 207, 208, 7, 44, 2, 2, 208, 209, 5,
-	50, 26, 2, 209, 21, 3, 2, 2, 2, 210, 211, 5, 24, 13, 2, 211, 23, 3, 2,
-	2, 2, 212, 217, 5, 26, 14, 2, 213, 214, 7, 23, 2, 2, 214, 216, 5, 26, 14,
-	2, 215, 213, 3, 2, 2, 2, 216, 219, 3, 2, 2, 2, 217, 215, 3, 2, 2, 2, 217,
-	218, 3, 2, 2, 2, 218, 25, 3, 2, 2, 2, 219, 217, 3, 2, 2, 2, 220, 225, 5,
-	28, 15, 2, 221, 222, 7, 22, 2, 2, 222, 224, 5, 28, 15, 2, 223, 221, 3,
-	2, 2, 2, 224, 227, 3, 2, 2, 2, 225, 223, 3, 2, 2, 2, 225, 226, 3, 2, 2,
-	2, 226, 27, 3, 2, 2, 2, 227, 225, 3, 2, 2, 2, 228, 262, 5, 48, 25, 2, 229,
-	230, 7, 24, 2, 2, 230, 262, 5, 28, 15, 2, 231, 232, 5, 50, 26, 2, 232,
-	233, 5, 56, 29, 2, 233, 262, 3, 2, 2, 2, 234, 235, 5, 50, 26, 2, 235, 236,
-	5, 54, 28, 2, 236, 237, 5, 50, 26, 2, 237, 262, 3, 2, 2, 2, 238, 239, 5,
-	50, 26, 2, 239, 240, 9, 3, 2, 2, 240, 243, 7, 40, 2, 2, 241, 244, 5, 50,
-	26, 2, 242, 244, 5, 30, 16, 2, 243, 241, 3, 2, 2, 2, 243, 242, 3, 2, 2,
-	2, 244, 252, 3, 2, 2, 2, 245, 248, 7, 42, 2, 2, 246, 249, 5, 50, 26, 2,
-	247, 249, 5, 30, 16, 2, 248, 246, 3, 2, 2, 2, 248, 247, 3, 2, 2, 2, 249,
-	251, 3, 2, 2, 2, 250, 245, 3, 2, 2, 2, 251, 254, 3, 2, 2, 2, 252, 250,
-	3, 2, 2, 2, 252, 253, 3, 2, 2, 2, 253, 255, 3, 2, 2, 2, 254, 252, 3, 2,
-	2, 2, 255, 256, 7, 41, 2, 2, 256, 262, 3, 2, 2, 2, 257, 258, 7, 40, 2,
-	2, 258, 259, 5, 22, 12, 2, 259, 260, 7, 41, 2, 2, 260, 262, 3, 2, 2, 2,
-	261, 228, 3, 2, 2, 2, 261, 229, 3, 2, 2, 2, 261, 231, 3, 2, 2, 2, 261,
-	234, 3, 2, 2, 2, 261, 238, 3, 2, 2, 2, 261, 257, 3, 2, 2, 2, 262, 29, 3,
-	2, 2, 2, 263, 272, 7, 38, 2, 2, 264, 269, 5, 50, 26, 2, 265, 266, 7, 42,
-	2, 2, 266, 268, 5, 50, 26, 2, 267, 265, 3, 2, 2, 2, 268, 271, 3, 2, 2,
-	2, 269, 267, 3, 2, 2, 2, 269, 270, 3, 2, 2, 2, 270, 273, 3, 2, 2, 2, 271,
-	269, 3, 2, 2, 2, 272, 264, 3, 2, 2, 2, 272, 273, 3, 2, 2, 2, 273, 275,
-	3, 2, 2, 2, 274, 276, 7, 42, 2, 2, 275, 274, 3, 2, 2, 2, 275, 276, 3, 2,
-	2, 2, 276, 277, 3, 2, 2, 2, 277, 278, 7, 39, 2, 2, 278, 31, 3, 2, 2, 2,
-	279, 288, 7, 38, 2, 2, 280, 285, 5, 50, 26, 2, 281, 282, 7, 42, 2, 2, 282,
-	284, 5, 50, 26, 2, 283, 281, 3, 2, 2, 2, 284, 287, 3, 2, 2, 2, 285, 283,
-	3, 2, 2, 2, 285, 286, 3, 2, 2, 2, 286, 289, 3, 2, 2, 2, 287, 285, 3, 2,
-	2, 2, 288, 280, 3, 2, 2, 2, 288, 289, 3, 2, 2, 2, 289, 291, 3, 2, 2, 2,
-	290, 292, 7, 42, 2, 2, 291, 290, 3, 2, 2, 2, 291, 292, 3, 2, 2, 2, 292,
-	293, 3, 2, 2, 2, 293, 294, 7, 39, 2, 2, 294, 33, 3, 2, 2, 2, 295, 304,
-	7, 38, 2, 2, 296, 301, 5, 50, 26, 2, 297, 298, 7, 42, 2, 2, 298, 300, 5,
-	50, 26, 2, 299, 297, 3, 2, 2, 2, 300, 303, 3, 2, 2, 2, 301, 299, 3, 2,
-	2, 2, 301, 302, 3, 2, 2, 2, 302, 305, 3, 2, 2, 2, 303, 301, 3, 2, 2, 2,
-	304, 296, 3, 2, 2, 2, 304, 305, 3, 2, 2, 2, 305, 307, 3, 2, 2, 2, 306,
-	308, 7, 42, 2, 2, 307, 306, 3, 2, 2, 2, 307, 308, 3, 2, 2, 2, 308, 309,
-	3, 2, 2, 2, 309, 310, 7, 39, 2, 2, 310, 35, 3, 2, 2, 2, 311, 312, 5, 30,
-	16, 2, 312, 37, 3, 2, 2, 2, 313, 314, 7, 45, 2, 2, 314, 39, 3, 2, 2, 2,
-	315, 316, 5, 50, 26, 2, 316, 41, 3, 2, 2, 2, 317, 318, 5, 50, 26, 2, 318,
-	43, 3, 2, 2, 2, 319, 320, 5, 50, 26, 2, 320, 45, 3, 2, 2, 2, 321, 322,
-	5, 50, 26, 2, 322, 47, 3, 2, 2, 2, 323, 324, 7, 48, 2, 2, 324, 49, 3, 2,
-	2, 2, 325, 326, 9, 4, 2, 2, 326, 51, 3, 2, 2, 2, 327, 328, 6, 27, 2, 2,
-	328, 330, 11, 2, 2, 2, 329, 327, 3, 2, 2, 2, 330, 331, 3, 2, 2, 2, 331,
-	329, 3, 2, 2, 2, 331, 332, 3, 2, 2, 2, 332, 53, 3, 2, 2, 2, 333, 334, 9,
-	5, 2, 2, 334, 55, 3, 2, 2, 2, 335, 336, 7, 37, 2, 2, 336, 57, 3, 2, 2,
-	2, 29, 63, 65, 74, 76, 115, 117, 154, 156, 169, 181, 195, 217, 225, 243,
-	248, 252, 261, 269, 272, 275, 285, 288, 291, 301, 304, 307, 331,
+	3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 7, 5, 229, 10, 5, 12, 5, 14, 5, 232, 11,
+	5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 5, 6, 241, 10, 6, 3, 6, 3,
+	6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 6, 6, 250, 10, 6, 13, 6, 14, 6, 251, 3,
+	7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 5, 7, 264, 10,
+	7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 5, 8, 276,
+	10, 8, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5,
+	10, 287, 10, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 293, 10, 10, 3, 10,
+	3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 301, 10, 10, 3, 10, 3, 10, 5,
+	10, 305, 10, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11,
+	3, 11, 3, 11, 5, 11, 317, 10, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3,
+	11, 3, 11, 3, 11, 3, 11, 3, 11, 5, 11, 329, 10, 11, 3, 12, 3, 12, 3, 12,
+	3, 12, 3, 12, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 7, 14, 341, 10, 14, 12,
+	14, 14, 14, 344, 11, 14, 3, 15, 3, 15, 3, 15, 7, 15, 349, 10, 15, 12, 15,
+	14, 15, 352, 11, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3,
+	16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 5, 16, 369, 10, 16,
+	3, 16, 3, 16, 3, 16, 5, 16, 374, 10, 16, 7, 16, 376, 10, 16, 12, 16, 14,
+	16, 379, 11, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 5, 16, 387,
+	10, 16, 3, 17, 3, 17, 3, 17, 3, 17, 7, 17, 393, 10, 17, 12, 17, 14, 17,
+	396, 11, 17, 5, 17, 398, 10, 17, 3, 17, 5, 17, 401, 10, 17, 3, 17, 3, 17,
+	3, 18, 3, 18, 3, 18, 3, 18, 7, 18, 409, 10, 18, 12, 18, 14, 18, 412, 11,
+	18, 5, 18, 414, 10, 18, 3, 18, 5, 18, 417, 10, 18, 3, 18, 3, 18, 3, 19,
+	3, 19, 3, 19, 3, 19, 7, 19, 425, 10, 19, 12, 19, 14, 19, 428, 11, 19, 5,
+	19, 430, 10, 19, 3, 19, 5, 19, 433, 10, 19, 3, 19, 3, 19, 3, 20, 3, 20,
+	3, 21, 3, 21, 6, 21, 441, 10, 21, 13, 21, 14, 21, 442, 3, 22, 3, 22, 3,
+	23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 24, 7, 24, 453, 10, 24, 12, 24, 14,
+	24, 456, 11, 24, 3, 24, 3, 24, 3, 24, 6, 24, 461, 10, 24, 13, 24, 14, 24,
+	462, 5, 24, 465, 10, 24, 3, 25, 3, 25, 3, 25, 3, 25, 7, 25, 471, 10, 25,
+	12, 25, 14, 25, 474, 11, 25, 3, 25, 3, 25, 3, 25, 6, 25, 479, 10, 25, 13,
+	25, 14, 25, 480, 5, 25, 483, 10, 25, 3, 26, 3, 26, 3, 26, 3, 26, 7, 26,
+	489, 10, 26, 12, 26, 14, 26, 492, 11, 26, 3, 26, 3, 26, 3, 26, 6, 26, 497,
+	10, 26, 13, 26, 14, 26, 498, 5, 26, 501, 10, 26, 3, 27, 3, 27, 3, 27, 3,
+	27, 5, 27, 507, 10, 27, 3, 28, 3, 28, 6, 28, 511, 10, 28, 13, 28, 14, 28,
+	512, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 5, 29, 522, 10, 29,
+	3, 29, 3, 29, 3, 29, 3, 29, 5, 29, 528, 10, 29, 3, 29, 3, 29, 3, 29, 7,
+	29, 533, 10, 29, 12, 29, 14, 29, 536, 11, 29, 3, 30, 3, 30, 3, 30, 3, 30,
+	7, 30, 542, 10, 30, 12, 30, 14, 30, 545, 11, 30, 3, 30, 3, 30, 3, 31, 3,
+	31, 3, 31, 3, 31, 5, 31, 553, 10, 31, 3, 32, 3, 32, 3, 32, 3, 32, 7, 32,
+	559, 10, 32, 12, 32, 14, 32, 562, 11, 32, 5, 32, 564, 10, 32, 3, 32, 5,
+	32, 567, 10, 32, 3, 32, 3, 32, 3, 32, 6, 32, 572, 10, 32, 13, 32, 14, 32,
+	573, 5, 32, 576, 10, 32, 3, 33, 3, 33, 3, 33, 3, 33, 7, 33, 582, 10, 33,
+	12, 33, 14, 33, 585, 11, 33, 5, 33, 587, 10, 33, 3, 33, 5, 33, 590, 10,
+	33, 3, 33, 3, 33, 5, 33, 594, 10, 33, 3, 34, 3, 34, 5, 34, 598, 10, 34,
+	3, 35, 3, 35, 3, 36, 3, 36, 3, 37, 3, 37, 3, 38, 3, 38, 3, 39, 3, 39, 3,
+	40, 3, 40, 3, 41, 3, 41, 3, 42, 3, 42, 3, 43, 3, 43, 6, 43, 618, 10, 43,
+	13, 43, 14, 43, 619, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45, 2, 2, 46, 2, 4,
+	6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42,
+	44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78,
+	80, 82, 84, 86, 88, 2, 9, 3, 2, 4, 5, 5, 2, 41, 41, 48, 48, 50, 50, 4,
+	2, 23, 24, 66, 66, 3, 2, 32, 33, 5, 2, 35, 35, 37, 37, 66, 70, 4, 2, 35,
+	40, 42, 47, 4, 2, 49, 49, 51, 53, 2, 685, 2, 96, 3, 2, 2, 2, 4, 110, 3,
+	2, 2, 2, 6, 115, 3, 2, 2, 2, 8, 174, 3, 2, 2, 2, 10, 233, 3, 2, 2, 2, 12,
+	253, 3, 2, 2, 2, 14, 265, 3, 2, 2, 2, 16, 277, 3, 2, 2, 2, 18, 279, 3,
+	2, 2, 2, 20, 306, 3, 2, 2, 2, 22, 330, 3, 2, 2, 2, 24, 335, 3, 2, 2, 2,
+	26, 337, 3, 2, 2, 2, 28, 345, 3, 2, 2, 2, 30, 386, 3, 2, 2, 2, 32, 388,
+	3, 2, 2, 2, 34, 404, 3, 2, 2, 2, 36, 420, 3, 2, 2, 2, 38, 436, 3, 2, 2,
+	2, 40, 440, 3, 2, 2, 2, 42, 444, 3, 2, 2, 2, 44, 446, 3, 2, 2, 2, 46, 464,
+	3, 2, 2, 2, 48, 482, 3, 2, 2, 2, 50, 500, 3, 2, 2, 2, 52, 502, 3, 2, 2,
+	2, 54, 510, 3, 2, 2, 2, 56, 514, 3, 2, 2, 2, 58, 537, 3, 2, 2, 2, 60, 552,
+	3, 2, 2, 2, 62, 575, 3, 2, 2, 2, 64, 593, 3, 2, 2, 2, 66, 597, 3, 2, 2,
+	2, 68, 599, 3, 2, 2, 2, 70, 601, 3, 2, 2, 2, 72, 603, 3, 2, 2, 2, 74, 605,
+	3, 2, 2, 2, 76, 607, 3, 2, 2, 2, 78, 609, 3, 2, 2, 2, 80, 611, 3, 2, 2,
+	2, 82, 613, 3, 2, 2, 2, 84, 617, 3, 2, 2, 2, 86, 621, 3, 2, 2, 2, 88, 623,
+	3, 2, 2, 2, 90, 97, 5, 6, 4, 2, 91, 97, 5, 10, 6, 2, 92, 97, 5, 12, 7,
+	2, 93, 97, 5, 18, 10, 2, 94, 97, 5, 20, 11, 2, 95, 97, 5, 22, 12, 2, 96,
+	90, 3, 2, 2, 2, 96, 91, 3, 2, 2, 2, 96, 92, 3, 2, 2, 2, 96, 93, 3, 2, 2,
+	2, 96, 94, 3, 2, 2, 2, 96, 95, 3, 2, 2, 2, 97, 98, 3, 2, 2, 2, 98, 96,
+	3, 2, 2, 2, 98, 99, 3, 2, 2, 2, 99, 100, 3, 2, 2, 2, 100, 101, 7, 2, 2,
+	3, 101, 3, 3, 2, 2, 2, 102, 109, 5, 8, 5, 2, 103, 109, 5, 10, 6, 2, 104,
+	109, 5, 14, 8, 2, 105, 109, 5, 18, 10, 2, 106, 109, 5, 20, 11, 2, 107,
+	109, 5, 22, 12, 2, 108, 102, 3, 2, 2, 2, 108, 103, 3, 2, 2, 2, 108, 104,
+	3, 2, 2, 2, 108, 105, 3, 2, 2, 2, 108, 106, 3, 2, 2, 2, 108, 107, 3, 2,
+	2, 2, 109, 112, 3, 2, 2, 2, 110, 108, 3, 2, 2, 2, 110, 111, 3, 2, 2, 2,
+	111, 113, 3, 2, 2, 2, 112, 110, 3, 2, 2, 2, 113, 114, 7, 2, 2, 3, 114,
+	5, 3, 2, 2, 2, 115, 116, 7, 61, 2, 2, 116, 117, 7, 3, 2, 2, 117, 118, 7,
+	62, 2, 2, 118, 119, 5, 84, 43, 2, 119, 120, 7, 11, 2, 2, 120, 121, 7, 62,
+	2, 2, 121, 128, 5, 84, 43, 2, 122, 123, 7, 10, 2, 2, 123, 124, 7, 62, 2,
+	2, 124, 129, 5, 24, 13, 2, 125, 126, 7, 22, 2, 2, 126, 127, 7, 62, 2, 2,
+	127, 129, 5, 40, 21, 2, 128, 122, 3, 2, 2, 2, 128, 125, 3, 2, 2, 2, 129,
+	171, 3, 2, 2, 2, 130, 131, 7, 13, 2, 2, 131, 132, 7, 62, 2, 2, 132, 170,
+	5, 84, 43, 2, 133, 134, 7, 12, 2, 2, 134, 135, 7, 62, 2, 2, 135, 170, 5,
+	34, 18, 2, 136, 137, 7, 14, 2, 2, 137, 138, 7, 62, 2, 2, 138, 170, 5, 68,
+	35, 2, 139, 140, 7, 15, 2, 2, 140, 141, 7, 62, 2, 2, 141, 170, 5, 36, 19,
+	2, 142, 143, 7, 16, 2, 2, 143, 144, 7, 62, 2, 2, 144, 170, 5, 38, 20, 2,
+	145, 146, 7, 17, 2, 2, 146, 147, 7, 62, 2, 2, 147, 170, 5, 70, 36, 2, 148,
+	149, 7, 18, 2, 2, 149, 150, 7, 62, 2, 2, 150, 170, 5, 72, 37, 2, 151, 152,
+	7, 19, 2, 2, 152, 153, 7, 62, 2, 2, 153, 170, 5, 74, 38, 2, 154, 155, 7,
+	23, 2, 2, 155, 156, 7, 62, 2, 2, 156, 170, 5, 42, 22, 2, 157, 158, 7, 24,
+	2, 2, 158, 159, 7, 62, 2, 2, 159, 170, 5, 44, 23, 2, 160, 161, 7, 25, 2,
+	2, 161, 162, 7, 62, 2, 2, 162, 170, 5, 46, 24, 2, 163, 164, 7, 26, 2, 2,
+	164, 165, 7, 62, 2, 2, 165, 170, 5, 48, 25, 2, 166, 167, 7, 27, 2, 2, 167,
+	168, 7, 62, 2, 2, 168, 170, 5, 54, 28, 2, 169, 130, 3, 2, 2, 2, 169, 133,
+	3, 2, 2, 2, 169, 136, 3, 2, 2, 2, 169, 139, 3, 2, 2, 2, 169, 142, 3, 2,
+	2, 2, 169, 145, 3, 2, 2, 2, 169, 148, 3, 2, 2, 2, 169, 151, 3, 2, 2, 2,
+	169, 154, 3, 2, 2, 2, 169, 157, 3, 2, 2, 2, 169, 160, 3, 2, 2, 2, 169,
+	163, 3, 2, 2, 2, 169, 166, 3, 2, 2, 2, 170, 173, 3, 2, 2, 2, 171, 169,
+	3, 2, 2, 2, 171, 172, 3, 2, 2, 2, 172, 7, 3, 2, 2, 2, 173, 171, 3, 2, 2,
+	2, 174, 175, 7, 61, 2, 2, 175, 176, 7, 3, 2, 2, 176, 177, 7, 62, 2, 2,
+	177, 178, 5, 84, 43, 2, 178, 179, 7, 11, 2, 2, 179, 180, 7, 62, 2, 2, 180,
+	187, 5, 84, 43, 2, 181, 182, 7, 10, 2, 2, 182, 183, 7, 62, 2, 2, 183, 188,
+	5, 24, 13, 2, 184, 185, 7, 22, 2, 2, 185, 186, 7, 62, 2, 2, 186, 188, 5,
+	40, 21, 2, 187, 181, 3, 2, 2, 2, 187, 184, 3, 2, 2, 2, 188, 230, 3, 2,
+	2, 2, 189, 190, 7, 13, 2, 2, 190, 191, 7, 62, 2, 2, 191, 229, 5, 84, 43,
+	2, 192, 193, 7, 12, 2, 2, 193, 194, 7, 62, 2, 2, 194, 229, 5, 34, 18, 2,
+	195, 196, 7, 14, 2, 2, 196, 197, 7, 62, 2, 2, 197, 229, 5, 68, 35, 2, 198,
+	199, 7, 15, 2, 2, 199, 200, 7, 62, 2, 2, 200, 229, 5, 36, 19, 2, 201, 202,
+	7, 16, 2, 2, 202, 203, 7, 62, 2, 2, 203, 229, 5, 38, 20, 2, 204, 205, 7,
+	17, 2, 2, 205, 206, 7, 62, 2, 2, 206, 229, 5, 70, 36, 2, 207, 208, 7, 18,
+	2, 2, 208, 209, 7, 62, 2, 2, 209, 229, 5, 72, 37, 2, 210, 211, 7, 19, 2,
+	2, 211, 212, 7, 62, 2, 2, 212, 229, 5, 74, 38, 2, 213, 214, 7, 23, 2, 2,
+	214, 215, 7, 62, 2, 2, 215, 229, 5, 42, 22, 2, 216, 217, 7, 24, 2, 2, 217,
+	218, 7, 62, 2, 2, 218, 229, 5, 44, 23, 2, 219, 220, 7, 25, 2, 2, 220, 221,
+	7, 62, 2, 2, 221, 229, 5, 46, 24, 2, 222, 223, 7, 26, 2, 2, 223, 224, 7,
+	62, 2, 2, 224, 229, 5, 48, 25, 2, 225, 226, 7, 27, 2, 2, 226, 227, 7, 62,
+	2, 2, 227, 229, 5, 54, 28, 2, 228, 189, 3, 2, 2, 2, 228, 192, 3, 2, 2,
+	2, 228, 195, 3, 2, 2, 2, 228, 198, 3, 2, 2, 2, 228, 201, 3, 2, 2, 2, 228,
+	204, 3, 2, 2, 2, 228, 207, 3, 2, 2, 2, 228, 210, 3, 2, 2, 2, 228, 213,
+	3, 2, 2, 2, 228, 216, 3, 2, 2, 2, 228, 219, 3, 2, 2, 2, 228, 222, 3, 2,
+	2, 2, 228, 225, 3, 2, 2, 2, 229, 232, 3, 2, 2, 2, 230, 228, 3, 2, 2, 2,
+	230, 231, 3, 2, 2, 2, 231, 9, 3, 2, 2, 2, 232, 230, 3, 2, 2, 2, 233, 234,
+	7, 61, 2, 2, 234, 235, 7, 3, 2, 2, 235, 236, 7, 62, 2, 2, 236, 249, 5,
+	84, 43, 2, 237, 238, 7, 10, 2, 2, 238, 240, 7, 62, 2, 2, 239, 241, 5, 78,
+	40, 2, 240, 239, 3, 2, 2, 2, 240, 241, 3, 2, 2, 2, 241, 242, 3, 2, 2, 2,
+	242, 250, 5, 24, 13, 2, 243, 244, 7, 27, 2, 2, 244, 245, 7, 62, 2, 2, 245,
+	250, 5, 54, 28, 2, 246, 247, 7, 20, 2, 2, 247, 248, 7, 62, 2, 2, 248, 250,
+	5, 76, 39, 2, 249, 237, 3, 2, 2, 2, 249, 243, 3, 2, 2, 2, 249, 246, 3,
+	2, 2, 2, 250, 251, 3, 2, 2, 2, 251, 249, 3, 2, 2, 2, 251, 252, 3, 2, 2,
+	2, 252, 11, 3, 2, 2, 2, 253, 254, 7, 61, 2, 2, 254, 255, 5, 16, 9, 2, 255,
+	256, 7, 62, 2, 2, 256, 257, 7, 66, 2, 2, 257, 258, 7, 10, 2, 2, 258, 259,
+	7, 62, 2, 2, 259, 263, 5, 24, 13, 2, 260, 261, 7, 17, 2, 2, 261, 262, 7,
+	62, 2, 2, 262, 264, 5, 70, 36, 2, 263, 260, 3, 2, 2, 2, 263, 264, 3, 2,
+	2, 2, 264, 13, 3, 2, 2, 2, 265, 266, 7, 61, 2, 2, 266, 267, 5, 16, 9, 2,
+	267, 268, 7, 62, 2, 2, 268, 269, 7, 66, 2, 2, 269, 270, 7, 10, 2, 2, 270,
+	271, 7, 62, 2, 2, 271, 275, 5, 24, 13, 2, 272, 273, 7, 17, 2, 2, 273, 274,
+	7, 62, 2, 2, 274, 276, 5, 70, 36, 2, 275, 272, 3, 2, 2, 2, 275, 276, 3,
+	2, 2, 2, 276, 15, 3, 2, 2, 2, 277, 278, 9, 2, 2, 2, 278, 17, 3, 2, 2, 2,
+	279, 280, 7, 61, 2, 2, 280, 281, 7, 6, 2, 2, 281, 282, 7, 62, 2, 2, 282,
+	304, 7, 66, 2, 2, 283, 284, 7, 10, 2, 2, 284, 286, 7, 62, 2, 2, 285, 287,
+	5, 78, 40, 2, 286, 285, 3, 2, 2, 2, 286, 287, 3, 2, 2, 2, 287, 288, 3,
+	2, 2, 2, 288, 292, 5, 24, 13, 2, 289, 290, 7, 20, 2, 2, 290, 291, 7, 62,
+	2, 2, 291, 293, 5, 76, 39, 2, 292, 289, 3, 2, 2, 2, 292, 293, 3, 2, 2,
+	2, 293, 305, 3, 2, 2, 2, 294, 295, 7, 20, 2, 2, 295, 296, 7, 62, 2, 2,
+	296, 297, 5, 76, 39, 2, 297, 298, 7, 10, 2, 2, 298, 300, 7, 62, 2, 2, 299,
+	301, 5, 78, 40, 2, 300, 299, 3, 2, 2, 2, 300, 301, 3, 2, 2, 2, 301, 302,
+	3, 2, 2, 2, 302, 303, 5, 24, 13, 2, 303, 305, 3, 2, 2, 2, 304, 283, 3,
+	2, 2, 2, 304, 294, 3, 2, 2, 2, 305, 19, 3, 2, 2, 2, 306, 307, 7, 61, 2,
+	2, 307, 308, 7, 7, 2, 2, 308, 309, 7, 62, 2, 2, 309, 328, 7, 66, 2, 2,
+	310, 311, 7, 9, 2, 2, 311, 312, 7, 62, 2, 2, 312, 316, 5, 32, 17, 2, 313,
+	314, 7, 20, 2, 2, 314, 315, 7, 62, 2, 2, 315, 317, 5, 76, 39, 2, 316, 313,
+	3, 2, 2, 2, 316, 317, 3, 2, 2, 2, 317, 329, 3, 2, 2, 2, 318, 319, 7, 20,
+	2, 2, 319, 320, 7, 62, 2, 2, 320, 321, 5, 76, 39, 2, 321, 322, 7, 9, 2,
+	2, 322, 323, 7, 62, 2, 2, 323, 324, 5, 32, 17, 2, 324, 329, 3, 2, 2, 2,
+	325, 326, 7, 31, 2, 2, 326, 327, 7, 62, 2, 2, 327, 329, 5, 50, 26, 2, 328,
+	310, 3, 2, 2, 2, 328, 318, 3, 2, 2, 2, 328, 325, 3, 2, 2, 2, 329, 21, 3,
+	2, 2, 2, 330, 331, 7, 61, 2, 2, 331, 332, 7, 21, 2, 2, 332, 333, 7, 62,
+	2, 2, 333, 334, 5, 82, 42, 2, 334, 23, 3, 2, 2, 2, 335, 336, 5, 26, 14,
+	2, 336, 25, 3, 2, 2, 2, 337, 342, 5, 28, 15, 2, 338, 339, 7, 33, 2, 2,
+	339, 341, 5, 28, 15, 2, 340, 338, 3, 2, 2, 2, 341, 344, 3, 2, 2, 2, 342,
+	340, 3, 2, 2, 2, 342, 343, 3, 2, 2, 2, 343, 27, 3, 2, 2, 2, 344, 342, 3,
+	2, 2, 2, 345, 350, 5, 30, 16, 2, 346, 347, 7, 32, 2, 2, 347, 349, 5, 30,
+	16, 2, 348, 346, 3, 2, 2, 2, 349, 352, 3, 2, 2, 2, 350, 348, 3, 2, 2, 2,
+	350, 351, 3, 2, 2, 2, 351, 29, 3, 2, 2, 2, 352, 350, 3, 2, 2, 2, 353, 387,
+	5, 80, 41, 2, 354, 355, 7, 34, 2, 2, 355, 387, 5, 30, 16, 2, 356, 357,
+	5, 82, 42, 2, 357, 358, 5, 88, 45, 2, 358, 387, 3, 2, 2, 2, 359, 360, 5,
+	82, 42, 2, 360, 361, 5, 86, 44, 2, 361, 362, 5, 82, 42, 2, 362, 387, 3,
+	2, 2, 2, 363, 364, 5, 82, 42, 2, 364, 365, 9, 3, 2, 2, 365, 368, 7, 58,
+	2, 2, 366, 369, 5, 82, 42, 2, 367, 369, 5, 32, 17, 2, 368, 366, 3, 2, 2,
+	2, 368, 367, 3, 2, 2, 2, 369, 377, 3, 2, 2, 2, 370, 373, 7, 60, 2, 2, 371,
+	374, 5, 82, 42, 2, 372, 374, 5, 32, 17, 2, 373, 371, 3, 2, 2, 2, 373, 372,
+	3, 2, 2, 2, 374, 376, 3, 2, 2, 2, 375, 370, 3, 2, 2, 2, 376, 379, 3, 2,
+	2, 2, 377, 375, 3, 2, 2, 2, 377, 378, 3, 2, 2, 2, 378, 380, 3, 2, 2, 2,
+	379, 377, 3, 2, 2, 2, 380, 381, 7, 59, 2, 2, 381, 387, 3, 2, 2, 2, 382,
+	383, 7, 58, 2, 2, 383, 384, 5, 24, 13, 2, 384, 385, 7, 59, 2, 2, 385, 387,
+	3, 2, 2, 2, 386, 353, 3, 2, 2, 2, 386, 354, 3, 2, 2, 2, 386, 356, 3, 2,
+	2, 2, 386, 359, 3, 2, 2, 2, 386, 363, 3, 2, 2, 2, 386, 382, 3, 2, 2, 2,
+	387, 31, 3, 2, 2, 2, 388, 397, 7, 54, 2, 2, 389, 394, 5, 82, 42, 2, 390,
+	391, 7, 60, 2, 2, 391, 393, 5, 82, 42, 2, 392, 390, 3, 2, 2, 2, 393, 396,
+	3, 2, 2, 2, 394, 392, 3, 2, 2, 2, 394, 395, 3, 2, 2, 2, 395, 398, 3, 2,
+	2, 2, 396, 394, 3, 2, 2, 2, 397, 389, 3, 2, 2, 2, 397, 398, 3, 2, 2, 2,
+	398, 400, 3, 2, 2, 2, 399, 401, 7, 60, 2, 2, 400, 399, 3, 2, 2, 2, 400,
+	401, 3, 2, 2, 2, 401, 402, 3, 2, 2, 2, 402, 403, 7, 55, 2, 2, 403, 33,
+	3, 2, 2, 2, 404, 413, 7, 54, 2, 2, 405, 410, 5, 82, 42, 2, 406, 407, 7,
+	60, 2, 2, 407, 409, 5, 82, 42, 2, 408, 406, 3, 2, 2, 2, 409, 412, 3, 2,
+	2, 2, 410, 408, 3, 2, 2, 2, 410, 411, 3, 2, 2, 2, 411, 414, 3, 2, 2, 2,
+	412, 410, 3, 2, 2, 2, 413, 405, 3, 2, 2, 2, 413, 414, 3, 2, 2, 2, 414,
+	416, 3, 2, 2, 2, 415, 417, 7, 60, 2, 2, 416, 415, 3, 2, 2, 2, 416, 417,
+	3, 2, 2, 2, 417, 418, 3, 2, 2, 2, 418, 419, 7, 55, 2, 2, 419, 35, 3, 2,
+	2, 2, 420, 429, 7, 54, 2, 2, 421, 426, 5, 82, 42, 2, 422, 423, 7, 60, 2,
+	2, 423, 425, 5, 82, 42, 2, 424, 422, 3, 2, 2, 2, 425, 428, 3, 2, 2, 2,
+	426, 424, 3, 2, 2, 2, 426, 427, 3, 2, 2, 2, 427, 430, 3, 2, 2, 2, 428,
+	426, 3, 2, 2, 2, 429, 421, 3, 2, 2, 2, 429, 430, 3, 2, 2, 2, 430, 432,
+	3, 2, 2, 2, 431, 433, 7, 60, 2, 2, 432, 431, 3, 2, 2, 2, 432, 433, 3, 2,
+	2, 2, 433, 434, 3, 2, 2, 2, 434, 435, 7, 55, 2, 2, 435, 37, 3, 2, 2, 2,
+	436, 437, 5, 32, 17, 2, 437, 39, 3, 2, 2, 2, 438, 439, 7, 61, 2, 2, 439,
+	441, 5, 24, 13, 2, 440, 438, 3, 2, 2, 2, 441, 442, 3, 2, 2, 2, 442, 440,
+	3, 2, 2, 2, 442, 443, 3, 2, 2, 2, 443, 41, 3, 2, 2, 2, 444, 445, 5, 32,
+	17, 2, 445, 43, 3, 2, 2, 2, 446, 447, 5, 82, 42, 2, 447, 45, 3, 2, 2, 2,
+	448, 449, 7, 56, 2, 2, 449, 454, 5, 52, 27, 2, 450, 451, 7, 60, 2, 2, 451,
+	453, 5, 52, 27, 2, 452, 450, 3, 2, 2, 2, 453, 456, 3, 2, 2, 2, 454, 452,
+	3, 2, 2, 2, 454, 455, 3, 2, 2, 2, 455, 457, 3, 2, 2, 2, 456, 454, 3, 2,
+	2, 2, 457, 458, 7, 57, 2, 2, 458, 465, 3, 2, 2, 2, 459, 461, 5, 52, 27,
+	2, 460, 459, 3, 2, 2, 2, 461, 462, 3, 2, 2, 2, 462, 460, 3, 2, 2, 2, 462,
+	463, 3, 2, 2, 2, 463, 465, 3, 2, 2, 2, 464, 448, 3, 2, 2, 2, 464, 460,
+	3, 2, 2, 2, 465, 47, 3, 2, 2, 2, 466, 467, 7, 56, 2, 2, 467, 472, 5, 52,
+	27, 2, 468, 469, 7, 60, 2, 2, 469, 471, 5, 52, 27, 2, 470, 468, 3, 2, 2,
+	2, 471, 474, 3, 2, 2, 2, 472, 470, 3, 2, 2, 2, 472, 473, 3, 2, 2, 2, 473,
+	475, 3, 2, 2, 2, 474, 472, 3, 2, 2, 2, 475, 476, 7, 57, 2, 2, 476, 483,
+	3, 2, 2, 2, 477, 479, 5, 52, 27, 2, 478, 477, 3, 2, 2, 2, 479, 480, 3,
+	2, 2, 2, 480, 478, 3, 2, 2, 2, 480, 481, 3, 2, 2, 2, 481, 483, 3, 2, 2,
+	2, 482, 466, 3, 2, 2, 2, 482, 478, 3, 2, 2, 2, 483, 49, 3, 2, 2, 2, 484,
+	485, 7, 56, 2, 2, 485, 490, 5, 52, 27, 2, 486, 487, 7, 60, 2, 2, 487, 489,
+	5, 52, 27, 2, 488, 486, 3, 2, 2, 2, 489, 492, 3, 2, 2, 2, 490, 488, 3,
+	2, 2, 2, 490, 491, 3, 2, 2, 2, 491, 493, 3, 2, 2, 2, 492, 490, 3, 2, 2,
+	2, 493, 494, 7, 57, 2, 2, 494, 501, 3, 2, 2, 2, 495, 497, 5, 52, 27, 2,
+	496, 495, 3, 2, 2, 2, 497, 498, 3, 2, 2, 2, 498, 496, 3, 2, 2, 2, 498,
+	499, 3, 2, 2, 2, 499, 501, 3, 2, 2, 2, 500, 484, 3, 2, 2, 2, 500, 496,
+	3, 2, 2, 2, 501, 51, 3, 2, 2, 2, 502, 503, 9, 4, 2, 2, 503, 506, 7, 62,
+	2, 2, 504, 507, 5, 82, 42, 2, 505, 507, 5, 32, 17, 2, 506, 504, 3, 2, 2,
+	2, 506, 505, 3, 2, 2, 2, 507, 53, 3, 2, 2, 2, 508, 509, 7, 61, 2, 2, 509,
+	511, 5, 56, 29, 2, 510, 508, 3, 2, 2, 2, 511, 512, 3, 2, 2, 2, 512, 510,
+	3, 2, 2, 2, 512, 513, 3, 2, 2, 2, 513, 55, 3, 2, 2, 2, 514, 515, 7, 8,
+	2, 2, 515, 516, 7, 62, 2, 2, 516, 534, 5, 82, 42, 2, 517, 518, 7, 28, 2,
+	2, 518, 521, 7, 62, 2, 2, 519, 522, 5, 32, 17, 2, 520, 522, 5, 82, 42,
+	2, 521, 519, 3, 2, 2, 2, 521, 520, 3, 2, 2, 2, 522, 533, 3, 2, 2, 2, 523,
+	524, 7, 29, 2, 2, 524, 527, 7, 62, 2, 2, 525, 528, 5, 58, 30, 2, 526, 528,
+	5, 60, 31, 2, 527, 525, 3, 2, 2, 2, 527, 526, 3, 2, 2, 2, 528, 533, 3,
+	2, 2, 2, 529, 530, 7, 30, 2, 2, 530, 531, 7, 62, 2, 2, 531, 533, 5, 62,
+	32, 2, 532, 517, 3, 2, 2, 2, 532, 523, 3, 2, 2, 2, 532, 529, 3, 2, 2, 2,
+	533, 536, 3, 2, 2, 2, 534, 532, 3, 2, 2, 2, 534, 535, 3, 2, 2, 2, 535,
+	57, 3, 2, 2, 2, 536, 534, 3, 2, 2, 2, 537, 538, 7, 54, 2, 2, 538, 543,
+	5, 60, 31, 2, 539, 540, 7, 60, 2, 2, 540, 542, 5, 60, 31, 2, 541, 539,
+	3, 2, 2, 2, 542, 545, 3, 2, 2, 2, 543, 541, 3, 2, 2, 2, 543, 544, 3, 2,
+	2, 2, 544, 546, 3, 2, 2, 2, 545, 543, 3, 2, 2, 2, 546, 547, 7, 55, 2, 2,
+	547, 59, 3, 2, 2, 2, 548, 553, 5, 86, 44, 2, 549, 553, 7, 41, 2, 2, 550,
+	553, 7, 48, 2, 2, 551, 553, 7, 50, 2, 2, 552, 548, 3, 2, 2, 2, 552, 549,
+	3, 2, 2, 2, 552, 550, 3, 2, 2, 2, 552, 551, 3, 2, 2, 2, 553, 61, 3, 2,
+	2, 2, 554, 563, 7, 54, 2, 2, 555, 560, 5, 64, 33, 2, 556, 557, 7, 60, 2,
+	2, 557, 559, 5, 64, 33, 2, 558, 556, 3, 2, 2, 2, 559, 562, 3, 2, 2, 2,
+	560, 558, 3, 2, 2, 2, 560, 561, 3, 2, 2, 2, 561, 564, 3, 2, 2, 2, 562,
+	560, 3, 2, 2, 2, 563, 555, 3, 2, 2, 2, 563, 564, 3, 2, 2, 2, 564, 566,
+	3, 2, 2, 2, 565, 567, 7, 60, 2, 2, 566, 565, 3, 2, 2, 2, 566, 567, 3, 2,
+	2, 2, 567, 568, 3, 2, 2, 2, 568, 576, 7, 55, 2, 2, 569, 570, 7, 61, 2,
+	2, 570, 572, 5, 64, 33, 2, 571, 569, 3, 2, 2, 2, 572, 573, 3, 2, 2, 2,
+	573, 571, 3, 2, 2, 2, 573, 574, 3, 2, 2, 2, 574, 576, 3, 2, 2, 2, 575,
+	554, 3, 2, 2, 2, 575, 571, 3, 2, 2, 2, 576, 63, 3, 2, 2, 2, 577, 586, 7,
+	54, 2, 2, 578, 583, 5, 66, 34, 2, 579, 580, 7, 60, 2, 2, 580, 582, 5, 66,
+	34, 2, 581, 579, 3, 2, 2, 2, 582, 585, 3, 2, 2, 2, 583, 581, 3, 2, 2, 2,
+	583, 584, 3, 2, 2, 2, 584, 587, 3, 2, 2, 2, 585, 583, 3, 2, 2, 2, 586,
+	578, 3, 2, 2, 2, 586, 587, 3, 2, 2, 2, 587, 589, 3, 2, 2, 2, 588, 590,
+	7, 60, 2, 2, 589, 588, 3, 2, 2, 2, 589, 590, 3, 2, 2, 2, 590, 591, 3, 2,
+	2, 2, 591, 594, 7, 55, 2, 2, 592, 594, 5, 82, 42, 2, 593, 577, 3, 2, 2,
+	2, 593, 592, 3, 2, 2, 2, 594, 65, 3, 2, 2, 2, 595, 598, 5, 82, 42, 2, 596,
+	598, 5, 32, 17, 2, 597, 595, 3, 2, 2, 2, 597, 596, 3, 2, 2, 2, 598, 67,
+	3, 2, 2, 2, 599, 600, 7, 63, 2, 2, 600, 69, 3, 2, 2, 2, 601, 602, 5, 82,
+	42, 2, 602, 71, 3, 2, 2, 2, 603, 604, 5, 82, 42, 2, 604, 73, 3, 2, 2, 2,
+	605, 606, 5, 82, 42, 2, 606, 75, 3, 2, 2, 2, 607, 608, 5, 82, 42, 2, 608,
+	77, 3, 2, 2, 2, 609, 610, 9, 5, 2, 2, 610, 79, 3, 2, 2, 2, 611, 612, 7,
+	66, 2, 2, 612, 81, 3, 2, 2, 2, 613, 614, 9, 6, 2, 2, 614, 83, 3, 2, 2,
+	2, 615, 616, 6, 43, 2, 2, 616, 618, 11, 2, 2, 2, 617, 615, 3, 2, 2, 2,
+	618, 619, 3, 2, 2, 2, 619, 617, 3, 2, 2, 2, 619, 620, 3, 2, 2, 2, 620,
+	85, 3, 2, 2, 2, 621, 622, 9, 7, 2, 2, 622, 87, 3, 2, 2, 2, 623, 624, 9,
+	8, 2, 2, 624, 89, 3, 2, 2, 2, 67, 96, 98, 108, 110, 128, 169, 171, 187,
+	228, 230, 240, 249, 251, 263, 275, 286, 292, 300, 304, 316, 328, 342, 350,
+	368, 373, 377, 386, 394, 397, 400, 410, 413, 416, 426, 429, 432, 442, 454,
+	462, 464, 472, 480, 482, 490, 498, 500, 506, 512, 521, 527, 532, 534, 543,
+	552, 560, 563, 566, 573, 575, 583, 586, 589, 593, 597, 619,
 }
 var literalNames = []string{
 	"", "'rule'", "'filter'", "'drop'", "'macro'", "'list'", "'name'", "'items'",
 	"'condition'", "'desc'", "'actions'", "'output'", "'priority'", "'tags'",
 	"'prefilter'", "'enabled'", "'warn_evttypes'", "'skip-if-unknown-filter'",
-	"'append'", "'required_engine_version'", "'and'", "'or'", "'not'", "'<'",
-	"'<='", "'>'", "'>='", "'='", "'!='", "'in'", "'contains'", "'icontains'",
-	"'startswith'", "'endswith'", "'pmatch'", "'exists'", "'['", "']'", "'('",
+	"'append'", "'required_engine_version'", "'sequence'", "'group_by'", "'window'",
+	"'threshold'", "'suppress'", "'exceptions'", "'fields'", "'comps'", "'values'",
+	"'lookup'", "'and'", "'or'", "'not'", "'<'", "'<='", "'>'", "'>='", "'='",
+	"'!='", "'in'", "'contains'", "'icontains'", "'startswith'", "'endswith'",
+	"'glob'", "'regex'", "'pmatch'", "'exists'", "'in_cidr'", "'is_private'",
+	"'is_loopback'", "'is_link_local'", "'['", "']'", "'{'", "'}'", "'('",
 	"')'", "','", "'-'",
 }
 var symbolicNames = []string{
 	"", "RULE", "FILTER", "DROP", "MACRO", "LIST", "NAME", "ITEMS", "COND",
 	"DESC", "ACTIONS", "OUTPUT", "PRIORITY", "TAGS", "PREFILTER", "ENABLED",
-	"WARNEVTTYPE", "SKIPUNKNOWN", "FAPPEND", "REQ", "AND", "OR", "NOT", "LT",
-	"LE", "GT", "GE", "EQ", "NEQ", "IN", "CONTAINS", "ICONTAINS", "STARTSWITH",
-	"ENDSWITH", "PMATCH", "EXISTS", "LBRACK", "RBRACK", "LPAREN", "RPAREN",
-	"LISTSEP", "DECL", "DEF", "SEVERITY", "SFSEVERITY", "FSEVERITY", "ID",
-	"NUMBER", "PATH", "STRING", "TAG", "WS", "NL", "COMMENT", "ANY",
+	"WARNEVTTYPE", "SKIPUNKNOWN", "FAPPEND", "REQ", "SEQUENCE", "GROUPBY",
+	"WINDOW", "THRESHOLD", "SUPPRESS", "EXCEPTIONS", "FIELDS", "COMPS", "VALUES",
+	"LOOKUP", "AND", "OR", "NOT", "LT", "LE", "GT", "GE", "EQ", "NEQ", "IN",
+	"CONTAINS", "ICONTAINS", "STARTSWITH", "ENDSWITH", "GLOB", "REGEX", "PMATCH",
+	"EXISTS", "INCIDR", "ISPRIVATE", "ISLOOPBACK", "ISLINKLOCAL", "LBRACK",
+	"RBRACK", "LBRACE", "RBRACE", "LPAREN", "RPAREN", "LISTSEP", "DECL", "DEF",
+	"SEVERITY", "SFSEVERITY", "FSEVERITY", "ID", "NUMBER", "PATH", "STRING",
+	"TAG", "WS", "NL", "COMMENT", "ANY",
 }
 
 var ruleNames = []string{
-	"policy", "defs", "prule", "srule", "pfilter", "sfilter", "drop_keyword",
+	"policy", "defs", "prule", "srule", "parule", "pfilter", "sfilter", "drop_keyword",
 	"pmacro", "plist", "preq", "expression", "or_expression", "and_expression",
-	"term", "items", "actions", "tags", "prefilter", "severity", "enabled",
-	"warnevttype", "skipunknown", "fappend", "variable", "atom", "text", "binary_operator",
-	"unary_operator",
+	"term", "items", "actions", "tags", "prefilter", "sequence", "groupby",
+	"window", "threshold", "suppress", "lookup", "thresholdattr", "exceptions",
+	"exception", "comps", "compop", "exvalues", "extuple", "exvalue", "severity",
+	"enabled", "warnevttype", "skipunknown", "fappend", "condop", "variable",
+	"atom", "text", "binary_operator", "unary_operator",
 }
 
 type SfplParser struct {
@@ -242,41 +388,59 @@ const (
 	SfplParserSKIPUNKNOWN = 17
 	SfplParserFAPPEND     = 18
 	SfplParserREQ         = 19
-	SfplParserAND         = 20
-	SfplParserOR          = 21
-	SfplParserNOT         = 22
-	SfplParserLT          = 23
-	SfplParserLE          = 24
-	SfplParserGT          = 25
-	SfplParserGE          = 26
-	SfplParserEQ          = 27
-	SfplParserNEQ         = 28
-	SfplParserIN          = 29
-	SfplParserCONTAINS    = 30
-	SfplParserICONTAINS   = 31
-	SfplParserSTARTSWITH  = 32
-	SfplParserENDSWITH    = 33
-	SfplParserPMATCH      = 34
-	SfplParserEXISTS      = 35
-	SfplParserLBRACK      = 36
-	SfplParserRBRACK      = 37
-	SfplParserLPAREN      = 38
-	SfplParserRPAREN      = 39
-	SfplParserLISTSEP     = 40
-	SfplParserDECL        = 41
-	SfplParserDEF         = 42
-	SfplParserSEVERITY    = 43
-	SfplParserSFSEVERITY  = 44
-	SfplParserFSEVERITY   = 45
-	SfplParserID          = 46
-	SfplParserNUMBER      = 47
-	SfplParserPATH        = 48
-	SfplParserSTRING      = 49
-	SfplParserTAG         = 50
-	SfplParserWS          = 51
-	SfplParserNL          = 52
-	SfplParserCOMMENT     = 53
-	SfplParserANY         = 54
+	SfplParserSEQUENCE    = 20
+	SfplParserGROUPBY     = 21
+	SfplParserWINDOW      = 22
+	SfplParserTHRESHOLD   = 23
+	SfplParserSUPPRESS    = 24
+	SfplParserEXCEPTIONS  = 25
+	SfplParserFIELDS      = 26
+	SfplParserCOMPS       = 27
+	SfplParserVALUES      = 28
+	SfplParserLOOKUP      = 29
+	SfplParserAND         = 30
+	SfplParserOR          = 31
+	SfplParserNOT         = 32
+	SfplParserLT          = 33
+	SfplParserLE          = 34
+	SfplParserGT          = 35
+	SfplParserGE          = 36
+	SfplParserEQ          = 37
+	SfplParserNEQ         = 38
+	SfplParserIN          = 39
+	SfplParserCONTAINS    = 40
+	SfplParserICONTAINS   = 41
+	SfplParserSTARTSWITH  = 42
+	SfplParserENDSWITH    = 43
+	SfplParserGLOB        = 44
+	SfplParserREGEX       = 45
+	SfplParserPMATCH      = 46
+	SfplParserEXISTS      = 47
+	SfplParserINCIDR      = 48
+	SfplParserISPRIVATE   = 49
+	SfplParserISLOOPBACK  = 50
+	SfplParserISLINKLOCAL = 51
+	SfplParserLBRACK      = 52
+	SfplParserRBRACK      = 53
+	SfplParserLBRACE      = 54
+	SfplParserRBRACE      = 55
+	SfplParserLPAREN      = 56
+	SfplParserRPAREN      = 57
+	SfplParserLISTSEP     = 58
+	SfplParserDECL        = 59
+	SfplParserDEF         = 60
+	SfplParserSEVERITY    = 61
+	SfplParserSFSEVERITY  = 62
+	SfplParserFSEVERITY   = 63
+	SfplParserID          = 64
+	SfplParserNUMBER      = 65
+	SfplParserPATH        = 66
+	SfplParserSTRING      = 67
+	SfplParserTAG         = 68
+	SfplParserWS          = 69
+	SfplParserNL          = 70
+	SfplParserCOMMENT     = 71
+	SfplParserANY         = 72
 )
 
 // SfplParser rules.
@@ -285,30 +449,46 @@ const (
 	SfplParserRULE_defs            = 1
 	SfplParserRULE_prule           = 2
 	SfplParserRULE_srule           = 3
-	SfplParserRULE_pfilter         = 4
-	SfplParserRULE_sfilter         = 5
-	SfplParserRULE_drop_keyword    = 6
-	SfplParserRULE_pmacro          = 7
-	SfplParserRULE_plist           = 8
-	SfplParserRULE_preq            = 9
-	SfplParserRULE_expression      = 10
-	SfplParserRULE_or_expression   = 11
-	SfplParserRULE_and_expression  = 12
-	SfplParserRULE_term            = 13
-	SfplParserRULE_items           = 14
-	SfplParserRULE_actions         = 15
-	SfplParserRULE_tags            = 16
-	SfplParserRULE_prefilter       = 17
-	SfplParserRULE_severity        = 18
-	SfplParserRULE_enabled         = 19
-	SfplParserRULE_warnevttype     = 20
-	SfplParserRULE_skipunknown     = 21
-	SfplParserRULE_fappend         = 22
-	SfplParserRULE_variable        = 23
-	SfplParserRULE_atom            = 24
-	SfplParserRULE_text            = 25
-	SfplParserRULE_binary_operator = 26
-	SfplParserRULE_unary_operator  = 27
+	SfplParserRULE_parule          = 4
+	SfplParserRULE_pfilter         = 5
+	SfplParserRULE_sfilter         = 6
+	SfplParserRULE_drop_keyword    = 7
+	SfplParserRULE_pmacro          = 8
+	SfplParserRULE_plist           = 9
+	SfplParserRULE_preq            = 10
+	SfplParserRULE_expression      = 11
+	SfplParserRULE_or_expression   = 12
+	SfplParserRULE_and_expression  = 13
+	SfplParserRULE_term            = 14
+	SfplParserRULE_items           = 15
+	SfplParserRULE_actions         = 16
+	SfplParserRULE_tags            = 17
+	SfplParserRULE_prefilter       = 18
+	SfplParserRULE_sequence        = 19
+	SfplParserRULE_groupby         = 20
+	SfplParserRULE_window          = 21
+	SfplParserRULE_threshold       = 22
+	SfplParserRULE_suppress        = 23
+	SfplParserRULE_lookup          = 24
+	SfplParserRULE_thresholdattr   = 25
+	SfplParserRULE_exceptions      = 26
+	SfplParserRULE_exception       = 27
+	SfplParserRULE_comps           = 28
+	SfplParserRULE_compop          = 29
+	SfplParserRULE_exvalues        = 30
+	SfplParserRULE_extuple         = 31
+	SfplParserRULE_exvalue         = 32
+	SfplParserRULE_severity        = 33
+	SfplParserRULE_enabled         = 34
+	SfplParserRULE_warnevttype     = 35
+	SfplParserRULE_skipunknown     = 36
+	SfplParserRULE_fappend         = 37
+	SfplParserRULE_condop          = 38
+	SfplParserRULE_variable        = 39
+	SfplParserRULE_atom            = 40
+	SfplParserRULE_text            = 41
+	SfplParserRULE_binary_operator = 42
+	SfplParserRULE_unary_operator  = 43
 )
 
 // IPolicyContext is an interface to support dynamic dispatch.
@@ -376,6 +556,29 @@ func (s *PolicyContext) Prule(i int) IPruleContext {
 	return t.(IPruleContext)
 }
 
+func (s *PolicyContext) AllParule() []IParuleContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IParuleContext)(nil)).Elem())
+	var tst = make([]IParuleContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IParuleContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *PolicyContext) Parule(i int) IParuleContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParuleContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParuleContext)
+}
+
 func (s *PolicyContext) AllPfilter() []IPfilterContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IPfilterContext)(nil)).Elem())
 	var tst = make([]IPfilterContext, len(ts))
@@ -520,52 +723,58 @@ func (p *SfplParser) Policy() (localctx IPolicyContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(61)
+	p.SetState(94)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for ok := true; ok; ok = _la == SfplParserDECL {
-		p.SetState(61)
+		p.SetState(94)
 		p.GetErrorHandler().Sync(p)
 		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext()) {
 		case 1:
 			{
-				p.SetState(56)
+				p.SetState(88)
 				p.Prule()
 			}
 
 		case 2:
 			{
-				p.SetState(57)
-				p.Pfilter()
+				p.SetState(89)
+				p.Parule()
 			}
 
 		case 3:
 			{
-				p.SetState(58)
-				p.Pmacro()
+				p.SetState(90)
+				p.Pfilter()
 			}
 
 		case 4:
 			{
-				p.SetState(59)
-				p.Plist()
+				p.SetState(91)
+				p.Pmacro()
 			}
 
 		case 5:
 			{
-				p.SetState(60)
+				p.SetState(92)
+				p.Plist()
+			}
+
+		case 6:
+			{
+				p.SetState(93)
 				p.Preq()
 			}
 
 		}
 
-		p.SetState(63)
+		p.SetState(96)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(65)
+		p.SetState(98)
 		p.Match(SfplParserEOF)
 	}
 
@@ -637,6 +846,29 @@ func (s *DefsContext) Srule(i int) ISruleContext {
 	return t.(ISruleContext)
 }
 
+func (s *DefsContext) AllParule() []IParuleContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IParuleContext)(nil)).Elem())
+	var tst = make([]IParuleContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IParuleContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *DefsContext) Parule(i int) IParuleContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParuleContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParuleContext)
+}
+
 func (s *DefsContext) AllSfilter() []ISfilterContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISfilterContext)(nil)).Elem())
 	var tst = make([]ISfilterContext, len(ts))
@@ -781,52 +1013,58 @@ func (p *SfplParser) Defs() (localctx IDefsContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(74)
+	p.SetState(108)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SfplParserDECL {
-		p.SetState(72)
+		p.SetState(106)
 		p.GetErrorHandler().Sync(p)
 		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) {
 		case 1:
 			{
-				p.SetState(67)
+				p.SetState(100)
 				p.Srule()
 			}
 
 		case 2:
 			{
-				p.SetState(68)
-				p.Sfilter()
+				p.SetState(101)
+				p.Parule()
 			}
 
 		case 3:
 			{
-				p.SetState(69)
-				p.Pmacro()
+				p.SetState(102)
+				p.Sfilter()
 			}
 
 		case 4:
 			{
-				p.SetState(70)
-				p.Plist()
+				p.SetState(103)
+				p.Pmacro()
 			}
 
 		case 5:
 			{
-				p.SetState(71)
+				p.SetState(104)
+				p.Plist()
+			}
+
+		case 6:
+			{
+				p.SetState(105)
 				p.Preq()
 			}
 
 		}
 
-		p.SetState(76)
+		p.SetState(110)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(77)
+		p.SetState(111)
 		p.Match(SfplParserEOF)
 	}
 
@@ -928,6 +1166,20 @@ func (s *PruleContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
+func (s *PruleContext) SEQUENCE() antlr.TerminalNode {
+	return s.GetToken(SfplParserSEQUENCE, 0)
+}
+
+func (s *PruleContext) Sequence() ISequenceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISequenceContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISequenceContext)
+}
+
 func (s *PruleContext) AllOUTPUT() []antlr.TerminalNode {
 	return s.GetTokens(SfplParserOUTPUT)
 }
@@ -1153,6 +1405,161 @@ func (s *PruleContext) Skipunknown(i int) ISkipunknownContext {
 	return t.(ISkipunknownContext)
 }
 
+func (s *PruleContext) AllGROUPBY() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserGROUPBY)
+}
+
+func (s *PruleContext) GROUPBY(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserGROUPBY, i)
+}
+
+func (s *PruleContext) AllGroupby() []IGroupbyContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IGroupbyContext)(nil)).Elem())
+	var tst = make([]IGroupbyContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IGroupbyContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *PruleContext) Groupby(i int) IGroupbyContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGroupbyContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGroupbyContext)
+}
+
+func (s *PruleContext) AllWINDOW() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserWINDOW)
+}
+
+func (s *PruleContext) WINDOW(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserWINDOW, i)
+}
+
+func (s *PruleContext) AllWindow() []IWindowContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IWindowContext)(nil)).Elem())
+	var tst = make([]IWindowContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IWindowContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *PruleContext) Window(i int) IWindowContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWindowContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWindowContext)
+}
+
+func (s *PruleContext) AllTHRESHOLD() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserTHRESHOLD)
+}
+
+func (s *PruleContext) THRESHOLD(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserTHRESHOLD, i)
+}
+
+func (s *PruleContext) AllThreshold() []IThresholdContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IThresholdContext)(nil)).Elem())
+	var tst = make([]IThresholdContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IThresholdContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *PruleContext) Threshold(i int) IThresholdContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IThresholdContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IThresholdContext)
+}
+
+func (s *PruleContext) AllSUPPRESS() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserSUPPRESS)
+}
+
+func (s *PruleContext) SUPPRESS(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserSUPPRESS, i)
+}
+
+func (s *PruleContext) AllSuppress() []ISuppressContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISuppressContext)(nil)).Elem())
+	var tst = make([]ISuppressContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ISuppressContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *PruleContext) Suppress(i int) ISuppressContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISuppressContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISuppressContext)
+}
+
+func (s *PruleContext) AllEXCEPTIONS() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserEXCEPTIONS)
+}
+
+func (s *PruleContext) EXCEPTIONS(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserEXCEPTIONS, i)
+}
+
+func (s *PruleContext) AllExceptions() []IExceptionsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExceptionsContext)(nil)).Elem())
+	var tst = make([]IExceptionsContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExceptionsContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *PruleContext) Exceptions(i int) IExceptionsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExceptionsContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExceptionsContext)
+}
+
 func (s *PruleContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1206,177 +1613,270 @@ func (p *SfplParser) Prule() (localctx IPruleContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(79)
+		p.SetState(113)
 		p.Match(SfplParserDECL)
 	}
 	{
-		p.SetState(80)
+		p.SetState(114)
 		p.Match(SfplParserRULE)
 	}
 	{
-		p.SetState(81)
+		p.SetState(115)
 		p.Match(SfplParserDEF)
 	}
 	{
-		p.SetState(82)
+		p.SetState(116)
 		p.Text()
 	}
 	{
-		p.SetState(83)
+		p.SetState(117)
 		p.Match(SfplParserDESC)
 	}
 	{
-		p.SetState(84)
+		p.SetState(118)
 		p.Match(SfplParserDEF)
 	}
 	{
-		p.SetState(85)
+		p.SetState(119)
 		p.Text()
 	}
-	{
-		p.SetState(86)
-		p.Match(SfplParserCOND)
-	}
-	{
-		p.SetState(87)
-		p.Match(SfplParserDEF)
-	}
-	{
-		p.SetState(88)
-		p.Expression()
+	p.SetState(126)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SfplParserCOND:
+		{
+			p.SetState(120)
+			p.Match(SfplParserCOND)
+		}
+		{
+			p.SetState(121)
+			p.Match(SfplParserDEF)
+		}
+		{
+			p.SetState(122)
+			p.Expression()
+		}
+
+	case SfplParserSEQUENCE:
+		{
+			p.SetState(123)
+			p.Match(SfplParserSEQUENCE)
+		}
+		{
+			p.SetState(124)
+			p.Match(SfplParserDEF)
+		}
+		{
+			p.SetState(125)
+			p.Sequence()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(115)
+	p.SetState(169)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SfplParserACTIONS)|(1<<SfplParserOUTPUT)|(1<<SfplParserPRIORITY)|(1<<SfplParserTAGS)|(1<<SfplParserPREFILTER)|(1<<SfplParserENABLED)|(1<<SfplParserWARNEVTTYPE)|(1<<SfplParserSKIPUNKNOWN))) != 0 {
-		p.SetState(113)
+	for ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SfplParserACTIONS)|(1<<SfplParserOUTPUT)|(1<<SfplParserPRIORITY)|(1<<SfplParserTAGS)|(1<<SfplParserPREFILTER)|(1<<SfplParserENABLED)|(1<<SfplParserWARNEVTTYPE)|(1<<SfplParserSKIPUNKNOWN)|(1<<SfplParserGROUPBY)|(1<<SfplParserWINDOW)|(1<<SfplParserTHRESHOLD)|(1<<SfplParserSUPPRESS)|(1<<SfplParserEXCEPTIONS))) != 0 {
+		p.SetState(167)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case SfplParserOUTPUT:
 			{
-				p.SetState(89)
+				p.SetState(128)
 				p.Match(SfplParserOUTPUT)
 			}
 			{
-				p.SetState(90)
+				p.SetState(129)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(91)
+				p.SetState(130)
 				p.Text()
 			}
 
 		case SfplParserACTIONS:
 			{
-				p.SetState(92)
+				p.SetState(131)
 				p.Match(SfplParserACTIONS)
 			}
 			{
-				p.SetState(93)
+				p.SetState(132)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(94)
+				p.SetState(133)
 				p.Actions()
 			}
 
 		case SfplParserPRIORITY:
 			{
-				p.SetState(95)
+				p.SetState(134)
 				p.Match(SfplParserPRIORITY)
 			}
 			{
-				p.SetState(96)
+				p.SetState(135)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(97)
+				p.SetState(136)
 				p.Severity()
 			}
 
 		case SfplParserTAGS:
 			{
-				p.SetState(98)
+				p.SetState(137)
 				p.Match(SfplParserTAGS)
 			}
 			{
-				p.SetState(99)
+				p.SetState(138)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(100)
+				p.SetState(139)
 				p.Tags()
 			}
 
 		case SfplParserPREFILTER:
 			{
-				p.SetState(101)
+				p.SetState(140)
 				p.Match(SfplParserPREFILTER)
 			}
 			{
-				p.SetState(102)
+				p.SetState(141)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(103)
+				p.SetState(142)
 				p.Prefilter()
 			}
 
 		case SfplParserENABLED:
 			{
-				p.SetState(104)
+				p.SetState(143)
 				p.Match(SfplParserENABLED)
 			}
 			{
-				p.SetState(105)
+				p.SetState(144)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(106)
+				p.SetState(145)
 				p.Enabled()
 			}
 
 		case SfplParserWARNEVTTYPE:
 			{
-				p.SetState(107)
+				p.SetState(146)
 				p.Match(SfplParserWARNEVTTYPE)
 			}
 			{
-				p.SetState(108)
+				p.SetState(147)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(109)
+				p.SetState(148)
 				p.Warnevttype()
 			}
 
 		case SfplParserSKIPUNKNOWN:
 			{
-				p.SetState(110)
+				p.SetState(149)
 				p.Match(SfplParserSKIPUNKNOWN)
 			}
 			{
-				p.SetState(111)
+				p.SetState(150)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(112)
+				p.SetState(151)
 				p.Skipunknown()
 			}
 
-		default:
-			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		}
-
-		p.SetState(117)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
-	}
+		case SfplParserGROUPBY:
+			{
+				p.SetState(152)
+				p.Match(SfplParserGROUPBY)
+			}
+			{
+				p.SetState(153)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(154)
+				p.Groupby()
+			}
 
-	return localctx
-}
+		case SfplParserWINDOW:
+			{
+				p.SetState(155)
+				p.Match(SfplParserWINDOW)
+			}
+			{
+				p.SetState(156)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(157)
+				p.Window()
+			}
+
+		case SfplParserTHRESHOLD:
+			{
+				p.SetState(158)
+				p.Match(SfplParserTHRESHOLD)
+			}
+			{
+				p.SetState(159)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(160)
+				p.Threshold()
+			}
+
+		case SfplParserSUPPRESS:
+			{
+				p.SetState(161)
+				p.Match(SfplParserSUPPRESS)
+			}
+			{
+				p.SetState(162)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(163)
+				p.Suppress()
+			}
+
+		case SfplParserEXCEPTIONS:
+			{
+				p.SetState(164)
+				p.Match(SfplParserEXCEPTIONS)
+			}
+			{
+				p.SetState(165)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(166)
+				p.Exceptions()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+		p.SetState(171)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
 
 // ISruleContext is an interface to support dynamic dispatch.
 type ISruleContext interface {
@@ -1473,6 +1973,20 @@ func (s *SruleContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
+func (s *SruleContext) SEQUENCE() antlr.TerminalNode {
+	return s.GetToken(SfplParserSEQUENCE, 0)
+}
+
+func (s *SruleContext) Sequence() ISequenceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISequenceContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISequenceContext)
+}
+
 func (s *SruleContext) AllOUTPUT() []antlr.TerminalNode {
 	return s.GetTokens(SfplParserOUTPUT)
 }
@@ -1698,6 +2212,161 @@ func (s *SruleContext) Skipunknown(i int) ISkipunknownContext {
 	return t.(ISkipunknownContext)
 }
 
+func (s *SruleContext) AllGROUPBY() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserGROUPBY)
+}
+
+func (s *SruleContext) GROUPBY(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserGROUPBY, i)
+}
+
+func (s *SruleContext) AllGroupby() []IGroupbyContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IGroupbyContext)(nil)).Elem())
+	var tst = make([]IGroupbyContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IGroupbyContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SruleContext) Groupby(i int) IGroupbyContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGroupbyContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGroupbyContext)
+}
+
+func (s *SruleContext) AllWINDOW() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserWINDOW)
+}
+
+func (s *SruleContext) WINDOW(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserWINDOW, i)
+}
+
+func (s *SruleContext) AllWindow() []IWindowContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IWindowContext)(nil)).Elem())
+	var tst = make([]IWindowContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IWindowContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SruleContext) Window(i int) IWindowContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWindowContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWindowContext)
+}
+
+func (s *SruleContext) AllTHRESHOLD() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserTHRESHOLD)
+}
+
+func (s *SruleContext) THRESHOLD(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserTHRESHOLD, i)
+}
+
+func (s *SruleContext) AllThreshold() []IThresholdContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IThresholdContext)(nil)).Elem())
+	var tst = make([]IThresholdContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IThresholdContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SruleContext) Threshold(i int) IThresholdContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IThresholdContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IThresholdContext)
+}
+
+func (s *SruleContext) AllSUPPRESS() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserSUPPRESS)
+}
+
+func (s *SruleContext) SUPPRESS(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserSUPPRESS, i)
+}
+
+func (s *SruleContext) AllSuppress() []ISuppressContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISuppressContext)(nil)).Elem())
+	var tst = make([]ISuppressContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ISuppressContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SruleContext) Suppress(i int) ISuppressContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISuppressContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISuppressContext)
+}
+
+func (s *SruleContext) AllEXCEPTIONS() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserEXCEPTIONS)
+}
+
+func (s *SruleContext) EXCEPTIONS(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserEXCEPTIONS, i)
+}
+
+func (s *SruleContext) AllExceptions() []IExceptionsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExceptionsContext)(nil)).Elem())
+	var tst = make([]IExceptionsContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExceptionsContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SruleContext) Exceptions(i int) IExceptionsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExceptionsContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExceptionsContext)
+}
+
 func (s *SruleContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1751,171 +2420,264 @@ func (p *SfplParser) Srule() (localctx ISruleContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(118)
+		p.SetState(172)
 		p.Match(SfplParserDECL)
 	}
 	{
-		p.SetState(119)
+		p.SetState(173)
 		p.Match(SfplParserRULE)
 	}
 	{
-		p.SetState(120)
+		p.SetState(174)
 		p.Match(SfplParserDEF)
 	}
 	{
-		p.SetState(121)
+		p.SetState(175)
 		p.Text()
 	}
 	{
-		p.SetState(122)
+		p.SetState(176)
 		p.Match(SfplParserDESC)
 	}
 	{
-		p.SetState(123)
+		p.SetState(177)
 		p.Match(SfplParserDEF)
 	}
 	{
-		p.SetState(124)
+		p.SetState(178)
 		p.Text()
 	}
-	{
-		p.SetState(125)
-		p.Match(SfplParserCOND)
-	}
-	{
-		p.SetState(126)
-		p.Match(SfplParserDEF)
-	}
-	{
-		p.SetState(127)
-		p.Expression()
+	p.SetState(185)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SfplParserCOND:
+		{
+			p.SetState(179)
+			p.Match(SfplParserCOND)
+		}
+		{
+			p.SetState(180)
+			p.Match(SfplParserDEF)
+		}
+		{
+			p.SetState(181)
+			p.Expression()
+		}
+
+	case SfplParserSEQUENCE:
+		{
+			p.SetState(182)
+			p.Match(SfplParserSEQUENCE)
+		}
+		{
+			p.SetState(183)
+			p.Match(SfplParserDEF)
+		}
+		{
+			p.SetState(184)
+			p.Sequence()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(154)
+	p.SetState(228)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SfplParserACTIONS)|(1<<SfplParserOUTPUT)|(1<<SfplParserPRIORITY)|(1<<SfplParserTAGS)|(1<<SfplParserPREFILTER)|(1<<SfplParserENABLED)|(1<<SfplParserWARNEVTTYPE)|(1<<SfplParserSKIPUNKNOWN))) != 0 {
-		p.SetState(152)
+	for ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SfplParserACTIONS)|(1<<SfplParserOUTPUT)|(1<<SfplParserPRIORITY)|(1<<SfplParserTAGS)|(1<<SfplParserPREFILTER)|(1<<SfplParserENABLED)|(1<<SfplParserWARNEVTTYPE)|(1<<SfplParserSKIPUNKNOWN)|(1<<SfplParserGROUPBY)|(1<<SfplParserWINDOW)|(1<<SfplParserTHRESHOLD)|(1<<SfplParserSUPPRESS)|(1<<SfplParserEXCEPTIONS))) != 0 {
+		p.SetState(226)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case SfplParserOUTPUT:
 			{
-				p.SetState(128)
+				p.SetState(187)
 				p.Match(SfplParserOUTPUT)
 			}
 			{
-				p.SetState(129)
+				p.SetState(188)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(130)
+				p.SetState(189)
 				p.Text()
 			}
 
 		case SfplParserACTIONS:
 			{
-				p.SetState(131)
+				p.SetState(190)
 				p.Match(SfplParserACTIONS)
 			}
 			{
-				p.SetState(132)
+				p.SetState(191)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(133)
+				p.SetState(192)
 				p.Actions()
 			}
 
 		case SfplParserPRIORITY:
 			{
-				p.SetState(134)
+				p.SetState(193)
 				p.Match(SfplParserPRIORITY)
 			}
 			{
-				p.SetState(135)
+				p.SetState(194)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(136)
+				p.SetState(195)
 				p.Severity()
 			}
 
 		case SfplParserTAGS:
 			{
-				p.SetState(137)
+				p.SetState(196)
 				p.Match(SfplParserTAGS)
 			}
 			{
-				p.SetState(138)
+				p.SetState(197)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(139)
+				p.SetState(198)
 				p.Tags()
 			}
 
 		case SfplParserPREFILTER:
 			{
-				p.SetState(140)
+				p.SetState(199)
 				p.Match(SfplParserPREFILTER)
 			}
 			{
-				p.SetState(141)
+				p.SetState(200)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(142)
+				p.SetState(201)
 				p.Prefilter()
 			}
 
 		case SfplParserENABLED:
 			{
-				p.SetState(143)
+				p.SetState(202)
 				p.Match(SfplParserENABLED)
 			}
 			{
-				p.SetState(144)
+				p.SetState(203)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(145)
+				p.SetState(204)
 				p.Enabled()
 			}
 
 		case SfplParserWARNEVTTYPE:
 			{
-				p.SetState(146)
+				p.SetState(205)
 				p.Match(SfplParserWARNEVTTYPE)
 			}
 			{
-				p.SetState(147)
+				p.SetState(206)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(148)
+				p.SetState(207)
 				p.Warnevttype()
 			}
 
 		case SfplParserSKIPUNKNOWN:
 			{
-				p.SetState(149)
+				p.SetState(208)
 				p.Match(SfplParserSKIPUNKNOWN)
 			}
 			{
-				p.SetState(150)
+				p.SetState(209)
 				p.Match(SfplParserDEF)
 			}
 			{
-				p.SetState(151)
+				p.SetState(210)
 				p.Skipunknown()
 			}
 
+		case SfplParserGROUPBY:
+			{
+				p.SetState(211)
+				p.Match(SfplParserGROUPBY)
+			}
+			{
+				p.SetState(212)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(213)
+				p.Groupby()
+			}
+
+		case SfplParserWINDOW:
+			{
+				p.SetState(214)
+				p.Match(SfplParserWINDOW)
+			}
+			{
+				p.SetState(215)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(216)
+				p.Window()
+			}
+
+		case SfplParserTHRESHOLD:
+			{
+				p.SetState(217)
+				p.Match(SfplParserTHRESHOLD)
+			}
+			{
+				p.SetState(218)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(219)
+				p.Threshold()
+			}
+
+		case SfplParserSUPPRESS:
+			{
+				p.SetState(220)
+				p.Match(SfplParserSUPPRESS)
+			}
+			{
+				p.SetState(221)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(222)
+				p.Suppress()
+			}
+
+		case SfplParserEXCEPTIONS:
+			{
+				p.SetState(223)
+				p.Match(SfplParserEXCEPTIONS)
+			}
+			{
+				p.SetState(224)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(225)
+				p.Exceptions()
+			}
+
 		default:
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(156)
+		p.SetState(230)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -1923,23 +2685,345 @@ func (p *SfplParser) Srule() (localctx ISruleContext) {
 	return localctx
 }
 
-// IPfilterContext is an interface to support dynamic dispatch.
-type IPfilterContext interface {
+// IParuleContext is an interface to support dynamic dispatch.
+type IParuleContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsPfilterContext differentiates from other interfaces.
-	IsPfilterContext()
+	// IsParuleContext differentiates from other interfaces.
+	IsParuleContext()
 }
 
-type PfilterContext struct {
+type ParuleContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPfilterContext() *PfilterContext {
+func NewEmptyParuleContext() *ParuleContext {
+	var p = new(ParuleContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_parule
+	return p
+}
+
+func (*ParuleContext) IsParuleContext() {}
+
+func NewParuleContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParuleContext {
+	var p = new(ParuleContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_parule
+
+	return p
+}
+
+func (s *ParuleContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ParuleContext) DECL() antlr.TerminalNode {
+	return s.GetToken(SfplParserDECL, 0)
+}
+
+func (s *ParuleContext) RULE() antlr.TerminalNode {
+	return s.GetToken(SfplParserRULE, 0)
+}
+
+func (s *ParuleContext) AllDEF() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserDEF)
+}
+
+func (s *ParuleContext) DEF(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserDEF, i)
+}
+
+func (s *ParuleContext) Text() ITextContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITextContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextContext)
+}
+
+func (s *ParuleContext) AllCOND() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserCOND)
+}
+
+func (s *ParuleContext) COND(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserCOND, i)
+}
+
+func (s *ParuleContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ParuleContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ParuleContext) AllEXCEPTIONS() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserEXCEPTIONS)
+}
+
+func (s *ParuleContext) EXCEPTIONS(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserEXCEPTIONS, i)
+}
+
+func (s *ParuleContext) AllExceptions() []IExceptionsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExceptionsContext)(nil)).Elem())
+	var tst = make([]IExceptionsContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExceptionsContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ParuleContext) Exceptions(i int) IExceptionsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExceptionsContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExceptionsContext)
+}
+
+func (s *ParuleContext) AllFAPPEND() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserFAPPEND)
+}
+
+func (s *ParuleContext) FAPPEND(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserFAPPEND, i)
+}
+
+func (s *ParuleContext) AllFappend() []IFappendContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IFappendContext)(nil)).Elem())
+	var tst = make([]IFappendContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IFappendContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ParuleContext) Fappend(i int) IFappendContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFappendContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFappendContext)
+}
+
+func (s *ParuleContext) AllCondop() []ICondopContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ICondopContext)(nil)).Elem())
+	var tst = make([]ICondopContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ICondopContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ParuleContext) Condop(i int) ICondopContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICondopContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICondopContext)
+}
+
+func (s *ParuleContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParuleContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ParuleContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterParule(s)
+	}
+}
+
+func (s *ParuleContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitParule(s)
+	}
+}
+
+func (s *ParuleContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitParule(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Parule() (localctx IParuleContext) {
+	localctx = NewParuleContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 8, SfplParserRULE_parule)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(231)
+		p.Match(SfplParserDECL)
+	}
+	{
+		p.SetState(232)
+		p.Match(SfplParserRULE)
+	}
+	{
+		p.SetState(233)
+		p.Match(SfplParserDEF)
+	}
+	{
+		p.SetState(234)
+		p.Text()
+	}
+	p.SetState(247)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SfplParserCOND)|(1<<SfplParserFAPPEND)|(1<<SfplParserEXCEPTIONS))) != 0 {
+		p.SetState(247)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case SfplParserCOND:
+			{
+				p.SetState(235)
+				p.Match(SfplParserCOND)
+			}
+			{
+				p.SetState(236)
+				p.Match(SfplParserDEF)
+			}
+			p.SetState(238)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == SfplParserAND || _la == SfplParserOR {
+				{
+					p.SetState(237)
+					p.Condop()
+				}
+
+			}
+			{
+				p.SetState(240)
+				p.Expression()
+			}
+
+		case SfplParserEXCEPTIONS:
+			{
+				p.SetState(241)
+				p.Match(SfplParserEXCEPTIONS)
+			}
+			{
+				p.SetState(242)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(243)
+				p.Exceptions()
+			}
+
+		case SfplParserFAPPEND:
+			{
+				p.SetState(244)
+				p.Match(SfplParserFAPPEND)
+			}
+			{
+				p.SetState(245)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(246)
+				p.Fappend()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+		p.SetState(249)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IPfilterContext is an interface to support dynamic dispatch.
+type IPfilterContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsPfilterContext differentiates from other interfaces.
+	IsPfilterContext()
+}
+
+type PfilterContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPfilterContext() *PfilterContext {
 	var p = new(PfilterContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
 	p.RuleIndex = SfplParserRULE_pfilter
@@ -2047,7 +3131,7 @@ func (s *PfilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Pfilter() (localctx IPfilterContext) {
 	localctx = NewPfilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, SfplParserRULE_pfilter)
+	p.EnterRule(localctx, 10, SfplParserRULE_pfilter)
 	var _la int
 
 	defer func() {
@@ -2068,48 +3152,48 @@ func (p *SfplParser) Pfilter() (localctx IPfilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(157)
+		p.SetState(251)
 		p.Match(SfplParserDECL)
 	}
 	{
-		p.SetState(158)
+		p.SetState(252)
 		p.Drop_keyword()
 	}
 	{
-		p.SetState(159)
+		p.SetState(253)
 		p.Match(SfplParserDEF)
 	}
 	{
-		p.SetState(160)
+		p.SetState(254)
 		p.Match(SfplParserID)
 	}
 	{
-		p.SetState(161)
+		p.SetState(255)
 		p.Match(SfplParserCOND)
 	}
 	{
-		p.SetState(162)
+		p.SetState(256)
 		p.Match(SfplParserDEF)
 	}
 	{
-		p.SetState(163)
+		p.SetState(257)
 		p.Expression()
 	}
-	p.SetState(167)
+	p.SetState(261)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SfplParserENABLED {
 		{
-			p.SetState(164)
+			p.SetState(258)
 			p.Match(SfplParserENABLED)
 		}
 		{
-			p.SetState(165)
+			p.SetState(259)
 			p.Match(SfplParserDEF)
 		}
 		{
-			p.SetState(166)
+			p.SetState(260)
 			p.Enabled()
 		}
 
@@ -2242,7 +3326,7 @@ func (s *SfilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Sfilter() (localctx ISfilterContext) {
 	localctx = NewSfilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, SfplParserRULE_sfilter)
+	p.EnterRule(localctx, 12, SfplParserRULE_sfilter)
 	var _la int
 
 	defer func() {
@@ -2263,48 +3347,48 @@ func (p *SfplParser) Sfilter() (localctx ISfilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(169)
+		p.SetState(263)
 		p.Match(SfplParserDECL)
 	}
 	{
-		p.SetState(170)
+		p.SetState(264)
 		p.Drop_keyword()
 	}
 	{
-		p.SetState(171)
+		p.SetState(265)
 		p.Match(SfplParserDEF)
 	}
 	{
-		p.SetState(172)
+		p.SetState(266)
 		p.Match(SfplParserID)
 	}
 	{
-		p.SetState(173)
+		p.SetState(267)
 		p.Match(SfplParserCOND)
 	}
 	{
-		p.SetState(174)
+		p.SetState(268)
 		p.Match(SfplParserDEF)
 	}
 	{
-		p.SetState(175)
+		p.SetState(269)
 		p.Expression()
 	}
-	p.SetState(179)
+	p.SetState(273)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SfplParserENABLED {
 		{
-			p.SetState(176)
+			p.SetState(270)
 			p.Match(SfplParserENABLED)
 		}
 		{
-			p.SetState(177)
+			p.SetState(271)
 			p.Match(SfplParserDEF)
 		}
 		{
-			p.SetState(178)
+			p.SetState(272)
 			p.Enabled()
 		}
 
@@ -2391,7 +3475,7 @@ func (s *Drop_keywordContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SfplParser) Drop_keyword() (localctx IDrop_keywordContext) {
 	localctx = NewDrop_keywordContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, SfplParserRULE_drop_keyword)
+	p.EnterRule(localctx, 14, SfplParserRULE_drop_keyword)
 	var _la int
 
 	defer func() {
@@ -2412,7 +3496,7 @@ func (p *SfplParser) Drop_keyword() (localctx IDrop_keywordContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(181)
+		p.SetState(275)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SfplParserFILTER || _la == SfplParserDROP) {
@@ -2512,6 +3596,16 @@ func (s *PmacroContext) Fappend() IFappendContext {
 	return t.(IFappendContext)
 }
 
+func (s *PmacroContext) Condop() ICondopContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICondopContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICondopContext)
+}
+
 func (s *PmacroContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -2544,7 +3638,7 @@ func (s *PmacroContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Pmacro() (localctx IPmacroContext) {
 	localctx = NewPmacroContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, SfplParserRULE_pmacro)
+	p.EnterRule(localctx, 16, SfplParserRULE_pmacro)
 	var _la int
 
 	defer func() {
@@ -2565,51 +3659,108 @@ func (p *SfplParser) Pmacro() (localctx IPmacroContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(183)
+		p.SetState(277)
 		p.Match(SfplParserDECL)
 	}
 	{
-		p.SetState(184)
+		p.SetState(278)
 		p.Match(SfplParserMACRO)
 	}
 	{
-		p.SetState(185)
+		p.SetState(279)
 		p.Match(SfplParserDEF)
 	}
 	{
-		p.SetState(186)
+		p.SetState(280)
 		p.Match(SfplParserID)
 	}
-	{
-		p.SetState(187)
-		p.Match(SfplParserCOND)
-	}
-	{
-		p.SetState(188)
-		p.Match(SfplParserDEF)
-	}
-	{
-		p.SetState(189)
-		p.Expression()
-	}
-	p.SetState(193)
+	p.SetState(302)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == SfplParserFAPPEND {
+	switch p.GetTokenStream().LA(1) {
+	case SfplParserCOND:
+		{
+			p.SetState(281)
+			p.Match(SfplParserCOND)
+		}
+		{
+			p.SetState(282)
+			p.Match(SfplParserDEF)
+		}
+		p.SetState(284)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SfplParserAND || _la == SfplParserOR {
+			{
+				p.SetState(283)
+				p.Condop()
+			}
+
+		}
+		{
+			p.SetState(286)
+			p.Expression()
+		}
+		p.SetState(290)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SfplParserFAPPEND {
+			{
+				p.SetState(287)
+				p.Match(SfplParserFAPPEND)
+			}
+			{
+				p.SetState(288)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(289)
+				p.Fappend()
+			}
+
+		}
+
+	case SfplParserFAPPEND:
 		{
-			p.SetState(190)
+			p.SetState(292)
 			p.Match(SfplParserFAPPEND)
 		}
 		{
-			p.SetState(191)
+			p.SetState(293)
 			p.Match(SfplParserDEF)
 		}
 		{
-			p.SetState(192)
+			p.SetState(294)
 			p.Fappend()
 		}
+		{
+			p.SetState(295)
+			p.Match(SfplParserCOND)
+		}
+		{
+			p.SetState(296)
+			p.Match(SfplParserDEF)
+		}
+		p.SetState(298)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SfplParserAND || _la == SfplParserOR {
+			{
+				p.SetState(297)
+				p.Condop()
+			}
+
+		}
+		{
+			p.SetState(300)
+			p.Expression()
+		}
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -2687,6 +3838,34 @@ func (s *PlistContext) Items() IItemsContext {
 	return t.(IItemsContext)
 }
 
+func (s *PlistContext) FAPPEND() antlr.TerminalNode {
+	return s.GetToken(SfplParserFAPPEND, 0)
+}
+
+func (s *PlistContext) Fappend() IFappendContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFappendContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFappendContext)
+}
+
+func (s *PlistContext) LOOKUP() antlr.TerminalNode {
+	return s.GetToken(SfplParserLOOKUP, 0)
+}
+
+func (s *PlistContext) Lookup() ILookupContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILookupContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILookupContext)
+}
+
 func (s *PlistContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -2719,7 +3898,8 @@ func (s *PlistContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Plist() (localctx IPlistContext) {
 	localctx = NewPlistContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, SfplParserRULE_plist)
+	p.EnterRule(localctx, 18, SfplParserRULE_plist)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -2739,32 +3919,100 @@ func (p *SfplParser) Plist() (localctx IPlistContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(195)
+		p.SetState(304)
 		p.Match(SfplParserDECL)
 	}
 	{
-		p.SetState(196)
+		p.SetState(305)
 		p.Match(SfplParserLIST)
 	}
 	{
-		p.SetState(197)
+		p.SetState(306)
 		p.Match(SfplParserDEF)
 	}
 	{
-		p.SetState(198)
+		p.SetState(307)
 		p.Match(SfplParserID)
 	}
-	{
-		p.SetState(199)
-		p.Match(SfplParserITEMS)
-	}
-	{
-		p.SetState(200)
-		p.Match(SfplParserDEF)
-	}
-	{
-		p.SetState(201)
-		p.Items()
+	p.SetState(326)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SfplParserITEMS:
+		{
+			p.SetState(308)
+			p.Match(SfplParserITEMS)
+		}
+		{
+			p.SetState(309)
+			p.Match(SfplParserDEF)
+		}
+		{
+			p.SetState(310)
+			p.Items()
+		}
+		p.SetState(314)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SfplParserFAPPEND {
+			{
+				p.SetState(311)
+				p.Match(SfplParserFAPPEND)
+			}
+			{
+				p.SetState(312)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(313)
+				p.Fappend()
+			}
+
+		}
+
+	case SfplParserFAPPEND:
+		{
+			p.SetState(316)
+			p.Match(SfplParserFAPPEND)
+		}
+		{
+			p.SetState(317)
+			p.Match(SfplParserDEF)
+		}
+		{
+			p.SetState(318)
+			p.Fappend()
+		}
+		{
+			p.SetState(319)
+			p.Match(SfplParserITEMS)
+		}
+		{
+			p.SetState(320)
+			p.Match(SfplParserDEF)
+		}
+		{
+			p.SetState(321)
+			p.Items()
+		}
+
+	case SfplParserLOOKUP:
+		{
+			p.SetState(323)
+			p.Match(SfplParserLOOKUP)
+		}
+		{
+			p.SetState(324)
+			p.Match(SfplParserDEF)
+		}
+		{
+			p.SetState(325)
+			p.Lookup()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -2862,7 +4110,7 @@ func (s *PreqContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Preq() (localctx IPreqContext) {
 	localctx = NewPreqContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, SfplParserRULE_preq)
+	p.EnterRule(localctx, 20, SfplParserRULE_preq)
 
 	defer func() {
 		p.ExitRule()
@@ -2882,19 +4130,19 @@ func (p *SfplParser) Preq() (localctx IPreqContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(203)
+		p.SetState(328)
 		p.Match(SfplParserDECL)
 	}
 	{
-		p.SetState(204)
+		p.SetState(329)
 		p.Match(SfplParserREQ)
 	}
 	{
-		p.SetState(205)
+		p.SetState(330)
 		p.Match(SfplParserDEF)
 	}
 	{
-		p.SetState(206)
+		p.SetState(331)
 		p.Atom()
 	}
 
@@ -2981,7 +4229,7 @@ func (s *ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Expression() (localctx IExpressionContext) {
 	localctx = NewExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, SfplParserRULE_expression)
+	p.EnterRule(localctx, 22, SfplParserRULE_expression)
 
 	defer func() {
 		p.ExitRule()
@@ -3001,7 +4249,7 @@ func (p *SfplParser) Expression() (localctx IExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(208)
+		p.SetState(333)
 		p.Or_expression()
 	}
 
@@ -3109,7 +4357,7 @@ func (s *Or_expressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SfplParser) Or_expression() (localctx IOr_expressionContext) {
 	localctx = NewOr_expressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, SfplParserRULE_or_expression)
+	p.EnterRule(localctx, 24, SfplParserRULE_or_expression)
 	var _la int
 
 	defer func() {
@@ -3130,24 +4378,24 @@ func (p *SfplParser) Or_expression() (localctx IOr_expressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(210)
+		p.SetState(335)
 		p.And_expression()
 	}
-	p.SetState(215)
+	p.SetState(340)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SfplParserOR {
 		{
-			p.SetState(211)
+			p.SetState(336)
 			p.Match(SfplParserOR)
 		}
 		{
-			p.SetState(212)
+			p.SetState(337)
 			p.And_expression()
 		}
 
-		p.SetState(217)
+		p.SetState(342)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -3256,7 +4504,7 @@ func (s *And_expressionContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *SfplParser) And_expression() (localctx IAnd_expressionContext) {
 	localctx = NewAnd_expressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, SfplParserRULE_and_expression)
+	p.EnterRule(localctx, 26, SfplParserRULE_and_expression)
 	var _la int
 
 	defer func() {
@@ -3277,24 +4525,24 @@ func (p *SfplParser) And_expression() (localctx IAnd_expressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(218)
+		p.SetState(343)
 		p.Term()
 	}
-	p.SetState(223)
+	p.SetState(348)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SfplParserAND {
 		{
-			p.SetState(219)
+			p.SetState(344)
 			p.Match(SfplParserAND)
 		}
 		{
-			p.SetState(220)
+			p.SetState(345)
 			p.Term()
 		}
 
-		p.SetState(225)
+		p.SetState(350)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -3423,6 +4671,10 @@ func (s *TermContext) PMATCH() antlr.TerminalNode {
 	return s.GetToken(SfplParserPMATCH, 0)
 }
 
+func (s *TermContext) INCIDR() antlr.TerminalNode {
+	return s.GetToken(SfplParserINCIDR, 0)
+}
+
 func (s *TermContext) AllItems() []IItemsContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IItemsContext)(nil)).Elem())
 	var tst = make([]IItemsContext, len(ts))
@@ -3496,7 +4748,7 @@ func (s *TermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Term() (localctx ITermContext) {
 	localctx = NewTermContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, SfplParserRULE_term)
+	p.EnterRule(localctx, 28, SfplParserRULE_term)
 	var _la int
 
 	defer func() {
@@ -3515,64 +4767,64 @@ func (p *SfplParser) Term() (localctx ITermContext) {
 		}
 	}()
 
-	p.SetState(259)
+	p.SetState(384)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(226)
+			p.SetState(351)
 			p.Variable()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(227)
+			p.SetState(352)
 			p.Match(SfplParserNOT)
 		}
 		{
-			p.SetState(228)
+			p.SetState(353)
 			p.Term()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(229)
+			p.SetState(354)
 			p.Atom()
 		}
 		{
-			p.SetState(230)
+			p.SetState(355)
 			p.Unary_operator()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(232)
+			p.SetState(357)
 			p.Atom()
 		}
 		{
-			p.SetState(233)
+			p.SetState(358)
 			p.Binary_operator()
 		}
 		{
-			p.SetState(234)
+			p.SetState(359)
 			p.Atom()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(236)
+			p.SetState(361)
 			p.Atom()
 		}
 		{
-			p.SetState(237)
+			p.SetState(362)
 			_la = p.GetTokenStream().LA(1)
 
-			if !(_la == SfplParserIN || _la == SfplParserPMATCH) {
+			if !(((_la-39)&-(0x1f+1)) == 0 && ((1<<uint((_la-39)))&((1<<(SfplParserIN-39))|(1<<(SfplParserPMATCH-39))|(1<<(SfplParserINCIDR-39)))) != 0) {
 				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
@@ -3580,50 +4832,50 @@ func (p *SfplParser) Term() (localctx ITermContext) {
 			}
 		}
 		{
-			p.SetState(238)
+			p.SetState(363)
 			p.Match(SfplParserLPAREN)
 		}
-		p.SetState(241)
+		p.SetState(366)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case SfplParserLT, SfplParserGT, SfplParserID, SfplParserNUMBER, SfplParserPATH, SfplParserSTRING, SfplParserTAG:
 			{
-				p.SetState(239)
+				p.SetState(364)
 				p.Atom()
 			}
 
 		case SfplParserLBRACK:
 			{
-				p.SetState(240)
+				p.SetState(365)
 				p.Items()
 			}
 
 		default:
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
-		p.SetState(250)
+		p.SetState(375)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SfplParserLISTSEP {
 			{
-				p.SetState(243)
+				p.SetState(368)
 				p.Match(SfplParserLISTSEP)
 			}
-			p.SetState(246)
+			p.SetState(371)
 			p.GetErrorHandler().Sync(p)
 
 			switch p.GetTokenStream().LA(1) {
 			case SfplParserLT, SfplParserGT, SfplParserID, SfplParserNUMBER, SfplParserPATH, SfplParserSTRING, SfplParserTAG:
 				{
-					p.SetState(244)
+					p.SetState(369)
 					p.Atom()
 				}
 
 			case SfplParserLBRACK:
 				{
-					p.SetState(245)
+					p.SetState(370)
 					p.Items()
 				}
 
@@ -3631,27 +4883,27 @@ func (p *SfplParser) Term() (localctx ITermContext) {
 				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			}
 
-			p.SetState(252)
+			p.SetState(377)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(253)
+			p.SetState(378)
 			p.Match(SfplParserRPAREN)
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(255)
+			p.SetState(380)
 			p.Match(SfplParserLPAREN)
 		}
 		{
-			p.SetState(256)
+			p.SetState(381)
 			p.Expression()
 		}
 		{
-			p.SetState(257)
+			p.SetState(382)
 			p.Match(SfplParserRPAREN)
 		}
 
@@ -3769,7 +5021,7 @@ func (s *ItemsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Items() (localctx IItemsContext) {
 	localctx = NewItemsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, SfplParserRULE_items)
+	p.EnterRule(localctx, 30, SfplParserRULE_items)
 	var _la int
 
 	defer func() {
@@ -3792,53 +5044,53 @@ func (p *SfplParser) Items() (localctx IItemsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(261)
+		p.SetState(386)
 		p.Match(SfplParserLBRACK)
 	}
-	p.SetState(270)
+	p.SetState(395)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if ((_la-23)&-(0x1f+1)) == 0 && ((1<<uint((_la-23)))&((1<<(SfplParserLT-23))|(1<<(SfplParserGT-23))|(1<<(SfplParserID-23))|(1<<(SfplParserNUMBER-23))|(1<<(SfplParserPATH-23))|(1<<(SfplParserSTRING-23))|(1<<(SfplParserTAG-23)))) != 0 {
+	if ((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SfplParserLT-33))|(1<<(SfplParserGT-33))|(1<<(SfplParserID-33)))) != 0 || ((_la-65)&-(0x1f+1)) == 0 && ((1<<uint((_la-65)))&((1<<(SfplParserNUMBER-65))|(1<<(SfplParserPATH-65))|(1<<(SfplParserSTRING-65))|(1<<(SfplParserTAG-65)))) != 0 {
 		{
-			p.SetState(262)
+			p.SetState(387)
 			p.Atom()
 		}
-		p.SetState(267)
+		p.SetState(392)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 27, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(263)
+					p.SetState(388)
 					p.Match(SfplParserLISTSEP)
 				}
 				{
-					p.SetState(264)
+					p.SetState(389)
 					p.Atom()
 				}
 
 			}
-			p.SetState(269)
+			p.SetState(394)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 27, p.GetParserRuleContext())
 		}
 
 	}
-	p.SetState(273)
+	p.SetState(398)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SfplParserLISTSEP {
 		{
-			p.SetState(272)
+			p.SetState(397)
 			p.Match(SfplParserLISTSEP)
 		}
 
 	}
 	{
-		p.SetState(275)
+		p.SetState(400)
 		p.Match(SfplParserRBRACK)
 	}
 
@@ -3954,7 +5206,7 @@ func (s *ActionsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Actions() (localctx IActionsContext) {
 	localctx = NewActionsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, SfplParserRULE_actions)
+	p.EnterRule(localctx, 32, SfplParserRULE_actions)
 	var _la int
 
 	defer func() {
@@ -3977,169 +5229,2614 @@ func (p *SfplParser) Actions() (localctx IActionsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(277)
+		p.SetState(402)
 		p.Match(SfplParserLBRACK)
 	}
-	p.SetState(286)
+	p.SetState(411)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if ((_la-23)&-(0x1f+1)) == 0 && ((1<<uint((_la-23)))&((1<<(SfplParserLT-23))|(1<<(SfplParserGT-23))|(1<<(SfplParserID-23))|(1<<(SfplParserNUMBER-23))|(1<<(SfplParserPATH-23))|(1<<(SfplParserSTRING-23))|(1<<(SfplParserTAG-23)))) != 0 {
+	if ((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SfplParserLT-33))|(1<<(SfplParserGT-33))|(1<<(SfplParserID-33)))) != 0 || ((_la-65)&-(0x1f+1)) == 0 && ((1<<uint((_la-65)))&((1<<(SfplParserNUMBER-65))|(1<<(SfplParserPATH-65))|(1<<(SfplParserSTRING-65))|(1<<(SfplParserTAG-65)))) != 0 {
 		{
-			p.SetState(278)
+			p.SetState(403)
 			p.Atom()
 		}
-		p.SetState(283)
+		p.SetState(408)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 30, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(279)
+					p.SetState(404)
 					p.Match(SfplParserLISTSEP)
 				}
 				{
-					p.SetState(280)
+					p.SetState(405)
 					p.Atom()
 				}
 
 			}
-			p.SetState(285)
+			p.SetState(410)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 30, p.GetParserRuleContext())
+		}
+
+	}
+	p.SetState(414)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SfplParserLISTSEP {
+		{
+			p.SetState(413)
+			p.Match(SfplParserLISTSEP)
+		}
+
+	}
+	{
+		p.SetState(416)
+		p.Match(SfplParserRBRACK)
+	}
+
+	return localctx
+}
+
+// ITagsContext is an interface to support dynamic dispatch.
+type ITagsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTagsContext differentiates from other interfaces.
+	IsTagsContext()
+}
+
+type TagsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTagsContext() *TagsContext {
+	var p = new(TagsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_tags
+	return p
+}
+
+func (*TagsContext) IsTagsContext() {}
+
+func NewTagsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TagsContext {
+	var p = new(TagsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_tags
+
+	return p
+}
+
+func (s *TagsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TagsContext) LBRACK() antlr.TerminalNode {
+	return s.GetToken(SfplParserLBRACK, 0)
+}
+
+func (s *TagsContext) RBRACK() antlr.TerminalNode {
+	return s.GetToken(SfplParserRBRACK, 0)
+}
+
+func (s *TagsContext) AllAtom() []IAtomContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IAtomContext)(nil)).Elem())
+	var tst = make([]IAtomContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IAtomContext)
 		}
+	}
+
+	return tst
+}
+
+func (s *TagsContext) Atom(i int) IAtomContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAtomContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAtomContext)
+}
+
+func (s *TagsContext) AllLISTSEP() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserLISTSEP)
+}
+
+func (s *TagsContext) LISTSEP(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserLISTSEP, i)
+}
+
+func (s *TagsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TagsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TagsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterTags(s)
+	}
+}
+
+func (s *TagsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitTags(s)
+	}
+}
+
+func (s *TagsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitTags(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Tags() (localctx ITagsContext) {
+	localctx = NewTagsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, SfplParserRULE_tags)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(418)
+		p.Match(SfplParserLBRACK)
+	}
+	p.SetState(427)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if ((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SfplParserLT-33))|(1<<(SfplParserGT-33))|(1<<(SfplParserID-33)))) != 0 || ((_la-65)&-(0x1f+1)) == 0 && ((1<<uint((_la-65)))&((1<<(SfplParserNUMBER-65))|(1<<(SfplParserPATH-65))|(1<<(SfplParserSTRING-65))|(1<<(SfplParserTAG-65)))) != 0 {
+		{
+			p.SetState(419)
+			p.Atom()
+		}
+		p.SetState(424)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext())
+
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(420)
+					p.Match(SfplParserLISTSEP)
+				}
+				{
+					p.SetState(421)
+					p.Atom()
+				}
+
+			}
+			p.SetState(426)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext())
+		}
+
+	}
+	p.SetState(430)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SfplParserLISTSEP {
+		{
+			p.SetState(429)
+			p.Match(SfplParserLISTSEP)
+		}
+
+	}
+	{
+		p.SetState(432)
+		p.Match(SfplParserRBRACK)
+	}
+
+	return localctx
+}
+
+// IPrefilterContext is an interface to support dynamic dispatch.
+type IPrefilterContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsPrefilterContext differentiates from other interfaces.
+	IsPrefilterContext()
+}
+
+type PrefilterContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPrefilterContext() *PrefilterContext {
+	var p = new(PrefilterContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_prefilter
+	return p
+}
+
+func (*PrefilterContext) IsPrefilterContext() {}
+
+func NewPrefilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrefilterContext {
+	var p = new(PrefilterContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_prefilter
+
+	return p
+}
+
+func (s *PrefilterContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PrefilterContext) Items() IItemsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IItemsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IItemsContext)
+}
+
+func (s *PrefilterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrefilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PrefilterContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterPrefilter(s)
+	}
+}
+
+func (s *PrefilterContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitPrefilter(s)
+	}
+}
+
+func (s *PrefilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitPrefilter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Prefilter() (localctx IPrefilterContext) {
+	localctx = NewPrefilterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, SfplParserRULE_prefilter)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(434)
+		p.Items()
+	}
+
+	return localctx
+}
+
+// ISequenceContext is an interface to support dynamic dispatch.
+type ISequenceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSequenceContext differentiates from other interfaces.
+	IsSequenceContext()
+}
+
+type SequenceContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySequenceContext() *SequenceContext {
+	var p = new(SequenceContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_sequence
+	return p
+}
+
+func (*SequenceContext) IsSequenceContext() {}
+
+func NewSequenceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SequenceContext {
+	var p = new(SequenceContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_sequence
+
+	return p
+}
+
+func (s *SequenceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SequenceContext) AllDECL() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserDECL)
+}
+
+func (s *SequenceContext) DECL(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserDECL, i)
+}
+
+func (s *SequenceContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SequenceContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SequenceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SequenceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SequenceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterSequence(s)
+	}
+}
+
+func (s *SequenceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitSequence(s)
+	}
+}
+
+func (s *SequenceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitSequence(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Sequence() (localctx ISequenceContext) {
+	localctx = NewSequenceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, SfplParserRULE_sequence)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(438)
+	p.GetErrorHandler().Sync(p)
+	_alt = 1
+	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		switch _alt {
+		case 1:
+			{
+				p.SetState(436)
+				p.Match(SfplParserDECL)
+			}
+			{
+				p.SetState(437)
+				p.Expression()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+		p.SetState(440)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// IGroupbyContext is an interface to support dynamic dispatch.
+type IGroupbyContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsGroupbyContext differentiates from other interfaces.
+	IsGroupbyContext()
+}
+
+type GroupbyContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGroupbyContext() *GroupbyContext {
+	var p = new(GroupbyContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_groupby
+	return p
+}
+
+func (*GroupbyContext) IsGroupbyContext() {}
+
+func NewGroupbyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupbyContext {
+	var p = new(GroupbyContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_groupby
+
+	return p
+}
+
+func (s *GroupbyContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GroupbyContext) Items() IItemsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IItemsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IItemsContext)
+}
+
+func (s *GroupbyContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GroupbyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GroupbyContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterGroupby(s)
+	}
+}
+
+func (s *GroupbyContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitGroupby(s)
+	}
+}
+
+func (s *GroupbyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitGroupby(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Groupby() (localctx IGroupbyContext) {
+	localctx = NewGroupbyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, SfplParserRULE_groupby)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(442)
+		p.Items()
+	}
+
+	return localctx
+}
+
+// IWindowContext is an interface to support dynamic dispatch.
+type IWindowContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsWindowContext differentiates from other interfaces.
+	IsWindowContext()
+}
+
+type WindowContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyWindowContext() *WindowContext {
+	var p = new(WindowContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_window
+	return p
+}
+
+func (*WindowContext) IsWindowContext() {}
+
+func NewWindowContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WindowContext {
+	var p = new(WindowContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_window
+
+	return p
+}
+
+func (s *WindowContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *WindowContext) Atom() IAtomContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAtomContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAtomContext)
+}
+
+func (s *WindowContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WindowContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *WindowContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterWindow(s)
+	}
+}
+
+func (s *WindowContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitWindow(s)
+	}
+}
+
+func (s *WindowContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitWindow(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Window() (localctx IWindowContext) {
+	localctx = NewWindowContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, SfplParserRULE_window)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(444)
+		p.Atom()
+	}
+
+	return localctx
+}
+
+// IThresholdContext is an interface to support dynamic dispatch.
+type IThresholdContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsThresholdContext differentiates from other interfaces.
+	IsThresholdContext()
+}
+
+type ThresholdContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyThresholdContext() *ThresholdContext {
+	var p = new(ThresholdContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_threshold
+	return p
+}
+
+func (*ThresholdContext) IsThresholdContext() {}
+
+func NewThresholdContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ThresholdContext {
+	var p = new(ThresholdContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_threshold
+
+	return p
+}
+
+func (s *ThresholdContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ThresholdContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(SfplParserLBRACE, 0)
+}
+
+func (s *ThresholdContext) AllThresholdattr() []IThresholdattrContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IThresholdattrContext)(nil)).Elem())
+	var tst = make([]IThresholdattrContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IThresholdattrContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ThresholdContext) Thresholdattr(i int) IThresholdattrContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IThresholdattrContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IThresholdattrContext)
+}
+
+func (s *ThresholdContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(SfplParserRBRACE, 0)
+}
+
+func (s *ThresholdContext) AllLISTSEP() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserLISTSEP)
+}
+
+func (s *ThresholdContext) LISTSEP(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserLISTSEP, i)
+}
+
+func (s *ThresholdContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ThresholdContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ThresholdContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterThreshold(s)
+	}
+}
+
+func (s *ThresholdContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitThreshold(s)
+	}
+}
+
+func (s *ThresholdContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitThreshold(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Threshold() (localctx IThresholdContext) {
+	localctx = NewThresholdContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, SfplParserRULE_threshold)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.SetState(462)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SfplParserLBRACE:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(446)
+			p.Match(SfplParserLBRACE)
+		}
+		{
+			p.SetState(447)
+			p.Thresholdattr()
+		}
+		p.SetState(452)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == SfplParserLISTSEP {
+			{
+				p.SetState(448)
+				p.Match(SfplParserLISTSEP)
+			}
+			{
+				p.SetState(449)
+				p.Thresholdattr()
+			}
+
+			p.SetState(454)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(455)
+			p.Match(SfplParserRBRACE)
+		}
+
+	case SfplParserGROUPBY, SfplParserWINDOW, SfplParserID:
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(458)
+		p.GetErrorHandler().Sync(p)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				{
+					p.SetState(457)
+					p.Thresholdattr()
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+			p.SetState(460)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 38, p.GetParserRuleContext())
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ISuppressContext is an interface to support dynamic dispatch.
+type ISuppressContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSuppressContext differentiates from other interfaces.
+	IsSuppressContext()
+}
+
+type SuppressContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySuppressContext() *SuppressContext {
+	var p = new(SuppressContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_suppress
+	return p
+}
+
+func (*SuppressContext) IsSuppressContext() {}
+
+func NewSuppressContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SuppressContext {
+	var p = new(SuppressContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_suppress
+
+	return p
+}
+
+func (s *SuppressContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SuppressContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(SfplParserLBRACE, 0)
+}
+
+func (s *SuppressContext) AllThresholdattr() []IThresholdattrContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IThresholdattrContext)(nil)).Elem())
+	var tst = make([]IThresholdattrContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IThresholdattrContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SuppressContext) Thresholdattr(i int) IThresholdattrContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IThresholdattrContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IThresholdattrContext)
+}
+
+func (s *SuppressContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(SfplParserRBRACE, 0)
+}
+
+func (s *SuppressContext) AllLISTSEP() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserLISTSEP)
+}
+
+func (s *SuppressContext) LISTSEP(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserLISTSEP, i)
+}
+
+func (s *SuppressContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SuppressContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SuppressContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterSuppress(s)
+	}
+}
+
+func (s *SuppressContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitSuppress(s)
+	}
+}
+
+func (s *SuppressContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitSuppress(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Suppress() (localctx ISuppressContext) {
+	localctx = NewSuppressContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, SfplParserRULE_suppress)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.SetState(480)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SfplParserLBRACE:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(464)
+			p.Match(SfplParserLBRACE)
+		}
+		{
+			p.SetState(465)
+			p.Thresholdattr()
+		}
+		p.SetState(470)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == SfplParserLISTSEP {
+			{
+				p.SetState(466)
+				p.Match(SfplParserLISTSEP)
+			}
+			{
+				p.SetState(467)
+				p.Thresholdattr()
+			}
+
+			p.SetState(472)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(473)
+			p.Match(SfplParserRBRACE)
+		}
+
+	case SfplParserGROUPBY, SfplParserWINDOW, SfplParserID:
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(476)
+		p.GetErrorHandler().Sync(p)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				{
+					p.SetState(475)
+					p.Thresholdattr()
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+			p.SetState(478)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 41, p.GetParserRuleContext())
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ILookupContext is an interface to support dynamic dispatch.
+type ILookupContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsLookupContext differentiates from other interfaces.
+	IsLookupContext()
+}
+
+type LookupContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLookupContext() *LookupContext {
+	var p = new(LookupContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_lookup
+	return p
+}
+
+func (*LookupContext) IsLookupContext() {}
+
+func NewLookupContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LookupContext {
+	var p = new(LookupContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_lookup
+
+	return p
+}
+
+func (s *LookupContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LookupContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(SfplParserLBRACE, 0)
+}
+
+func (s *LookupContext) AllThresholdattr() []IThresholdattrContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IThresholdattrContext)(nil)).Elem())
+	var tst = make([]IThresholdattrContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IThresholdattrContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *LookupContext) Thresholdattr(i int) IThresholdattrContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IThresholdattrContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IThresholdattrContext)
+}
+
+func (s *LookupContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(SfplParserRBRACE, 0)
+}
+
+func (s *LookupContext) AllLISTSEP() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserLISTSEP)
+}
+
+func (s *LookupContext) LISTSEP(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserLISTSEP, i)
+}
+
+func (s *LookupContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LookupContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LookupContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterLookup(s)
+	}
+}
+
+func (s *LookupContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitLookup(s)
+	}
+}
+
+func (s *LookupContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitLookup(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Lookup() (localctx ILookupContext) {
+	localctx = NewLookupContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, SfplParserRULE_lookup)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(498)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SfplParserLBRACE:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(482)
+			p.Match(SfplParserLBRACE)
+		}
+		{
+			p.SetState(483)
+			p.Thresholdattr()
+		}
+		p.SetState(488)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == SfplParserLISTSEP {
+			{
+				p.SetState(484)
+				p.Match(SfplParserLISTSEP)
+			}
+			{
+				p.SetState(485)
+				p.Thresholdattr()
+			}
+
+			p.SetState(490)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(491)
+			p.Match(SfplParserRBRACE)
+		}
+
+	case SfplParserGROUPBY, SfplParserWINDOW, SfplParserID:
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(494)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == SfplParserGROUPBY || _la == SfplParserWINDOW || _la == SfplParserID {
+			{
+				p.SetState(493)
+				p.Thresholdattr()
+			}
+
+			p.SetState(496)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IThresholdattrContext is an interface to support dynamic dispatch.
+type IThresholdattrContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsThresholdattrContext differentiates from other interfaces.
+	IsThresholdattrContext()
+}
+
+type ThresholdattrContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyThresholdattrContext() *ThresholdattrContext {
+	var p = new(ThresholdattrContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_thresholdattr
+	return p
+}
+
+func (*ThresholdattrContext) IsThresholdattrContext() {}
+
+func NewThresholdattrContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ThresholdattrContext {
+	var p = new(ThresholdattrContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_thresholdattr
+
+	return p
+}
+
+func (s *ThresholdattrContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ThresholdattrContext) DEF() antlr.TerminalNode {
+	return s.GetToken(SfplParserDEF, 0)
+}
+
+func (s *ThresholdattrContext) ID() antlr.TerminalNode {
+	return s.GetToken(SfplParserID, 0)
+}
+
+func (s *ThresholdattrContext) WINDOW() antlr.TerminalNode {
+	return s.GetToken(SfplParserWINDOW, 0)
+}
+
+func (s *ThresholdattrContext) GROUPBY() antlr.TerminalNode {
+	return s.GetToken(SfplParserGROUPBY, 0)
+}
+
+func (s *ThresholdattrContext) Atom() IAtomContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAtomContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAtomContext)
+}
+
+func (s *ThresholdattrContext) Items() IItemsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IItemsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IItemsContext)
+}
+
+func (s *ThresholdattrContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ThresholdattrContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ThresholdattrContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterThresholdattr(s)
+	}
+}
+
+func (s *ThresholdattrContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitThresholdattr(s)
+	}
+}
+
+func (s *ThresholdattrContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitThresholdattr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Thresholdattr() (localctx IThresholdattrContext) {
+	localctx = NewThresholdattrContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, SfplParserRULE_thresholdattr)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(500)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == SfplParserGROUPBY || _la == SfplParserWINDOW || _la == SfplParserID) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	{
+		p.SetState(501)
+		p.Match(SfplParserDEF)
+	}
+	p.SetState(504)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SfplParserLT, SfplParserGT, SfplParserID, SfplParserNUMBER, SfplParserPATH, SfplParserSTRING, SfplParserTAG:
+		{
+			p.SetState(502)
+			p.Atom()
+		}
+
+	case SfplParserLBRACK:
+		{
+			p.SetState(503)
+			p.Items()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IExceptionsContext is an interface to support dynamic dispatch.
+type IExceptionsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExceptionsContext differentiates from other interfaces.
+	IsExceptionsContext()
+}
+
+type ExceptionsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExceptionsContext() *ExceptionsContext {
+	var p = new(ExceptionsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_exceptions
+	return p
+}
+
+func (*ExceptionsContext) IsExceptionsContext() {}
+
+func NewExceptionsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExceptionsContext {
+	var p = new(ExceptionsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_exceptions
+
+	return p
+}
+
+func (s *ExceptionsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExceptionsContext) AllDECL() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserDECL)
+}
+
+func (s *ExceptionsContext) DECL(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserDECL, i)
+}
+
+func (s *ExceptionsContext) AllException() []IExceptionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExceptionContext)(nil)).Elem())
+	var tst = make([]IExceptionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExceptionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ExceptionsContext) Exception(i int) IExceptionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExceptionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExceptionContext)
+}
+
+func (s *ExceptionsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExceptionsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExceptionsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterExceptions(s)
+	}
+}
+
+func (s *ExceptionsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitExceptions(s)
+	}
+}
+
+func (s *ExceptionsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitExceptions(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Exceptions() (localctx IExceptionsContext) {
+	localctx = NewExceptionsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, SfplParserRULE_exceptions)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(508)
+	p.GetErrorHandler().Sync(p)
+	_alt = 1
+	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		switch _alt {
+		case 1:
+			{
+				p.SetState(506)
+				p.Match(SfplParserDECL)
+			}
+			{
+				p.SetState(507)
+				p.Exception()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+		p.SetState(510)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// IExceptionContext is an interface to support dynamic dispatch.
+type IExceptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExceptionContext differentiates from other interfaces.
+	IsExceptionContext()
+}
+
+type ExceptionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExceptionContext() *ExceptionContext {
+	var p = new(ExceptionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_exception
+	return p
+}
+
+func (*ExceptionContext) IsExceptionContext() {}
+
+func NewExceptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExceptionContext {
+	var p = new(ExceptionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_exception
+
+	return p
+}
+
+func (s *ExceptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExceptionContext) NAME() antlr.TerminalNode {
+	return s.GetToken(SfplParserNAME, 0)
+}
+
+func (s *ExceptionContext) AllDEF() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserDEF)
+}
+
+func (s *ExceptionContext) DEF(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserDEF, i)
+}
+
+func (s *ExceptionContext) AllAtom() []IAtomContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IAtomContext)(nil)).Elem())
+	var tst = make([]IAtomContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IAtomContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ExceptionContext) Atom(i int) IAtomContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAtomContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAtomContext)
+}
+
+func (s *ExceptionContext) AllFIELDS() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserFIELDS)
+}
+
+func (s *ExceptionContext) FIELDS(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserFIELDS, i)
+}
+
+func (s *ExceptionContext) AllCOMPS() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserCOMPS)
+}
+
+func (s *ExceptionContext) COMPS(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserCOMPS, i)
+}
+
+func (s *ExceptionContext) AllVALUES() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserVALUES)
+}
+
+func (s *ExceptionContext) VALUES(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserVALUES, i)
+}
+
+func (s *ExceptionContext) AllExvalues() []IExvaluesContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExvaluesContext)(nil)).Elem())
+	var tst = make([]IExvaluesContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExvaluesContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ExceptionContext) Exvalues(i int) IExvaluesContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExvaluesContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExvaluesContext)
+}
+
+func (s *ExceptionContext) AllItems() []IItemsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IItemsContext)(nil)).Elem())
+	var tst = make([]IItemsContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IItemsContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ExceptionContext) Items(i int) IItemsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IItemsContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IItemsContext)
+}
+
+func (s *ExceptionContext) AllComps() []ICompsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ICompsContext)(nil)).Elem())
+	var tst = make([]ICompsContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ICompsContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ExceptionContext) Comps(i int) ICompsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICompsContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompsContext)
+}
+
+func (s *ExceptionContext) AllCompop() []ICompopContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ICompopContext)(nil)).Elem())
+	var tst = make([]ICompopContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ICompopContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ExceptionContext) Compop(i int) ICompopContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICompopContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompopContext)
+}
+
+func (s *ExceptionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExceptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExceptionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterException(s)
+	}
+}
+
+func (s *ExceptionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitException(s)
+	}
+}
+
+func (s *ExceptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitException(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Exception() (localctx IExceptionContext) {
+	localctx = NewExceptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, SfplParserRULE_exception)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(512)
+		p.Match(SfplParserNAME)
+	}
+	{
+		p.SetState(513)
+		p.Match(SfplParserDEF)
+	}
+	{
+		p.SetState(514)
+		p.Atom()
+	}
+	p.SetState(532)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SfplParserFIELDS)|(1<<SfplParserCOMPS)|(1<<SfplParserVALUES))) != 0 {
+		p.SetState(530)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case SfplParserFIELDS:
+			{
+				p.SetState(515)
+				p.Match(SfplParserFIELDS)
+			}
+			{
+				p.SetState(516)
+				p.Match(SfplParserDEF)
+			}
+			p.SetState(519)
+			p.GetErrorHandler().Sync(p)
+
+			switch p.GetTokenStream().LA(1) {
+			case SfplParserLBRACK:
+				{
+					p.SetState(517)
+					p.Items()
+				}
+
+			case SfplParserLT, SfplParserGT, SfplParserID, SfplParserNUMBER, SfplParserPATH, SfplParserSTRING, SfplParserTAG:
+				{
+					p.SetState(518)
+					p.Atom()
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+		case SfplParserCOMPS:
+			{
+				p.SetState(521)
+				p.Match(SfplParserCOMPS)
+			}
+			{
+				p.SetState(522)
+				p.Match(SfplParserDEF)
+			}
+			p.SetState(525)
+			p.GetErrorHandler().Sync(p)
+
+			switch p.GetTokenStream().LA(1) {
+			case SfplParserLBRACK:
+				{
+					p.SetState(523)
+					p.Comps()
+				}
+
+			case SfplParserLT, SfplParserLE, SfplParserGT, SfplParserGE, SfplParserEQ, SfplParserNEQ, SfplParserIN, SfplParserCONTAINS, SfplParserICONTAINS, SfplParserSTARTSWITH, SfplParserENDSWITH, SfplParserGLOB, SfplParserREGEX, SfplParserPMATCH, SfplParserINCIDR:
+				{
+					p.SetState(524)
+					p.Compop()
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+		case SfplParserVALUES:
+			{
+				p.SetState(527)
+				p.Match(SfplParserVALUES)
+			}
+			{
+				p.SetState(528)
+				p.Match(SfplParserDEF)
+			}
+			{
+				p.SetState(529)
+				p.Exvalues()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+		p.SetState(534)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// ICompsContext is an interface to support dynamic dispatch.
+type ICompsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCompsContext differentiates from other interfaces.
+	IsCompsContext()
+}
+
+type CompsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCompsContext() *CompsContext {
+	var p = new(CompsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_comps
+	return p
+}
+
+func (*CompsContext) IsCompsContext() {}
+
+func NewCompsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompsContext {
+	var p = new(CompsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_comps
+
+	return p
+}
+
+func (s *CompsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CompsContext) LBRACK() antlr.TerminalNode {
+	return s.GetToken(SfplParserLBRACK, 0)
+}
+
+func (s *CompsContext) AllCompop() []ICompopContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ICompopContext)(nil)).Elem())
+	var tst = make([]ICompopContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ICompopContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *CompsContext) Compop(i int) ICompopContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICompopContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompopContext)
+}
+
+func (s *CompsContext) RBRACK() antlr.TerminalNode {
+	return s.GetToken(SfplParserRBRACK, 0)
+}
+
+func (s *CompsContext) AllLISTSEP() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserLISTSEP)
+}
+
+func (s *CompsContext) LISTSEP(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserLISTSEP, i)
+}
+
+func (s *CompsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CompsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CompsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterComps(s)
+	}
+}
+
+func (s *CompsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitComps(s)
+	}
+}
+
+func (s *CompsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitComps(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Comps() (localctx ICompsContext) {
+	localctx = NewCompsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, SfplParserRULE_comps)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(535)
+		p.Match(SfplParserLBRACK)
+	}
+	{
+		p.SetState(536)
+		p.Compop()
+	}
+	p.SetState(541)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SfplParserLISTSEP {
+		{
+			p.SetState(537)
+			p.Match(SfplParserLISTSEP)
+		}
+		{
+			p.SetState(538)
+			p.Compop()
+		}
+
+		p.SetState(543)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(544)
+		p.Match(SfplParserRBRACK)
+	}
+
+	return localctx
+}
+
+// ICompopContext is an interface to support dynamic dispatch.
+type ICompopContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCompopContext differentiates from other interfaces.
+	IsCompopContext()
+}
+
+type CompopContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCompopContext() *CompopContext {
+	var p = new(CompopContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_compop
+	return p
+}
+
+func (*CompopContext) IsCompopContext() {}
+
+func NewCompopContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompopContext {
+	var p = new(CompopContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_compop
+
+	return p
+}
+
+func (s *CompopContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CompopContext) Binary_operator() IBinary_operatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBinary_operatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBinary_operatorContext)
+}
+
+func (s *CompopContext) IN() antlr.TerminalNode {
+	return s.GetToken(SfplParserIN, 0)
+}
+
+func (s *CompopContext) PMATCH() antlr.TerminalNode {
+	return s.GetToken(SfplParserPMATCH, 0)
+}
+
+func (s *CompopContext) INCIDR() antlr.TerminalNode {
+	return s.GetToken(SfplParserINCIDR, 0)
+}
+
+func (s *CompopContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CompopContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CompopContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterCompop(s)
+	}
+}
+
+func (s *CompopContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitCompop(s)
+	}
+}
+
+func (s *CompopContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitCompop(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Compop() (localctx ICompopContext) {
+	localctx = NewCompopContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, SfplParserRULE_compop)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(550)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SfplParserLT, SfplParserLE, SfplParserGT, SfplParserGE, SfplParserEQ, SfplParserNEQ, SfplParserCONTAINS, SfplParserICONTAINS, SfplParserSTARTSWITH, SfplParserENDSWITH, SfplParserGLOB, SfplParserREGEX:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(546)
+			p.Binary_operator()
+		}
+
+	case SfplParserIN:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(547)
+			p.Match(SfplParserIN)
+		}
+
+	case SfplParserPMATCH:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(548)
+			p.Match(SfplParserPMATCH)
+		}
+
+	case SfplParserINCIDR:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(549)
+			p.Match(SfplParserINCIDR)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IExvaluesContext is an interface to support dynamic dispatch.
+type IExvaluesContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExvaluesContext differentiates from other interfaces.
+	IsExvaluesContext()
+}
+
+type ExvaluesContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExvaluesContext() *ExvaluesContext {
+	var p = new(ExvaluesContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_exvalues
+	return p
+}
+
+func (*ExvaluesContext) IsExvaluesContext() {}
+
+func NewExvaluesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExvaluesContext {
+	var p = new(ExvaluesContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_exvalues
+
+	return p
+}
+
+func (s *ExvaluesContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExvaluesContext) LBRACK() antlr.TerminalNode {
+	return s.GetToken(SfplParserLBRACK, 0)
+}
+
+func (s *ExvaluesContext) RBRACK() antlr.TerminalNode {
+	return s.GetToken(SfplParserRBRACK, 0)
+}
+
+func (s *ExvaluesContext) AllExtuple() []IExtupleContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExtupleContext)(nil)).Elem())
+	var tst = make([]IExtupleContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExtupleContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ExvaluesContext) Extuple(i int) IExtupleContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExtupleContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExtupleContext)
+}
+
+func (s *ExvaluesContext) AllLISTSEP() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserLISTSEP)
+}
+
+func (s *ExvaluesContext) LISTSEP(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserLISTSEP, i)
+}
+
+func (s *ExvaluesContext) AllDECL() []antlr.TerminalNode {
+	return s.GetTokens(SfplParserDECL)
+}
+
+func (s *ExvaluesContext) DECL(i int) antlr.TerminalNode {
+	return s.GetToken(SfplParserDECL, i)
+}
+
+func (s *ExvaluesContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExvaluesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExvaluesContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterExvalues(s)
+	}
+}
+
+func (s *ExvaluesContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitExvalues(s)
+	}
+}
+
+func (s *ExvaluesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitExvalues(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Exvalues() (localctx IExvaluesContext) {
+	localctx = NewExvaluesContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, SfplParserRULE_exvalues)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.SetState(573)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SfplParserLBRACK:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(552)
+			p.Match(SfplParserLBRACK)
+		}
+		p.SetState(561)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if ((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SfplParserLT-33))|(1<<(SfplParserGT-33))|(1<<(SfplParserLBRACK-33))|(1<<(SfplParserID-33)))) != 0 || ((_la-65)&-(0x1f+1)) == 0 && ((1<<uint((_la-65)))&((1<<(SfplParserNUMBER-65))|(1<<(SfplParserPATH-65))|(1<<(SfplParserSTRING-65))|(1<<(SfplParserTAG-65)))) != 0 {
+			{
+				p.SetState(553)
+				p.Extuple()
+			}
+			p.SetState(558)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext())
+
+			for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+				if _alt == 1 {
+					{
+						p.SetState(554)
+						p.Match(SfplParserLISTSEP)
+					}
+					{
+						p.SetState(555)
+						p.Extuple()
+					}
+
+				}
+				p.SetState(560)
+				p.GetErrorHandler().Sync(p)
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext())
+			}
+
+		}
+		p.SetState(564)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SfplParserLISTSEP {
+			{
+				p.SetState(563)
+				p.Match(SfplParserLISTSEP)
+			}
+
+		}
+		{
+			p.SetState(566)
+			p.Match(SfplParserRBRACK)
+		}
+
+	case SfplParserDECL:
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(569)
+		p.GetErrorHandler().Sync(p)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				{
+					p.SetState(567)
+					p.Match(SfplParserDECL)
+				}
+				{
+					p.SetState(568)
+					p.Extuple()
+				}
 
-	}
-	p.SetState(289)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
 
-	if _la == SfplParserLISTSEP {
-		{
-			p.SetState(288)
-			p.Match(SfplParserLISTSEP)
+			p.SetState(571)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext())
 		}
 
-	}
-	{
-		p.SetState(291)
-		p.Match(SfplParserRBRACK)
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// ITagsContext is an interface to support dynamic dispatch.
-type ITagsContext interface {
+// IExtupleContext is an interface to support dynamic dispatch.
+type IExtupleContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTagsContext differentiates from other interfaces.
-	IsTagsContext()
+	// IsExtupleContext differentiates from other interfaces.
+	IsExtupleContext()
 }
 
-type TagsContext struct {
+type ExtupleContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTagsContext() *TagsContext {
-	var p = new(TagsContext)
+func NewEmptyExtupleContext() *ExtupleContext {
+	var p = new(ExtupleContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SfplParserRULE_tags
+	p.RuleIndex = SfplParserRULE_extuple
 	return p
 }
 
-func (*TagsContext) IsTagsContext() {}
+func (*ExtupleContext) IsExtupleContext() {}
 
-func NewTagsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TagsContext {
-	var p = new(TagsContext)
+func NewExtupleContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExtupleContext {
+	var p = new(ExtupleContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SfplParserRULE_tags
+	p.RuleIndex = SfplParserRULE_extuple
 
 	return p
 }
 
-func (s *TagsContext) GetParser() antlr.Parser { return s.parser }
+func (s *ExtupleContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TagsContext) LBRACK() antlr.TerminalNode {
+func (s *ExtupleContext) LBRACK() antlr.TerminalNode {
 	return s.GetToken(SfplParserLBRACK, 0)
 }
 
-func (s *TagsContext) RBRACK() antlr.TerminalNode {
+func (s *ExtupleContext) RBRACK() antlr.TerminalNode {
 	return s.GetToken(SfplParserRBRACK, 0)
 }
 
-func (s *TagsContext) AllAtom() []IAtomContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IAtomContext)(nil)).Elem())
-	var tst = make([]IAtomContext, len(ts))
+func (s *ExtupleContext) AllExvalue() []IExvalueContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExvalueContext)(nil)).Elem())
+	var tst = make([]IExvalueContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IAtomContext)
+			tst[i] = t.(IExvalueContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *TagsContext) Atom(i int) IAtomContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAtomContext)(nil)).Elem(), i)
+func (s *ExtupleContext) Exvalue(i int) IExvalueContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExvalueContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IAtomContext)
+	return t.(IExvalueContext)
 }
 
-func (s *TagsContext) AllLISTSEP() []antlr.TerminalNode {
+func (s *ExtupleContext) AllLISTSEP() []antlr.TerminalNode {
 	return s.GetTokens(SfplParserLISTSEP)
 }
 
-func (s *TagsContext) LISTSEP(i int) antlr.TerminalNode {
+func (s *ExtupleContext) LISTSEP(i int) antlr.TerminalNode {
 	return s.GetToken(SfplParserLISTSEP, i)
 }
 
-func (s *TagsContext) GetRuleContext() antlr.RuleContext {
+func (s *ExtupleContext) Atom() IAtomContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAtomContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAtomContext)
+}
+
+func (s *ExtupleContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TagsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExtupleContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TagsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ExtupleContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SfplListener); ok {
-		listenerT.EnterTags(s)
+		listenerT.EnterExtuple(s)
 	}
 }
 
-func (s *TagsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ExtupleContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SfplListener); ok {
-		listenerT.ExitTags(s)
+		listenerT.ExitExtuple(s)
 	}
 }
 
-func (s *TagsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ExtupleContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SfplVisitor:
-		return t.VisitTags(s)
+		return t.VisitExtuple(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SfplParser) Tags() (localctx ITagsContext) {
-	localctx = NewTagsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, SfplParserRULE_tags)
+func (p *SfplParser) Extuple() (localctx IExtupleContext) {
+	localctx = NewExtupleContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, SfplParserRULE_extuple)
 	var _la int
 
 	defer func() {
@@ -4160,100 +7857,126 @@ func (p *SfplParser) Tags() (localctx ITagsContext) {
 
 	var _alt int
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(293)
-		p.Match(SfplParserLBRACK)
-	}
-	p.SetState(302)
+	p.SetState(591)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	if ((_la-23)&-(0x1f+1)) == 0 && ((1<<uint((_la-23)))&((1<<(SfplParserLT-23))|(1<<(SfplParserGT-23))|(1<<(SfplParserID-23))|(1<<(SfplParserNUMBER-23))|(1<<(SfplParserPATH-23))|(1<<(SfplParserSTRING-23))|(1<<(SfplParserTAG-23)))) != 0 {
+	switch p.GetTokenStream().LA(1) {
+	case SfplParserLBRACK:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(294)
-			p.Atom()
+			p.SetState(575)
+			p.Match(SfplParserLBRACK)
 		}
-		p.SetState(299)
+		p.SetState(584)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext())
-
-		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			if _alt == 1 {
-				{
-					p.SetState(295)
-					p.Match(SfplParserLISTSEP)
-				}
-				{
-					p.SetState(296)
-					p.Atom()
-				}
+		_la = p.GetTokenStream().LA(1)
 
+		if ((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SfplParserLT-33))|(1<<(SfplParserGT-33))|(1<<(SfplParserLBRACK-33))|(1<<(SfplParserID-33)))) != 0 || ((_la-65)&-(0x1f+1)) == 0 && ((1<<uint((_la-65)))&((1<<(SfplParserNUMBER-65))|(1<<(SfplParserPATH-65))|(1<<(SfplParserSTRING-65))|(1<<(SfplParserTAG-65)))) != 0 {
+			{
+				p.SetState(576)
+				p.Exvalue()
 			}
-			p.SetState(301)
+			p.SetState(581)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
+
+			for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+				if _alt == 1 {
+					{
+						p.SetState(577)
+						p.Match(SfplParserLISTSEP)
+					}
+					{
+						p.SetState(578)
+						p.Exvalue()
+					}
+
+				}
+				p.SetState(583)
+				p.GetErrorHandler().Sync(p)
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
+			}
+
 		}
+		p.SetState(587)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-	}
-	p.SetState(305)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+		if _la == SfplParserLISTSEP {
+			{
+				p.SetState(586)
+				p.Match(SfplParserLISTSEP)
+			}
 
-	if _la == SfplParserLISTSEP {
+		}
 		{
-			p.SetState(304)
-			p.Match(SfplParserLISTSEP)
+			p.SetState(589)
+			p.Match(SfplParserRBRACK)
 		}
 
-	}
-	{
-		p.SetState(307)
-		p.Match(SfplParserRBRACK)
+	case SfplParserLT, SfplParserGT, SfplParserID, SfplParserNUMBER, SfplParserPATH, SfplParserSTRING, SfplParserTAG:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(590)
+			p.Atom()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IPrefilterContext is an interface to support dynamic dispatch.
-type IPrefilterContext interface {
+// IExvalueContext is an interface to support dynamic dispatch.
+type IExvalueContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsPrefilterContext differentiates from other interfaces.
-	IsPrefilterContext()
+	// IsExvalueContext differentiates from other interfaces.
+	IsExvalueContext()
 }
 
-type PrefilterContext struct {
+type ExvalueContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPrefilterContext() *PrefilterContext {
-	var p = new(PrefilterContext)
+func NewEmptyExvalueContext() *ExvalueContext {
+	var p = new(ExvalueContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SfplParserRULE_prefilter
+	p.RuleIndex = SfplParserRULE_exvalue
 	return p
 }
 
-func (*PrefilterContext) IsPrefilterContext() {}
+func (*ExvalueContext) IsExvalueContext() {}
 
-func NewPrefilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrefilterContext {
-	var p = new(PrefilterContext)
+func NewExvalueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExvalueContext {
+	var p = new(ExvalueContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SfplParserRULE_prefilter
+	p.RuleIndex = SfplParserRULE_exvalue
 
 	return p
 }
 
-func (s *PrefilterContext) GetParser() antlr.Parser { return s.parser }
+func (s *ExvalueContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PrefilterContext) Items() IItemsContext {
+func (s *ExvalueContext) Atom() IAtomContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAtomContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAtomContext)
+}
+
+func (s *ExvalueContext) Items() IItemsContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IItemsContext)(nil)).Elem(), 0)
 
 	if t == nil {
@@ -4263,39 +7986,39 @@ func (s *PrefilterContext) Items() IItemsContext {
 	return t.(IItemsContext)
 }
 
-func (s *PrefilterContext) GetRuleContext() antlr.RuleContext {
+func (s *ExvalueContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PrefilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExvalueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PrefilterContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ExvalueContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SfplListener); ok {
-		listenerT.EnterPrefilter(s)
+		listenerT.EnterExvalue(s)
 	}
 }
 
-func (s *PrefilterContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ExvalueContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SfplListener); ok {
-		listenerT.ExitPrefilter(s)
+		listenerT.ExitExvalue(s)
 	}
 }
 
-func (s *PrefilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ExvalueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SfplVisitor:
-		return t.VisitPrefilter(s)
+		return t.VisitExvalue(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SfplParser) Prefilter() (localctx IPrefilterContext) {
-	localctx = NewPrefilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, SfplParserRULE_prefilter)
+func (p *SfplParser) Exvalue() (localctx IExvalueContext) {
+	localctx = NewExvalueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, SfplParserRULE_exvalue)
 
 	defer func() {
 		p.ExitRule()
@@ -4313,10 +8036,26 @@ func (p *SfplParser) Prefilter() (localctx IPrefilterContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(309)
-		p.Items()
+	p.SetState(595)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SfplParserLT, SfplParserGT, SfplParserID, SfplParserNUMBER, SfplParserPATH, SfplParserSTRING, SfplParserTAG:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(593)
+			p.Atom()
+		}
+
+	case SfplParserLBRACK:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(594)
+			p.Items()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -4396,7 +8135,7 @@ func (s *SeverityContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Severity() (localctx ISeverityContext) {
 	localctx = NewSeverityContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, SfplParserRULE_severity)
+	p.EnterRule(localctx, 66, SfplParserRULE_severity)
 
 	defer func() {
 		p.ExitRule()
@@ -4416,7 +8155,7 @@ func (p *SfplParser) Severity() (localctx ISeverityContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(311)
+		p.SetState(597)
 		p.Match(SfplParserSEVERITY)
 	}
 
@@ -4503,7 +8242,7 @@ func (s *EnabledContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Enabled() (localctx IEnabledContext) {
 	localctx = NewEnabledContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, SfplParserRULE_enabled)
+	p.EnterRule(localctx, 68, SfplParserRULE_enabled)
 
 	defer func() {
 		p.ExitRule()
@@ -4523,7 +8262,7 @@ func (p *SfplParser) Enabled() (localctx IEnabledContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(313)
+		p.SetState(599)
 		p.Atom()
 	}
 
@@ -4610,7 +8349,7 @@ func (s *WarnevttypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SfplParser) Warnevttype() (localctx IWarnevttypeContext) {
 	localctx = NewWarnevttypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, SfplParserRULE_warnevttype)
+	p.EnterRule(localctx, 70, SfplParserRULE_warnevttype)
 
 	defer func() {
 		p.ExitRule()
@@ -4630,7 +8369,7 @@ func (p *SfplParser) Warnevttype() (localctx IWarnevttypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(315)
+		p.SetState(601)
 		p.Atom()
 	}
 
@@ -4717,7 +8456,7 @@ func (s *SkipunknownContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SfplParser) Skipunknown() (localctx ISkipunknownContext) {
 	localctx = NewSkipunknownContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, SfplParserRULE_skipunknown)
+	p.EnterRule(localctx, 72, SfplParserRULE_skipunknown)
 
 	defer func() {
 		p.ExitRule()
@@ -4737,7 +8476,7 @@ func (p *SfplParser) Skipunknown() (localctx ISkipunknownContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(317)
+		p.SetState(603)
 		p.Atom()
 	}
 
@@ -4824,7 +8563,7 @@ func (s *FappendContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Fappend() (localctx IFappendContext) {
 	localctx = NewFappendContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, SfplParserRULE_fappend)
+	p.EnterRule(localctx, 74, SfplParserRULE_fappend)
 
 	defer func() {
 		p.ExitRule()
@@ -4844,13 +8583,126 @@ func (p *SfplParser) Fappend() (localctx IFappendContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(319)
+		p.SetState(605)
 		p.Atom()
 	}
 
 	return localctx
 }
 
+// ICondopContext is an interface to support dynamic dispatch.
+type ICondopContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCondopContext differentiates from other interfaces.
+	IsCondopContext()
+}
+
+type CondopContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCondopContext() *CondopContext {
+	var p = new(CondopContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SfplParserRULE_condop
+	return p
+}
+
+func (*CondopContext) IsCondopContext() {}
+
+func NewCondopContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CondopContext {
+	var p = new(CondopContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SfplParserRULE_condop
+
+	return p
+}
+
+func (s *CondopContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CondopContext) AND() antlr.TerminalNode {
+	return s.GetToken(SfplParserAND, 0)
+}
+
+func (s *CondopContext) OR() antlr.TerminalNode {
+	return s.GetToken(SfplParserOR, 0)
+}
+
+func (s *CondopContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CondopContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CondopContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.EnterCondop(s)
+	}
+}
+
+func (s *CondopContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SfplListener); ok {
+		listenerT.ExitCondop(s)
+	}
+}
+
+func (s *CondopContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SfplVisitor:
+		return t.VisitCondop(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SfplParser) Condop() (localctx ICondopContext) {
+	localctx = NewCondopContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, SfplParserRULE_condop)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(607)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == SfplParserAND || _la == SfplParserOR) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+	return localctx
+}
+
 // IVariableContext is an interface to support dynamic dispatch.
 type IVariableContext interface {
 	antlr.ParserRuleContext
@@ -4925,7 +8777,7 @@ func (s *VariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Variable() (localctx IVariableContext) {
 	localctx = NewVariableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, SfplParserRULE_variable)
+	p.EnterRule(localctx, 78, SfplParserRULE_variable)
 
 	defer func() {
 		p.ExitRule()
@@ -4945,7 +8797,7 @@ func (p *SfplParser) Variable() (localctx IVariableContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(321)
+		p.SetState(609)
 		p.Match(SfplParserID)
 	}
 
@@ -5050,7 +8902,7 @@ func (s *AtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Atom() (localctx IAtomContext) {
 	localctx = NewAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, SfplParserRULE_atom)
+	p.EnterRule(localctx, 80, SfplParserRULE_atom)
 	var _la int
 
 	defer func() {
@@ -5071,10 +8923,10 @@ func (p *SfplParser) Atom() (localctx IAtomContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(323)
+		p.SetState(611)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-23)&-(0x1f+1)) == 0 && ((1<<uint((_la-23)))&((1<<(SfplParserLT-23))|(1<<(SfplParserGT-23))|(1<<(SfplParserID-23))|(1<<(SfplParserNUMBER-23))|(1<<(SfplParserPATH-23))|(1<<(SfplParserSTRING-23))|(1<<(SfplParserTAG-23)))) != 0) {
+		if !(((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SfplParserLT-33))|(1<<(SfplParserGT-33))|(1<<(SfplParserID-33)))) != 0 || ((_la-65)&-(0x1f+1)) == 0 && ((1<<uint((_la-65)))&((1<<(SfplParserNUMBER-65))|(1<<(SfplParserPATH-65))|(1<<(SfplParserSTRING-65))|(1<<(SfplParserTAG-65)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -5154,7 +9006,7 @@ func (s *TextContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SfplParser) Text() (localctx ITextContext) {
 	localctx = NewTextContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, SfplParserRULE_text)
+	p.EnterRule(localctx, 82, SfplParserRULE_text)
 
 	defer func() {
 		p.ExitRule()
@@ -5175,15 +9027,15 @@ func (p *SfplParser) Text() (localctx ITextContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(327)
+	p.SetState(615)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
-			p.SetState(325)
+			p.SetState(613)
 
-			if !(!(p.GetCurrentToken().GetText() == "desc" ||
+			if !(!((p.GetCurrentToken().GetText() == "desc" ||
 				p.GetCurrentToken().GetText() == "condition" ||
 				p.GetCurrentToken().GetText() == "actions" ||
 				p.GetCurrentToken().GetText() == "output" ||
@@ -5193,19 +9045,26 @@ func (p *SfplParser) Text() (localctx ITextContext) {
 				p.GetCurrentToken().GetText() == "enabled" ||
 				p.GetCurrentToken().GetText() == "warn_evttypes" ||
 				p.GetCurrentToken().GetText() == "skip-if-unknown-filter" ||
-				p.GetCurrentToken().GetText() == "append")) {
-				panic(antlr.NewFailedPredicateException(p, "!(p.GetCurrentToken().GetText() == \"desc\" ||\n\t      p.GetCurrentToken().GetText() == \"condition\" ||\n\t      p.GetCurrentToken().GetText() == \"actions\" ||\n\t      p.GetCurrentToken().GetText() == \"output\" ||\n\t      p.GetCurrentToken().GetText() == \"priority\" ||\n\t      p.GetCurrentToken().GetText() == \"tags\" ||\n\t\t  p.GetCurrentToken().GetText() == \"prefilter\" ||\n\t\t  p.GetCurrentToken().GetText() == \"enabled\" ||\n\t\t  p.GetCurrentToken().GetText() == \"warn_evttypes\" ||\n\t\t  p.GetCurrentToken().GetText() == \"skip-if-unknown-filter\" ||\n\t\t  p.GetCurrentToken().GetText() == \"append\" )", ""))
+				p.GetCurrentToken().GetText() == "sequence" ||
+				p.GetCurrentToken().GetText() == "group_by" ||
+				p.GetCurrentToken().GetText() == "window" ||
+				p.GetCurrentToken().GetText() == "threshold" ||
+				p.GetCurrentToken().GetText() == "suppress" ||
+				p.GetCurrentToken().GetText() == "exceptions" ||
+				p.GetCurrentToken().GetText() == "append") &&
+				p.GetTokenStream().LT(2).GetTokenType() == SfplParserDEF)) {
+				panic(antlr.NewFailedPredicateException(p, "!((p.GetCurrentToken().GetText() == \"desc\" ||\n\t      p.GetCurrentToken().GetText() == \"condition\" ||\n\t      p.GetCurrentToken().GetText() == \"actions\" ||\n\t      p.GetCurrentToken().GetText() == \"output\" ||\n\t      p.GetCurrentToken().GetText() == \"priority\" ||\n\t      p.GetCurrentToken().GetText() == \"tags\" ||\n\t\t  p.GetCurrentToken().GetText() == \"prefilter\" ||\n\t\t  p.GetCurrentToken().GetText() == \"enabled\" ||\n\t\t  p.GetCurrentToken().GetText() == \"warn_evttypes\" ||\n\t\t  p.GetCurrentToken().GetText() == \"skip-if-unknown-filter\" ||\n\t\t  p.GetCurrentToken().GetText() == \"sequence\" ||\n\t\t  p.GetCurrentToken().GetText() == \"group_by\" ||\n\t\t  p.GetCurrentToken().GetText() == \"window\" ||\n\t\t  p.GetCurrentToken().GetText() == \"threshold\" ||\n\t\t  p.GetCurrentToken().GetText() == \"suppress\" ||\n\t\t  p.GetCurrentToken().GetText() == \"exceptions\" ||\n\t\t  p.GetCurrentToken().GetText() == \"append\") &&\n\t\t  p.GetTokenStream().LT(2).GetTokenType() == SfplParserDEF)", ""))
 			}
-			p.SetState(326)
+			p.SetState(614)
 			p.MatchWildcard()
 
 		default:
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(329)
+		p.SetState(617)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 64, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -5289,6 +9148,14 @@ func (s *Binary_operatorContext) ENDSWITH() antlr.TerminalNode {
 	return s.GetToken(SfplParserENDSWITH, 0)
 }
 
+func (s *Binary_operatorContext) GLOB() antlr.TerminalNode {
+	return s.GetToken(SfplParserGLOB, 0)
+}
+
+func (s *Binary_operatorContext) REGEX() antlr.TerminalNode {
+	return s.GetToken(SfplParserREGEX, 0)
+}
+
 func (s *Binary_operatorContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -5321,7 +9188,7 @@ func (s *Binary_operatorContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *SfplParser) Binary_operator() (localctx IBinary_operatorContext) {
 	localctx = NewBinary_operatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, SfplParserRULE_binary_operator)
+	p.EnterRule(localctx, 84, SfplParserRULE_binary_operator)
 	var _la int
 
 	defer func() {
@@ -5342,10 +9209,10 @@ func (p *SfplParser) Binary_operator() (localctx IBinary_operatorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(331)
+		p.SetState(619)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-23)&-(0x1f+1)) == 0 && ((1<<uint((_la-23)))&((1<<(SfplParserLT-23))|(1<<(SfplParserLE-23))|(1<<(SfplParserGT-23))|(1<<(SfplParserGE-23))|(1<<(SfplParserEQ-23))|(1<<(SfplParserNEQ-23))|(1<<(SfplParserCONTAINS-23))|(1<<(SfplParserICONTAINS-23))|(1<<(SfplParserSTARTSWITH-23))|(1<<(SfplParserENDSWITH-23)))) != 0) {
+		if !(((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SfplParserLT-33))|(1<<(SfplParserLE-33))|(1<<(SfplParserGT-33))|(1<<(SfplParserGE-33))|(1<<(SfplParserEQ-33))|(1<<(SfplParserNEQ-33))|(1<<(SfplParserCONTAINS-33))|(1<<(SfplParserICONTAINS-33))|(1<<(SfplParserSTARTSWITH-33))|(1<<(SfplParserENDSWITH-33))|(1<<(SfplParserGLOB-33))|(1<<(SfplParserREGEX-33)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -5398,6 +9265,18 @@ func (s *Unary_operatorContext) EXISTS() antlr.TerminalNode {
 	return s.GetToken(SfplParserEXISTS, 0)
 }
 
+func (s *Unary_operatorContext) ISPRIVATE() antlr.TerminalNode {
+	return s.GetToken(SfplParserISPRIVATE, 0)
+}
+
+func (s *Unary_operatorContext) ISLOOPBACK() antlr.TerminalNode {
+	return s.GetToken(SfplParserISLOOPBACK, 0)
+}
+
+func (s *Unary_operatorContext) ISLINKLOCAL() antlr.TerminalNode {
+	return s.GetToken(SfplParserISLINKLOCAL, 0)
+}
+
 func (s *Unary_operatorContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -5430,7 +9309,8 @@ func (s *Unary_operatorContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *SfplParser) Unary_operator() (localctx IUnary_operatorContext) {
 	localctx = NewUnary_operatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, SfplParserRULE_unary_operator)
+	p.EnterRule(localctx, 86, SfplParserRULE_unary_operator)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5450,8 +9330,15 @@ func (p *SfplParser) Unary_operator() (localctx IUnary_operatorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(333)
-		p.Match(SfplParserEXISTS)
+		p.SetState(621)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(((_la-47)&-(0x1f+1)) == 0 && ((1<<uint((_la-47)))&((1<<(SfplParserEXISTS-47))|(1<<(SfplParserISPRIVATE-47))|(1<<(SfplParserISLOOPBACK-47))|(1<<(SfplParserISLINKLOCAL-47)))) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
 	}
 
 	return localctx
@@ -5459,7 +9346,7 @@ func (p *SfplParser) Unary_operator() (localctx IUnary_operatorContext) {
 
 func (p *SfplParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 25:
+	case 41:
 		var t *TextContext = nil
 		if localctx != nil {
 			t = localctx.(*TextContext)
@@ -5474,7 +9361,7 @@ func (p *SfplParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex in
 func (p *SfplParser) Text_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
-		return !(p.GetCurrentToken().GetText() == "desc" ||
+		return !((p.GetCurrentToken().GetText() == "desc" ||
 			p.GetCurrentToken().GetText() == "condition" ||
 			p.GetCurrentToken().GetText() == "actions" ||
 			p.GetCurrentToken().GetText() == "output" ||
@@ -5484,7 +9371,14 @@ func (p *SfplParser) Text_Sempred(localctx antlr.RuleContext, predIndex int) boo
 			p.GetCurrentToken().GetText() == "enabled" ||
 			p.GetCurrentToken().GetText() == "warn_evttypes" ||
 			p.GetCurrentToken().GetText() == "skip-if-unknown-filter" ||
-			p.GetCurrentToken().GetText() == "append")
+			p.GetCurrentToken().GetText() == "sequence" ||
+			p.GetCurrentToken().GetText() == "group_by" ||
+			p.GetCurrentToken().GetText() == "window" ||
+			p.GetCurrentToken().GetText() == "threshold" ||
+			p.GetCurrentToken().GetText() == "suppress" ||
+			p.GetCurrentToken().GetText() == "exceptions" ||
+			p.GetCurrentToken().GetText() == "append") &&
+			p.GetTokenStream().LT(2).GetTokenType() == SfplParserDEF)
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
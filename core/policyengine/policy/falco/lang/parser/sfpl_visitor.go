@@ -19,6 +19,9 @@ type SfplVisitor interface {
 	// Visit a parse tree produced by SfplParser#srule.
 	VisitSrule(ctx *SruleContext) interface{}
 
+	// Visit a parse tree produced by SfplParser#parule.
+	VisitParule(ctx *ParuleContext) interface{}
+
 	// Visit a parse tree produced by SfplParser#pfilter.
 	VisitPfilter(ctx *PfilterContext) interface{}
 
@@ -61,6 +64,48 @@ type SfplVisitor interface {
 	// Visit a parse tree produced by SfplParser#prefilter.
 	VisitPrefilter(ctx *PrefilterContext) interface{}
 
+	// Visit a parse tree produced by SfplParser#sequence.
+	VisitSequence(ctx *SequenceContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#groupby.
+	VisitGroupby(ctx *GroupbyContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#window.
+	VisitWindow(ctx *WindowContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#threshold.
+	VisitThreshold(ctx *ThresholdContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#suppress.
+	VisitSuppress(ctx *SuppressContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#lookup.
+	VisitLookup(ctx *LookupContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#thresholdattr.
+	VisitThresholdattr(ctx *ThresholdattrContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#exceptions.
+	VisitExceptions(ctx *ExceptionsContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#exception.
+	VisitException(ctx *ExceptionContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#comps.
+	VisitComps(ctx *CompsContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#compop.
+	VisitCompop(ctx *CompopContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#exvalues.
+	VisitExvalues(ctx *ExvaluesContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#extuple.
+	VisitExtuple(ctx *ExtupleContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#exvalue.
+	VisitExvalue(ctx *ExvalueContext) interface{}
+
 	// Visit a parse tree produced by SfplParser#severity.
 	VisitSeverity(ctx *SeverityContext) interface{}
 
@@ -76,6 +121,9 @@ type SfplVisitor interface {
 	// Visit a parse tree produced by SfplParser#fappend.
 	VisitFappend(ctx *FappendContext) interface{}
 
+	// Visit a parse tree produced by SfplParser#condop.
+	VisitCondop(ctx *CondopContext) interface{}
+
 	// Visit a parse tree produced by SfplParser#variable.
 	VisitVariable(ctx *VariableContext) interface{}
 
@@ -0,0 +1,329 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy implements input policy translation for the rules engine.
+package policy
+
+import (
+	"sort"
+	"sync"
+)
+
+// ExprOp denotes the operator of an expression node.
+type ExprOp uint8
+
+// ExprOp enumeration.
+const (
+	OpLeaf ExprOp = iota
+	OpTrue
+	OpFalse
+	OpAnd
+	OpOr
+	OpNot
+)
+
+// Estimate defines the estimated evaluation cost of a predicate, in units of a simple comparison,
+// and its selectivity, i.e., the estimated probability that the predicate holds.
+type Estimate struct {
+	Cost        float64
+	Selectivity float64
+}
+
+// DefaultEstimate is the estimate of predicates without cost information.
+var DefaultEstimate = Estimate{Cost: 1, Selectivity: 0.5}
+
+// Expr is a node of the intermediate representation (IR) of criteria. Leaves wrap the predicates
+// built by source operations, and inner nodes denote the logical operations between them.
+type Expr[R any] struct {
+	Op   ExprOp
+	Key  string
+	Est  Estimate
+	Pred Predicate[R]
+	Args []*Expr[R]
+
+	// prefilter values of the records on which a leaf may hold, or nil if unconstrained
+	Prefilter []string
+
+	// memo slot of a leaf interned in a program, or zero
+	slot int
+}
+
+// Memo values.
+const (
+	unknown uint8 = iota
+	evalFalse
+	evalTrue
+)
+
+// Memo stores the results of the leaves of a program evaluated against a record.
+type Memo struct {
+	vals []uint8
+}
+
+// eval evaluates an expression against r, memoizing leaf results in m.
+func (e *Expr[R]) eval(r R, m *Memo) bool {
+	switch e.Op {
+	case OpTrue:
+		return true
+	case OpFalse:
+		return false
+	case OpNot:
+		return !e.Args[0].eval(r, m)
+	case OpAnd:
+		for _, a := range e.Args {
+			if !a.eval(r, m) {
+				return false
+			}
+		}
+		return true
+	case OpOr:
+		for _, a := range e.Args {
+			if a.eval(r, m) {
+				return true
+			}
+		}
+		return false
+	}
+	if e.slot == 0 || e.slot >= len(m.vals) {
+		return e.Pred(r)
+	}
+	switch m.vals[e.slot] {
+	case evalTrue:
+		return true
+	case evalFalse:
+		return false
+	}
+	v := e.Pred(r)
+	if v {
+		m.vals[e.slot] = evalTrue
+	} else {
+		m.vals[e.slot] = evalFalse
+	}
+	return v
+}
+
+// Program compiles a set of criteria into a shared IR. Identical leaves are deduplicated across all
+// criteria and memoized per record, conjunctions and disjunctions are flattened and reordered by
+// estimated cost and selectivity, and constant subexpressions are folded.
+type Program[R any] struct {
+	keyed  map[string]*Expr[R]
+	anon   map[*Expr[R]]*Expr[R]
+	leaves int
+	pool   sync.Pool
+}
+
+// NewProgram creates a new program.
+func NewProgram[R any]() *Program[R] {
+	return &Program[R]{keyed: make(map[string]*Expr[R]), anon: make(map[*Expr[R]]*Expr[R])}
+}
+
+// Add compiles criterion c into the program, and returns an equivalent criterion whose
+// memoized evaluation uses the program's IR.
+func (p *Program[R]) Add(c Criterion[R]) Criterion[R] {
+	return Criterion[R]{Pred: c.Pred, expr: p.compile(c.node())}
+}
+
+// Leaves returns the number of distinct leaves in the program.
+func (p *Program[R]) Leaves() int {
+	return p.leaves
+}
+
+// NewMemo returns an empty memo for evaluating the program against a record.
+// It should be released after the record is evaluated.
+func (p *Program[R]) NewMemo() *Memo {
+	if m, ok := p.pool.Get().(*Memo); ok && len(m.vals) == p.leaves+1 {
+		for i := range m.vals {
+			m.vals[i] = unknown
+		}
+		return m
+	}
+	return &Memo{vals: make([]uint8, p.leaves+1)}
+}
+
+// Release returns a memo to the program's pool.
+func (p *Program[R]) Release(m *Memo) {
+	p.pool.Put(m)
+}
+
+// compile interns the leaves of an expression, and returns its optimized form.
+func (p *Program[R]) compile(e *Expr[R]) *Expr[R] {
+	switch e.Op {
+	case OpTrue, OpFalse:
+		return e
+	case OpLeaf:
+		return p.intern(e)
+	case OpNot:
+		arg := p.compile(e.Args[0])
+		switch arg.Op {
+		case OpTrue:
+			return &Expr[R]{Op: OpFalse}
+		case OpFalse:
+			return &Expr[R]{Op: OpTrue}
+		case OpNot:
+			return arg.Args[0]
+		}
+		return &Expr[R]{Op: OpNot, Args: []*Expr[R]{arg}, Est: Estimate{arg.Est.Cost, 1 - arg.Est.Selectivity}}
+	}
+
+	// flatten nested operations and fold constants; the identity of a conjunction is true,
+	// and its absorbing element is false (and conversely for disjunctions)
+	identity, absorbing := OpTrue, OpFalse
+	if e.Op == OpOr {
+		identity, absorbing = OpFalse, OpTrue
+	}
+	var args []*Expr[R]
+	for _, a := range p.flatten(e.Op, e, nil) {
+		a = p.compile(a)
+		switch a.Op {
+		case identity:
+			continue
+		case absorbing:
+			return &Expr[R]{Op: absorbing}
+		case e.Op:
+			args = append(args, a.Args...)
+			continue
+		}
+		args = append(args, a)
+	}
+	switch len(args) {
+	case 0:
+		return &Expr[R]{Op: identity}
+	case 1:
+		return args[0]
+	}
+	return p.reorder(&Expr[R]{Op: e.Op, Args: args})
+}
+
+// flatten collects the operands of nested operations op rooted at e.
+func (p *Program[R]) flatten(op ExprOp, e *Expr[R], args []*Expr[R]) []*Expr[R] {
+	for _, a := range e.Args {
+		if a.Op == op {
+			args = p.flatten(op, a, args)
+		} else {
+			args = append(args, a)
+		}
+	}
+	return args
+}
+
+// intern returns the program's leaf equivalent to e, assigning a memo slot to new leaves.
+// Leaves without a key are only deduplicated by identity.
+func (p *Program[R]) intern(e *Expr[R]) *Expr[R] {
+	if e.Key != "" {
+		if l, ok := p.keyed[e.Key]; ok {
+			return l
+		}
+	} else if l, ok := p.anon[e]; ok {
+		return l
+	}
+	p.leaves++
+	l := &Expr[R]{Op: OpLeaf, Key: e.Key, Est: e.Est, Pred: e.Pred, Prefilter: e.Prefilter, slot: p.leaves}
+	if e.Key != "" {
+		p.keyed[e.Key] = l
+	} else {
+		p.anon[e] = l
+	}
+	return l
+}
+
+// reorder sorts the operands of a conjunction (resp. disjunction) so that the operands most likely
+// to short-circuit its evaluation at the lowest cost are evaluated first, and computes its estimate.
+// Operands of conjunctions are ranked by cost/(1-selectivity), and of disjunctions by cost/selectivity.
+func (p *Program[R]) reorder(e *Expr[R]) *Expr[R] {
+	rank := func(a *Expr[R]) float64 {
+		s := a.Est.Selectivity
+		if e.Op == OpAnd {
+			s = 1 - s
+		}
+		if s <= 0 {
+			return a.Est.Cost * 1e9
+		}
+		return a.Est.Cost / s
+	}
+	sort.SliceStable(e.Args, func(i, j int) bool { return rank(e.Args[i]) < rank(e.Args[j]) })
+
+	// the cost of an operand is only paid if the preceding operands do not short-circuit
+	reach := 1.0
+	for _, a := range e.Args {
+		e.Est.Cost += reach * a.Est.Cost
+		if e.Op == OpAnd {
+			reach *= a.Est.Selectivity
+		} else {
+			reach *= 1 - a.Est.Selectivity
+		}
+	}
+	if e.Op == OpAnd {
+		e.Est.Selectivity = reach
+	} else {
+		e.Est.Selectivity = 1 - reach
+	}
+	return e
+}
+
+// prefilter infers the set of prefilter values of the records on which an expression may hold.
+// It returns false if the expression may hold on records with any prefilter value.
+func (e *Expr[R]) prefilter() (map[string]struct{}, bool) {
+	switch e.Op {
+	case OpFalse:
+		return make(map[string]struct{}), true
+	case OpLeaf:
+		if e.Prefilter == nil {
+			return nil, false
+		}
+		set := make(map[string]struct{}, len(e.Prefilter))
+		for _, v := range e.Prefilter {
+			set[v] = struct{}{}
+		}
+		return set, true
+	case OpAnd:
+		// a conjunction only holds where all its constrained operands hold
+		var set map[string]struct{}
+		for _, a := range e.Args {
+			s, ok := a.prefilter()
+			if !ok {
+				continue
+			}
+			if set == nil {
+				set = s
+				continue
+			}
+			for v := range set {
+				if _, ok := s[v]; !ok {
+					delete(set, v)
+				}
+			}
+		}
+		return set, set != nil
+	case OpOr:
+		// a disjunction holds where any of its operands holds
+		set := make(map[string]struct{})
+		for _, a := range e.Args {
+			s, ok := a.prefilter()
+			if !ok {
+				return nil, false
+			}
+			for v := range s {
+				set[v] = struct{}{}
+			}
+		}
+		return set, true
+	}
+	// negations and tautologies are unconstrained
+	return nil, false
+}
@@ -0,0 +1,180 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy implements input policy translation for the rules engine.
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type irRecord map[string]string
+
+// eq returns a leaf comparing attribute attr with value, counting its evaluations in calls.
+func eq(attr string, value string, est Estimate, calls map[string]int) Criterion[irRecord] {
+	key := attr + "=" + value
+	return Leaf(key, est, func(r irRecord) bool {
+		calls[key]++
+		return r[attr] == value
+	})
+}
+
+func TestProgramDedup(t *testing.T) {
+	calls := make(map[string]int)
+	est := Estimate{Cost: 1, Selectivity: 0.1}
+	p := NewProgram[irRecord]()
+	c1 := p.Add(eq("type", "PE", est, calls).And(eq("name", "bash", est, calls)))
+	c2 := p.Add(eq("type", "PE", est, calls).And(eq("name", "sh", est, calls)))
+	assert.Equal(t, 3, p.Leaves())
+
+	r := irRecord{"type": "PE", "name": "sh"}
+	m := p.NewMemo()
+	assert.False(t, c1.EvalMemo(r, m))
+	assert.True(t, c2.EvalMemo(r, m))
+	assert.Equal(t, 1, calls["type=PE"])
+	p.Release(m)
+
+	m = p.NewMemo()
+	assert.True(t, c2.EvalMemo(r, m))
+	assert.Equal(t, 2, calls["type=PE"])
+	assert.True(t, c2.EvalMemo(r, nil))
+}
+
+func TestProgramReorder(t *testing.T) {
+	calls := make(map[string]int)
+	p := NewProgram[irRecord]()
+	costly := eq("args", "-c", Estimate{Cost: 20, Selectivity: 0.2}, calls)
+	cheap := eq("type", "PE", Estimate{Cost: 1, Selectivity: 0.1}, calls)
+	c := p.Add(costly.And(cheap))
+	assert.False(t, c.EvalMemo(irRecord{"type": "NF"}, p.NewMemo()))
+	assert.Equal(t, 0, calls["args=-c"])
+	assert.Equal(t, 1, calls["type=PE"])
+
+	c = p.Add(costly.Or(cheap))
+	assert.True(t, c.EvalMemo(irRecord{"type": "PE"}, p.NewMemo()))
+	assert.Equal(t, 0, calls["args=-c"])
+}
+
+func TestProgramFold(t *testing.T) {
+	calls := make(map[string]int)
+	p := NewProgram[irRecord]()
+	leaf := eq("type", "PE", DefaultEstimate, calls)
+	assert.Equal(t, OpFalse, p.Add(leaf.And(False[irRecord]())).expr.Op)
+	assert.Equal(t, OpTrue, p.Add(leaf.Or(True[irRecord]())).expr.Op)
+	assert.Equal(t, OpLeaf, p.Add(All([]Criterion[irRecord]{leaf})).expr.Op)
+	assert.Equal(t, OpLeaf, p.Add(leaf.Not().Not()).expr.Op)
+	c := p.Add(All([]Criterion[irRecord]{leaf, leaf.Not().And(leaf), leaf}))
+	assert.Equal(t, OpAnd, c.expr.Op)
+	assert.Len(t, c.expr.Args, 4)
+	assert.False(t, c.EvalMemo(irRecord{"type": "PE"}, p.NewMemo()))
+}
+
+func TestProgramEquivalence(t *testing.T) {
+	calls := make(map[string]int)
+	a := eq("a", "1", Estimate{Cost: 3, Selectivity: 0.9}, calls)
+	b := eq("b", "1", Estimate{Cost: 1, Selectivity: 0.1}, calls)
+	c := Criterion[irRecord]{Pred: func(r irRecord) bool { return r["c"] == "1" }}
+	criteria := []Criterion[irRecord]{
+		a.And(b.Or(c)),
+		a.Not().Or(b.And(c.Not())),
+		Any([]Criterion[irRecord]{a.And(b), b.And(c), c.Not()}),
+		All([]Criterion[irRecord]{a, b.Not(), True[irRecord]()}).Or(False[irRecord]()),
+	}
+	p := NewProgram[irRecord]()
+	for _, cr := range criteria {
+		compiled := p.Add(cr)
+		for i := 0; i < 8; i++ {
+			r := irRecord{"a": fmt.Sprint(i & 1), "b": fmt.Sprint(i >> 1 & 1), "c": fmt.Sprint(i >> 2 & 1)}
+			assert.Equal(t, cr.Eval(r), compiled.EvalMemo(r, p.NewMemo()))
+		}
+	}
+}
+
+func TestCriterionPrefilter(t *testing.T) {
+	calls := make(map[string]int)
+	pe := eq("type", "PE", DefaultEstimate, calls).WithPrefilter([]string{"PE"})
+	flow := eq("port", "22", DefaultEstimate, calls).WithPrefilter([]string{"NF", "FF"})
+	name := eq("name", "bash", DefaultEstimate, calls)
+
+	check := func(c Criterion[irRecord], values []string, ok bool) {
+		pf, constrained := c.Prefilter()
+		assert.Equal(t, ok, constrained)
+		assert.Equal(t, values, pf)
+	}
+	check(name, nil, false)
+	check(pe.And(name), []string{"PE"}, true)
+	check(pe.Or(flow), []string{"FF", "NF", "PE"}, true)
+	check(pe.Or(name), nil, false)
+	check(pe.And(flow), []string{}, true)
+	check(pe.Not(), nil, false)
+	check(False[irRecord](), []string{}, true)
+	check(name.WithPrefilter([]string{"PE"}).And(name), []string{"PE"}, true)
+	check(name.And(name).WithPrefilter([]string{"PE"}), nil, false)
+
+	p := NewProgram[irRecord]()
+	check(p.Add(flow.And(name.Or(pe))), []string{"FF", "NF"}, true)
+}
+
+// benchmarkRules builds a rule set in which rules share their record type and container predicates.
+func benchmarkRules(n int) []Criterion[irRecord] {
+	leaf := func(attr string, value string, est Estimate) Criterion[irRecord] {
+		return Leaf(attr+"="+value, est, func(r irRecord) bool { return strings.Contains(r[attr], value) })
+	}
+	var rules []Criterion[irRecord]
+	for i := 0; i < n; i++ {
+		rules = append(rules, All([]Criterion[irRecord]{
+			leaf("type", "PE", Estimate{Cost: 2, Selectivity: 0.2}),
+			leaf("container", "host", Estimate{Cost: 2, Selectivity: 0.2}).Not(),
+			leaf("args", fmt.Sprintf("arg%d", i), Estimate{Cost: 2, Selectivity: 0.2}),
+		}))
+	}
+	return rules
+}
+
+var benchmarkRecord = irRecord{"type": "PE", "container": "abc", "args": "-c arg"}
+
+func BenchmarkClosures(b *testing.B) {
+	rules := benchmarkRules(200)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, c := range rules {
+			c.Eval(benchmarkRecord)
+		}
+	}
+}
+
+func BenchmarkProgram(b *testing.B) {
+	p := NewProgram[irRecord]()
+	rules := benchmarkRules(200)
+	for i, c := range rules {
+		rules[i] = p.Add(c)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m := p.NewMemo()
+		for _, c := range rules {
+			c.EvalMemo(benchmarkRecord, m)
+		}
+		p.Release(m)
+	}
+}
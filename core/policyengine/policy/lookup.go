@@ -0,0 +1,185 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy implements input policy translation for the rules engine.
+package policy
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+)
+
+// Lookup file formats.
+const (
+	LookupLines = "lines"
+	LookupCSV   = "csv"
+)
+
+// DefaultLookupInterval is the default interval at which lookup files are checked for changes.
+const DefaultLookupInterval = time.Minute
+
+// Lookup is a list of values loaded from an external file, such as a threat intelligence feed. Lookups are
+// reloaded when their files change, and pass their new values to subscribers, so that predicates over lookups
+// are updated without recompiling policies.
+type Lookup struct {
+	// Name of the lookup list.
+	Name string
+	// Path of the lookup file.
+	Path string
+	// Format of the lookup file: newline-delimited values (lines) or CSV (csv).
+	Format string
+	// Column holding the values of CSV files, starting at 0.
+	Column int
+	// Interval at which the lookup file is checked for changes.
+	Interval time.Duration
+
+	mu      sync.Mutex
+	values  []string
+	modTime time.Time
+	size    int64
+	subs    []func([]string)
+}
+
+// NewLookup creates a lookup list and loads its values. The format is inferred from the file extension if empty.
+func NewLookup(name string, path string, format string, column int, interval time.Duration) (*Lookup, error) {
+	if format == "" {
+		format = LookupLines
+		if strings.EqualFold(filepath.Ext(path), ".csv") {
+			format = LookupCSV
+		}
+	}
+	if format != LookupLines && format != LookupCSV {
+		return nil, fmt.Errorf("unsupported lookup format %s", format)
+	}
+	if column < 0 {
+		return nil, fmt.Errorf("invalid lookup column %d", column)
+	}
+	if interval <= 0 {
+		interval = DefaultLookupInterval
+	}
+	l := &Lookup{Name: name, Path: path, Format: format, Column: column, Interval: interval}
+	if _, err := l.Refresh(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Values returns the current values of the lookup list.
+func (l *Lookup) Values() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.values
+}
+
+// Subscribe calls f with the current values of the lookup list, and whenever the list is reloaded.
+// Since predicates may be evaluated concurrently with reloads, subscribers should swap the state they
+// build from values atomically.
+func (l *Lookup) Subscribe(f func(values []string)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.subs = append(l.subs, f)
+	f(l.values)
+}
+
+// Refresh reloads the lookup file if it changed since it was last loaded, and returns whether it was
+// reloaded. The current values are kept if the file cannot be read.
+func (l *Lookup) Refresh() (bool, error) {
+	fi, err := os.Stat(l.Path)
+	if err != nil {
+		return false, fmt.Errorf("could not read lookup %s: %v", l.Name, err)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.values != nil && fi.ModTime().Equal(l.modTime) && fi.Size() == l.size {
+		return false, nil
+	}
+	values, err := l.load()
+	if err != nil {
+		return false, fmt.Errorf("could not read lookup %s: %v", l.Name, err)
+	}
+	l.values, l.modTime, l.size = values, fi.ModTime(), fi.Size()
+	for _, f := range l.subs {
+		f(values)
+	}
+	return true, nil
+}
+
+// Watch refreshes the lookup list periodically until stop is closed.
+func (l *Lookup) Watch(stop <-chan struct{}) {
+	ticker := time.NewTicker(l.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if ok, err := l.Refresh(); err != nil {
+				logger.Warn.Printf("Keeping %d values of lookup %s, %v", len(l.Values()), l.Name, err)
+			} else if ok {
+				logger.Info.Printf("Reloaded %d values of lookup %s from %s", len(l.Values()), l.Name, l.Path)
+			}
+		}
+	}
+}
+
+// load reads the values of the lookup file. Empty lines, and lines starting with '#', are skipped.
+func (l *Lookup) load() ([]string, error) {
+	f, err := os.Open(l.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	values := make([]string, 0)
+	if l.Format == LookupCSV {
+		r := csv.NewReader(f)
+		r.Comment = '#'
+		r.FieldsPerRecord = -1
+		r.TrimLeadingSpace = true
+		for {
+			record, err := r.Read()
+			if errors.Is(err, io.EOF) {
+				return values, nil
+			} else if err != nil {
+				return nil, err
+			}
+			if l.Column < len(record) {
+				if v := strings.TrimSpace(record[l.Column]); v != "" {
+					values = append(values, v)
+				}
+			}
+		}
+	}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if v := strings.TrimSpace(scanner.Text()); v != "" && !strings.HasPrefix(v, "#") {
+			values = append(values, v)
+		}
+	}
+	return values, scanner.Err()
+}
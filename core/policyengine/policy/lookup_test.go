@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy implements input policy translation for the rules engine.
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "values.txt")
+	assert.NoError(t, os.WriteFile(path, []byte("# comment\n a \n\nb\n"), 0600))
+	l, err := NewLookup("values", path, "", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, LookupLines, l.Format)
+	assert.Equal(t, DefaultLookupInterval, l.Interval)
+	assert.Equal(t, []string{"a", "b"}, l.Values())
+
+	var notified [][]string
+	l.Subscribe(func(values []string) { notified = append(notified, values) })
+	ok, err := l.Refresh()
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.NoError(t, os.WriteFile(path, []byte("a\nb\nc\n"), 0600))
+	ok, err = l.Refresh()
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, [][]string{{"a", "b"}, {"a", "b", "c"}}, notified)
+
+	// values are kept if the file cannot be read
+	assert.NoError(t, os.Remove(path))
+	_, err = l.Refresh()
+	assert.Error(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, l.Values())
+	assert.Len(t, notified, 2)
+}
+
+func TestLookupCSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "values.csv")
+	assert.NoError(t, os.WriteFile(path, []byte("# id,name\n1,a\n2, b,x\n3\n4,\"c,d\"\n"), 0600))
+	l, err := NewLookup("values", path, "", 1, time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, LookupCSV, l.Format)
+	assert.Equal(t, []string{"a", "b", "c,d"}, l.Values())
+
+	_, err = NewLookup("values", path, "json", 0, 0)
+	assert.Error(t, err)
+	_, err = NewLookup("values", path, LookupCSV, -1, 0)
+	assert.Error(t, err)
+	_, err = NewLookup("missing", filepath.Join(t.TempDir(), "missing.txt"), "", 0, 0)
+	assert.Error(t, err)
+}
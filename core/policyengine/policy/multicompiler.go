@@ -0,0 +1,170 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy implements input policy translation for the rules engine.
+package policy
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Key of the header comment that declares the language of a policy file (e.g., "# language: sigma").
+const LanguageHeader = "language"
+
+// MultiCompiler compiles policy files written in different languages into a single set of rules and
+// filters. The language of each file is selected, in order of precedence, from a header comment, from
+// a language extension preceding the file extension (e.g., rules.sigma.yml), from the nearest parent
+// directory below the policy directory named after a language, or else set to the default language.
+type MultiCompiler[R any] struct {
+	compilers map[string]PolicyCompiler[R]
+	def       string
+	root      string
+	langs     []string
+}
+
+// NewMultiCompiler constructs a compiler that dispatches policy files to compilers, indexed by language name.
+// Only the directories below root, the policy directory, select the language of the files they contain.
+func NewMultiCompiler[R any](compilers map[string]PolicyCompiler[R], def string, root string) PolicyCompiler[R] {
+	return &MultiCompiler[R]{compilers: compilers, def: def, root: root}
+}
+
+// Language returns the language of the policy file at path.
+func (mc *MultiCompiler[R]) Language(path string) (string, error) {
+	lang, err := readLanguageHeader(path)
+	if err != nil {
+		return "", err
+	}
+	if lang != "" {
+		if _, ok := mc.compilers[lang]; !ok {
+			return "", Diagnostic{File: path, Severity: SeverityError, Msg: fmt.Sprintf("unsupported policy language %s", lang)}
+		}
+		return lang, nil
+	}
+	base := filepath.Base(path)
+	if lang = strings.TrimPrefix(filepath.Ext(strings.TrimSuffix(base, filepath.Ext(base))), "."); mc.compilers[lang] != nil {
+		return lang, nil
+	}
+	rel, err := filepath.Rel(mc.root, filepath.Dir(path))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return mc.def, nil
+	}
+	for dir := rel; dir != "."; dir = filepath.Dir(dir) {
+		if lang = filepath.Base(dir); mc.compilers[lang] != nil {
+			return lang, nil
+		}
+	}
+	return mc.def, nil
+}
+
+// readLanguageHeader reads the language declared in the leading comments of a policy file, if any.
+func readLanguageHeader(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line == "---" {
+			continue
+		}
+		if !strings.HasPrefix(line, "#") {
+			break
+		}
+		key, value, found := strings.Cut(strings.TrimSpace(strings.TrimPrefix(line, "#")), ":")
+		if found && strings.TrimSpace(key) == LanguageHeader {
+			return strings.ToLower(strings.TrimSpace(value)), nil
+		}
+	}
+	return "", scanner.Err()
+}
+
+// Compile compiles the policy files of each language with the compiler of that language, and merges the
+// rules and filters in order of first appearance of each language in paths. If errors are found, the
+// returned error is the list of Diagnostics reported by all compilers.
+func (mc *MultiCompiler[R]) Compile(paths ...string) ([]Rule[R], []Filter[R], error) {
+	mc.langs = nil
+	groups := make(map[string][]string)
+	for _, path := range paths {
+		lang, err := mc.Language(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := groups[lang]; !ok {
+			mc.langs = append(mc.langs, lang)
+		}
+		groups[lang] = append(groups[lang], path)
+	}
+	var rules []Rule[R]
+	var filters []Filter[R]
+	var diags Diagnostics
+	for _, lang := range mc.langs {
+		pc, ok := mc.compilers[lang]
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported policy language %s", lang)
+		}
+		rs, fs, err := pc.Compile(groups[lang]...)
+		var ds Diagnostics
+		if errors.As(err, &ds) {
+			diags = append(diags, ds...)
+			continue
+		} else if err != nil {
+			return nil, nil, err
+		}
+		rules = append(rules, rs...)
+		filters = append(filters, fs...)
+	}
+	if diags.HasErrors() {
+		return nil, nil, diags
+	}
+	return rules, filters, nil
+}
+
+// Summary returns the combined summary of the compilers used in the last compilation.
+func (mc *MultiCompiler[R]) Summary() Summary {
+	var summary Summary
+	for _, lang := range mc.langs {
+		if s, ok := mc.compilers[lang].(Summarizer); ok {
+			ls := s.Summary()
+			summary.Rules += ls.Rules
+			summary.Filters += ls.Filters
+			summary.Macros += ls.Macros
+			summary.Lists += ls.Lists
+			summary.Diagnostics = append(summary.Diagnostics, ls.Diagnostics...)
+		}
+	}
+	return summary
+}
+
+// Lookups returns the lookup lists loaded by the compilers used in the last compilation.
+func (mc *MultiCompiler[R]) Lookups() []*Lookup {
+	var lookups []*Lookup
+	for _, lang := range mc.langs {
+		if lp, ok := mc.compilers[lang].(LookupProvider); ok {
+			lookups = append(lookups, lp.Lookups()...)
+		}
+	}
+	return lookups
+}
@@ -0,0 +1,102 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy implements input policy translation for the rules engine.
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeCompiler compiles each policy file into a rule named after the file.
+type fakeCompiler struct {
+	lang  string
+	paths []string
+	diags Diagnostics
+}
+
+func (c *fakeCompiler) Compile(paths ...string) ([]Rule[int], []Filter[int], error) {
+	c.paths = append(c.paths, paths...)
+	if c.diags.HasErrors() {
+		return nil, nil, c.diags
+	}
+	var rules []Rule[int]
+	for _, path := range paths {
+		rules = append(rules, Rule[int]{Name: filepath.Base(path), Source: Source{Language: c.lang, File: path}})
+	}
+	return rules, []Filter[int]{{Name: c.lang}}, nil
+}
+
+func (c *fakeCompiler) Summary() Summary {
+	return Summary{Rules: len(c.paths), Diagnostics: c.diags}
+}
+
+func TestMultiCompiler(t *testing.T) {
+	// the policy directory and its ancestors do not select languages
+	dir := filepath.Join(t.TempDir(), "b", "policies")
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+	a, b := &fakeCompiler{lang: "a"}, &fakeCompiler{lang: "b"}
+	mc := NewMultiCompiler(map[string]PolicyCompiler[int]{"a": a, "b": b}, "a", dir).(*MultiCompiler[int])
+
+	paths := []string{
+		write("default.yaml", "- rule: x\n"),
+		write("header.yaml", "---\n# Rules of language b\n#  language: B\n- rule: x\n"),
+		write("late.yaml", "- rule: x\n# language: b\n"),
+		write("ext.b.yml", "title: x\n"),
+		write("b/nested/dir.yml", "title: x\n"),
+		write("b/ext.a.yaml", "- rule: x\n"),
+	}
+	for i, lang := range []string{"a", "b", "a", "b", "b", "a"} {
+		l, err := mc.Language(paths[i])
+		assert.NoError(t, err)
+		assert.Equal(t, lang, l, paths[i])
+	}
+	_, err := mc.Language(write("unknown.yaml", "# language: c\n"))
+	assert.Error(t, err)
+	l, err := mc.Language(write("../outside.yaml", "- rule: x\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "a", l)
+
+	rules, filters, err := mc.Compile(paths...)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{paths[0], paths[2], paths[5]}, a.paths)
+	assert.Equal(t, []string{paths[1], paths[3], paths[4]}, b.paths)
+	assert.Len(t, rules, 6)
+	assert.Equal(t, Source{Language: "b", File: paths[1]}, rules[3].Source)
+	assert.Equal(t, []Filter[int]{{Name: "a"}, {Name: "b"}}, filters)
+	assert.Equal(t, 6, mc.Summary().Rules)
+
+	// diagnostics of all compilers are reported
+	a.diags.Add(Diagnostic{File: paths[0], Severity: SeverityError, Msg: "a"})
+	b.diags.Add(Diagnostic{File: paths[1], Severity: SeverityError, Msg: "b"})
+	_, _, err = mc.Compile(paths...)
+	var diags Diagnostics
+	assert.ErrorAs(t, err, &diags)
+	assert.Len(t, diags, 2)
+	assert.Len(t, mc.Summary().Diagnostics, 2)
+}
@@ -20,7 +20,7 @@
 // Package policy implements input policy translation for the rules engine.
 package policy
 
-import "github.com/sysflow-telemetry/sf-apis/go/logger"
+import "sort"
 
 // Predicate defines the type of a functional predicate.
 type Predicate[R any] func(R) bool
@@ -28,6 +28,7 @@ type Predicate[R any] func(R) bool
 // Criterion defines an interface for functional predicate operations.
 type Criterion[R any] struct {
 	Pred Predicate[R]
+	expr *Expr[R]
 }
 
 // Eval evaluates a functional predicate.
@@ -35,29 +36,86 @@ func (c Criterion[R]) Eval(r R) bool {
 	return c.Pred(r)
 }
 
+// EvalMemo evaluates a criterion using the intermediate representation of its predicate, memoizing leaf
+// results in m. It falls back to Eval if m is nil.
+func (c Criterion[R]) EvalMemo(r R, m *Memo) bool {
+	if m == nil {
+		return c.Pred(r)
+	}
+	return c.node().eval(r, m)
+}
+
 // And computes the conjunction of two functional predicates.
 func (c Criterion[R]) And(cr Criterion[R]) Criterion[R] {
 	var p Predicate[R] = func(r R) bool { return c.Eval(r) && cr.Eval(r) }
-	return Criterion[R]{p}
+	return Criterion[R]{p, &Expr[R]{Op: OpAnd, Args: []*Expr[R]{c.node(), cr.node()}}}
 }
 
 // Or computes the conjunction of two functional predicates.
 func (c Criterion[R]) Or(cr Criterion[R]) Criterion[R] {
 	var p Predicate[R] = func(r R) bool { return c.Eval(r) || cr.Eval(r) }
-	return Criterion[R]{p}
+	return Criterion[R]{p, &Expr[R]{Op: OpOr, Args: []*Expr[R]{c.node(), cr.node()}}}
 }
 
 // Not computes the negation of the function predicate.
 func (c Criterion[R]) Not() Criterion[R] {
 	var p Predicate[R] = func(r R) bool { return !c.Eval(r) }
-	return Criterion[R]{p}
+	return Criterion[R]{p, &Expr[R]{Op: OpNot, Args: []*Expr[R]{c.node()}}}
 }
 
 // True defines a functional predicate that always returns true.
-func True[R any]() Criterion[R] { return Criterion[R]{Pred: func(r R) bool { return true }} }
+func True[R any]() Criterion[R] {
+	return Criterion[R]{Pred: func(r R) bool { return true }, expr: &Expr[R]{Op: OpTrue}}
+}
 
 // False defines a functional predicate that always returns false.
-func False[R any]() Criterion[R] { return Criterion[R]{Pred: func(r R) bool { return false }} }
+func False[R any]() Criterion[R] {
+	return Criterion[R]{Pred: func(r R) bool { return false }, expr: &Expr[R]{Op: OpFalse}}
+}
+
+// Leaf defines a functional predicate identified by key, with estimated cost and selectivity est.
+// Leaves with equal keys must denote the same predicate; they are evaluated at most once per record
+// by programs containing them.
+func Leaf[R any](key string, est Estimate, p Predicate[R]) Criterion[R] {
+	return Criterion[R]{Pred: p, expr: &Expr[R]{Op: OpLeaf, Key: key, Est: est, Pred: p}}
+}
+
+// WithPrefilter annotates a leaf with the prefilter values of the records on which it may hold, i.e., the
+// values of the attribute used by the source's prefilter to select applicable rules (e.g., record types).
+// Criteria other than leaves are returned unchanged.
+func (c Criterion[R]) WithPrefilter(values []string) Criterion[R] {
+	if c.expr == nil || c.expr.Op != OpLeaf || values == nil {
+		return c
+	}
+	e := *c.expr
+	e.Prefilter = values
+	return Criterion[R]{c.Pred, &e}
+}
+
+// Prefilter infers the prefilter values of the records that a criterion may match from the annotations of
+// its leaves. It returns false if the criterion may match records with any prefilter value, and an empty
+// list if it cannot match any record.
+func (c Criterion[R]) Prefilter() ([]string, bool) {
+	set, ok := c.node().prefilter()
+	if !ok {
+		return nil, false
+	}
+	values := make([]string, 0, len(set))
+	for v := range set {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return values, true
+}
+
+// node returns the intermediate representation of a criterion. Criteria built without it
+// are represented as anonymous leaves.
+func (c Criterion[R]) node() *Expr[R] {
+	if c.expr != nil {
+		return c.expr
+	}
+	return &Expr[R]{Op: OpLeaf, Est: DefaultEstimate, Pred: c.Pred}
+}
 
 // All derives the conjuctive clause of all predicates in a slice of predicates.
 func All[R any](criteria []Criterion[R]) Criterion[R] {
@@ -76,11 +134,3 @@ func Any[R any](criteria []Criterion[R]) Criterion[R] {
 	}
 	return any
 }
-
-// First accepts a (Criterion[R], error) tuple and returns the Criterio[R], stopping execution if err is not nil.
-func First[R any, T Criterion[R]](pred T, err error) T {
-	if err != nil {
-		logger.Error.Fatalln("Caught error during predicate compilation: ", err)
-	}
-	return pred
-}
@@ -21,16 +21,43 @@
 package sigma
 
 import (
+	"fmt"
+	"net/netip"
 	"os"
 	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/bradleyjkemp/sigma-go"
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/common"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
 )
 
+// Name of the policy language.
+const Language = "sigma"
+
+// Pseudo-field whose field mapping in the Sigma config sets the fields matched by keyword searches.
+const keywordsField = "keywords"
+
+// Fields matched by keyword searches if the Sigma config does not map the keywords pseudo-field.
+var defaultKeywordFields = []string{"sf.proc.exe", "sf.proc.cmdline", "sf.pproc.exe", "sf.pproc.cmdline", "sf.file.path"}
+
+// Attributes of placeholders that load their values from lookup files.
+const (
+	lookupPath   = "path"
+	lookupFormat = "format"
+	lookupColumn = "column"
+	lookupReload = "reload"
+)
+
+// Estimate of CIDR predicates, which parse IP addresses.
+var cidrEstimate = policy.Estimate{Cost: 4, Selectivity: 0.2}
+
 type PolicyCompiler[R any] struct {
 	// Operations
 	ops source.Operations[R]
@@ -43,10 +70,24 @@ type PolicyCompiler[R any] struct {
 
 	// Intermediate rule and rule config objects parsed by the Sigma parser
 	sigmaRules  []sigma.Rule
+	sigmaPaths  []string
 	sigmaConfig sigma.Config
 
 	// Sigma config path
 	configPath string
+
+	// Fields matched by keyword searches
+	keywordFields []string
+
+	// Lookup lists of placeholders, indexed by placeholder name
+	lookups map[string]*policy.Lookup
+
+	// Path and identifier of the rule being compiled
+	path string
+	rule string
+
+	// Diagnostics reported during compilation
+	diags policy.Diagnostics
 }
 
 // NewPolicyCompiler constructs a new compiler instance.
@@ -69,10 +110,12 @@ func (pc *PolicyCompiler[R]) compile(rulePaths []string, configPath string) erro
 		}
 		rule, err := sigma.ParseRule(contents)
 		if err != nil {
-			logger.Error.Printf("Could not parse input rule ")
+			pc.path, pc.rule = path, ""
+			pc.warnf("could not parse rule: %v", err)
 			continue
 		}
 		pc.sigmaRules = append(pc.sigmaRules, rule)
+		pc.sigmaPaths = append(pc.sigmaPaths, path)
 	}
 
 	// Read Sigma config
@@ -86,9 +129,30 @@ func (pc *PolicyCompiler[R]) compile(rulePaths []string, configPath string) erro
 			return err
 		}
 	}
+	pc.keywordFields = defaultKeywordFields
+	if m, ok := pc.sigmaConfig.FieldMappings[keywordsField]; ok {
+		pc.keywordFields = m.TargetNames
+	}
+	pc.loadLookups(configPath)
 
 	// Translate the sigma rules into criterion objects
-	for _, rule := range pc.sigmaRules {
+	refs := make(map[string]policy.Criterion[R])
+	correlations := make(map[int]*Correlation)
+	ids := make(map[string]bool)
+	for i, rule := range pc.sigmaRules {
+		pc.path, pc.rule = pc.sigmaPaths[i], rule.ID
+		if rule.ID != "" && ids[rule.ID] {
+			pc.warnf("rule id is already defined")
+		}
+		ids[rule.ID] = true
+		if c, err := getCorrelation(rule); err != nil {
+			pc.warnf("could not parse correlation: %v", err)
+			continue
+		} else if c != nil {
+			correlations[i] = c
+			continue
+		}
+		var preds []policy.Criterion[R]
 		for _, conditions := range rule.Detection.Conditions {
 			logger.Trace.Println("Parsing rule ", rule.ID, rule.Title)
 			r := policy.Rule[R]{
@@ -100,22 +164,158 @@ func (pc *PolicyCompiler[R]) compile(rulePaths []string, configPath string) erro
 				Priority:  pc.getPriority(rule),
 				Prefilter: nil,
 				Enabled:   true,
+				Suppress:  pc.getSuppression(rule),
+				Source:    policy.Source{Language: Language, File: pc.path},
+			}
+			preds = append(preds, r.Condition)
+			if conditions.Aggregation != nil {
+				th, err := pc.visitAggregation(conditions.Aggregation, rule.Detection.Timeframe)
+				if err != nil {
+					pc.warnf("could not translate aggregation: %v", err)
+					continue
+				}
+				r.Threshold = th
 			}
 			pc.rules = append(pc.rules, r)
 		}
+		refs[rule.ID] = policy.Any(preds)
+		if name := getName(rule); name != "" {
+			refs[name] = refs[rule.ID]
+		}
+	}
+
+	// Translate the sigma correlation rules, which reference other rules
+	for i, rule := range pc.sigmaRules {
+		if c, ok := correlations[i]; ok {
+			logger.Trace.Println("Parsing correlation rule ", rule.ID, rule.Title)
+			pc.path, pc.rule = pc.sigmaPaths[i], rule.ID
+			if r, err := pc.visitCorrelation(rule, c, refs); err == nil {
+				r.Suppress = pc.getSuppression(rule)
+				pc.rules = append(pc.rules, r)
+			} else {
+				pc.warnf("could not translate correlation: %v", err)
+			}
+		}
 	}
 
 	return nil
 }
 
-// Compile parses a set of input policies defined in paths.
+// Compile parses a set of input policies defined in paths. If errors are found, the returned
+// error is the list of policy.Diagnostics reported during compilation.
 func (pc *PolicyCompiler[R]) Compile(paths ...string) ([]policy.Rule[R], []policy.Filter[R], error) {
 	if err := pc.compile(paths, pc.configPath); err != nil {
 		return nil, nil, err
 	}
+	pc.diags.Log()
+	if pc.diags.HasErrors() {
+		return nil, nil, pc.diags
+	}
 	return pc.rules, nil, nil
 }
 
+// Summary returns the summary of the last compilation.
+func (pc *PolicyCompiler[R]) Summary() policy.Summary {
+	return policy.Summary{Rules: len(pc.rules), Diagnostics: pc.diags}
+}
+
+// Lookups returns the lookup lists of placeholders loaded in the last compilation, in alphabetical order.
+func (pc *PolicyCompiler[R]) Lookups() []*policy.Lookup {
+	names := make([]string, 0, len(pc.lookups))
+	for name := range pc.lookups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	lookups := make([]*policy.Lookup, 0, len(names))
+	for _, name := range names {
+		lookups = append(lookups, pc.lookups[name])
+	}
+	return lookups
+}
+
+// loadLookups loads the placeholders of the Sigma config whose single value is a map of lookup attributes
+// (path, format, column, reload). Relative lookup paths are resolved against the directory of the config.
+func (pc *PolicyCompiler[R]) loadLookups(configPath string) {
+	pc.lookups = make(map[string]*policy.Lookup)
+	names := make([]string, 0, len(pc.sigmaConfig.Placeholders))
+	for name := range pc.sigmaConfig.Placeholders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pc.path, pc.rule = configPath, ""
+	for _, name := range names {
+		subs := pc.sigmaConfig.Placeholders[name]
+		if len(subs) != 1 {
+			continue
+		}
+		attrs, ok := subs[0].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var path, format string
+		var column int
+		var interval time.Duration
+		var err error
+		for k, v := range attrs {
+			s := fmt.Sprintf("%v", v)
+			switch k {
+			case lookupPath:
+				path = s
+			case lookupFormat:
+				format = strings.ToLower(s)
+			case lookupColumn:
+				if column, err = strconv.Atoi(s); err != nil {
+					err = fmt.Errorf("invalid lookup column %s", s)
+				}
+			case lookupReload:
+				if interval, err = common.ParseDuration(s); err != nil {
+					err = fmt.Errorf("invalid lookup reload interval %s", s)
+				}
+			default:
+				pc.warnf("unrecognized attribute %s of lookup placeholder %%%s%%", k, name)
+			}
+			if err != nil {
+				break
+			}
+		}
+		if err == nil && path == "" {
+			err = fmt.Errorf("lookup placeholder %%%s%% does not define a path", name)
+		}
+		if err != nil {
+			pc.errorf("%v", err)
+			continue
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(configPath), path)
+		}
+		l, err := policy.NewLookup(name, path, format, column, interval)
+		if err != nil {
+			pc.errorf("%v", err)
+			continue
+		}
+		pc.lookups[name] = l
+	}
+}
+
+// errorf reports a compilation error for the rule being compiled.
+func (pc *PolicyCompiler[R]) errorf(format string, args ...interface{}) {
+	pc.diags.Add(policy.Diagnostic{File: pc.path, Rule: pc.rule, Severity: policy.SeverityError, Msg: fmt.Sprintf(format, args...)})
+}
+
+// warnf reports a compilation warning for the rule being compiled.
+func (pc *PolicyCompiler[R]) warnf(format string, args ...interface{}) {
+	pc.diags.Add(policy.Diagnostic{File: pc.path, Rule: pc.rule, Severity: policy.SeverityWarning, Msg: fmt.Sprintf(format, args...)})
+}
+
+// first returns criterion c, or reports err and returns a false criterion if err is not nil.
+func (pc *PolicyCompiler[R]) first(c policy.Criterion[R], err error) policy.Criterion[R] {
+	if err != nil {
+		pc.errorf("%v", err)
+		return policy.False[R]()
+	}
+	return c
+}
+
 func (pc *PolicyCompiler[R]) getTags(rule sigma.Rule) []policy.EnrichmentTag {
 	tags := make([]policy.EnrichmentTag, len(rule.Tags))
 	for i, v := range rule.Tags {
@@ -140,6 +340,106 @@ func (pc *PolicyCompiler[R]) getPriority(rule sigma.Rule) policy.Priority {
 	return policy.Informational
 }
 
+func (pc *PolicyCompiler[R]) visitCorrelation(rule sigma.Rule, c *Correlation, refs map[string]policy.Criterion[R]) (policy.Rule[R], error) {
+	r := policy.Rule[R]{
+		Name:     rule.ID,
+		Desc:     rule.Description,
+		Tags:     pc.getTags(rule),
+		Priority: pc.getPriority(rule),
+		Enabled:  true,
+		Source:   policy.Source{Language: Language, File: pc.path},
+	}
+	switch c.Type {
+	case TemporalOrdered:
+		seq := &policy.Sequence[R]{GroupBy: make([]string, 0)}
+		for _, ref := range c.Rules {
+			cond, ok := refs[ref]
+			if !ok {
+				return r, fmt.Errorf("undefined rule reference %s", ref)
+			}
+			seq.Steps = append(seq.Steps, cond)
+		}
+		for _, attr := range c.GroupBy {
+			seq.GroupBy = append(seq.GroupBy, pc.mapField(attr))
+		}
+		if c.Timespan != "" {
+			d, err := common.ParseDuration(c.Timespan)
+			if err != nil {
+				return r, fmt.Errorf("invalid timespan %s", c.Timespan)
+			}
+			seq.Window = d
+		}
+		r.Sequence = seq
+		r.Condition = policy.Any(seq.Steps)
+		return r, nil
+	case EventCount, ValueCount:
+		var preds []policy.Criterion[R]
+		for _, ref := range c.Rules {
+			cond, ok := refs[ref]
+			if !ok {
+				return r, fmt.Errorf("undefined rule reference %s", ref)
+			}
+			preds = append(preds, cond)
+		}
+		count, err := c.Condition.count()
+		if err != nil {
+			return r, err
+		}
+		th := &policy.Threshold{Count: count, GroupBy: make([]string, 0)}
+		for _, attr := range c.GroupBy {
+			th.GroupBy = append(th.GroupBy, pc.mapField(attr))
+		}
+		if c.Type == ValueCount {
+			if c.Condition.Field == "" {
+				return r, fmt.Errorf("missing field in value_count condition")
+			}
+			th.Distinct = pc.mapField(c.Condition.Field)
+		}
+		if c.Timespan != "" {
+			d, err := common.ParseDuration(c.Timespan)
+			if err != nil {
+				return r, fmt.Errorf("invalid timespan %s", c.Timespan)
+			}
+			th.Window = d
+		}
+		r.Condition = policy.Any(preds)
+		r.Threshold = th
+		return r, nil
+	}
+	return r, fmt.Errorf("unsupported correlation type %s", c.Type)
+}
+
+// visitAggregation translates a Sigma aggregation expression, e.g., count(field) by attr > N, into a threshold.
+func (pc *PolicyCompiler[R]) visitAggregation(agg sigma.AggregationExpr, timeframe time.Duration) (*policy.Threshold, error) {
+	cmp, ok := agg.(sigma.Comparison)
+	if !ok {
+		return nil, fmt.Errorf("unsupported aggregation %T", agg)
+	}
+	f, ok := cmp.Func.(sigma.Count)
+	if !ok {
+		return nil, fmt.Errorf("unsupported aggregation function %T", cmp.Func)
+	}
+	th := &policy.Threshold{GroupBy: make([]string, 0), Window: timeframe}
+	if f.GroupedBy != "" {
+		th.GroupBy = append(th.GroupBy, pc.mapField(f.GroupedBy))
+	}
+	if f.Field != "" {
+		th.Distinct = pc.mapField(f.Field)
+	}
+	switch cmp.Op {
+	case sigma.GreaterThan:
+		th.Count = int(cmp.Threshold) + 1
+	case sigma.GreaterThanEqual:
+		th.Count = int(cmp.Threshold)
+	default:
+		return nil, fmt.Errorf("unsupported aggregation operator %s", cmp.Op)
+	}
+	if th.Count < 1 {
+		th.Count = 1
+	}
+	return th, nil
+}
+
 func (pc *PolicyCompiler[R]) visitSearchExpression(condition sigma.SearchExpr, searches map[string]sigma.Search) policy.Criterion[R] {
 
 	switch c := condition.(type) {
@@ -205,94 +505,328 @@ func (pc *PolicyCompiler[R]) visitSearchExpression(condition sigma.SearchExpr, s
 }
 
 func (pc *PolicyCompiler[R]) visitSearch(search sigma.Search) policy.Criterion[R] {
-
+	var matcherPreds []policy.Criterion[R]
 	if len(search.Keywords) > 0 {
-		logger.Warn.Println("Keyword search is not supported. Use field selectors instead.")
-		return policy.False[R]()
+		matcherPreds = append(matcherPreds, pc.visitKeywords(search.Keywords))
 	}
-
-	var matcherPreds []policy.Criterion[R]
 	for _, eventMatcher := range search.EventMatchers {
 		for _, fieldMatcher := range eventMatcher {
-			var fieldPreds policy.Criterion[R]
-			allValuesMustMatch := false
-			var transformers []TransformerFlags
-			var comparators []FieldModifier
-			for _, modifier := range fieldMatcher.Modifiers {
-				m := FieldModifier(modifier)
-				if m == All {
-					allValuesMustMatch = true
-				}
-				if m.IsTransformer() {
-					transformers = append(transformers, TransformersMap[m]...)
-				}
-				if m.IsComparator() {
-					comparators = append(comparators, m)
+			matcherPreds = append(matcherPreds, pc.visitFieldMatcher(fieldMatcher))
+		}
+	}
+	return policy.All(matcherPreds)
+}
+
+// visitKeywords translates a keyword search, which matches if any keyword is contained in any keyword field.
+func (pc *PolicyCompiler[R]) visitKeywords(keywords []string) policy.Criterion[R] {
+	var preds []policy.Criterion[R]
+	for _, attr := range pc.keywordFields {
+		pc.checkField(attr)
+		preds = append(preds, pc.first(pc.ops.FoldAny(attr, keywords, source.IContains)))
+	}
+	return policy.Any(preds)
+}
+
+// matchOptions holds the modifiers of a field matcher.
+type matchOptions struct {
+	all         bool
+	cased       bool
+	fieldref    bool
+	expand      bool
+	comparators []FieldModifier
+	reFlags     string
+	transforms  []TransformerFlags
+}
+
+// parseModifiers parses the modifiers of a field matcher. Transformers are combined into the alternative
+// sets of transformations applied to each value; e.g., windash|base64 applies base64 to the value and
+// to its windash variant.
+func (pc *PolicyCompiler[R]) parseModifiers(modifiers []string) matchOptions {
+	opts := matchOptions{transforms: []TransformerFlags{NoFlags}}
+	for _, modifier := range modifiers {
+		m := parseModifier(modifier)
+		switch {
+		case m == All:
+			opts.all = true
+		case m == Cased:
+			opts.cased = true
+		case m == FieldRef:
+			opts.fieldref = true
+		case m == Expand:
+			opts.expand = true
+		case m.IsComparator():
+			opts.comparators = append(opts.comparators, m)
+		case m.IsRegExpFlag():
+			opts.reFlags += RegExpFlagsMap[m]
+		case m.IsTransformer():
+			var transforms []TransformerFlags
+			for _, t := range opts.transforms {
+				for _, f := range TransformersMap[m] {
+					transforms = append(transforms, t.Set(f))
 				}
 			}
-			var valuePreds []policy.Criterion[R]
-			for _, value := range fieldMatcher.Values {
-				if len(transformers) > 0 {
-					var tPreds []policy.Criterion[R]
-					for _, t := range transformers {
-						values, _ := pc.transformer.Transform(value, t)
-						for _, v := range values {
-							tPreds = append(tPreds, pc.visitTerm(comparators, fieldMatcher.Field, v))
-						}
-					}
-					valuePreds = append(valuePreds, policy.Any(tPreds))
-				} else {
-					valuePreds = append(valuePreds, pc.visitTerm(comparators, fieldMatcher.Field, value))
-				}
+			opts.transforms = transforms
+		default:
+			pc.warnf("unsupported modifier %s", modifier)
+		}
+	}
+	return opts
+}
+
+// visitFieldMatcher translates a field matcher, which matches if any value (or all values, with the all
+// modifier) matches the field. A value matches if any of its expansions and transformations matches.
+func (pc *PolicyCompiler[R]) visitFieldMatcher(fm sigma.FieldMatcher) policy.Criterion[R] {
+	opts := pc.parseModifiers(fm.Modifiers)
+	if opts.reFlags != "" && !opts.hasComparator(RegExp) {
+		pc.warnf("regular expression flags require the re modifier")
+	}
+	var valuePreds []policy.Criterion[R]
+	for _, value := range fm.Values {
+		values := []string{value}
+		if opts.expand {
+			if l, ok := pc.lookupPlaceholder(value); ok {
+				valuePreds = append(valuePreds, pc.visitLookup(opts, fm.Field, l))
+				continue
 			}
-			if allValuesMustMatch {
-				fieldPreds = policy.All(valuePreds)
-			} else {
-				fieldPreds = policy.Any(valuePreds)
+			values = pc.expand(value)
+		}
+		var tPreds []policy.Criterion[R]
+		for _, v := range values {
+			for _, t := range opts.transforms {
+				tvalues, err := pc.transformer.Transform(v, t)
+				if err != nil {
+					pc.errorf("could not transform value %s: %v", v, err)
+					continue
+				}
+				for _, tv := range tvalues {
+					tPreds = append(tPreds, pc.visitTerm(opts, fm.Field, tv))
+				}
 			}
-			matcherPreds = append(matcherPreds, fieldPreds)
 		}
+		valuePreds = append(valuePreds, policy.Any(tPreds))
 	}
-	return policy.All(matcherPreds)
+	if opts.all {
+		return policy.All(valuePreds)
+	}
+	return policy.Any(valuePreds)
+}
+
+// lookupPlaceholder returns the lookup list of value if it consists of a single lookup placeholder.
+func (pc *PolicyCompiler[R]) lookupPlaceholder(value string) (*policy.Lookup, bool) {
+	if len(value) < 3 || value[0] != '%' || value[len(value)-1] != '%' {
+		return nil, false
+	}
+	l, ok := pc.lookups[value[1:len(value)-1]]
+	return l, ok
+}
+
+// visitLookup translates a field matcher over a lookup placeholder, which matches if any value of the lookup
+// matches the field. Only comparator modifiers are supported, since lookup values change after compilation.
+func (pc *PolicyCompiler[R]) visitLookup(opts matchOptions, attr string, l *policy.Lookup) policy.Criterion[R] {
+	attr = pc.mapField(attr)
+	pc.checkField(attr)
+	lops, ok := pc.ops.(source.LookupOperations[R])
+	if !ok {
+		pc.errorf("lookup placeholders are not supported by the policy source")
+		return policy.False[R]()
+	}
+	if opts.fieldref || len(opts.transforms) > 1 || opts.transforms[0] != NoFlags {
+		pc.errorf("lookup placeholder %%%s%% only supports comparator modifiers", l.Name)
+		return policy.False[R]()
+	}
+	ops := []source.Operator{opts.strOp(source.Eq, source.IEq)}
+	if len(opts.comparators) > 0 {
+		ops = ops[:0]
+	}
+	for _, m := range opts.comparators {
+		switch m {
+		case Contains:
+			ops = append(ops, opts.strOp(source.Contains, source.IContains))
+		case StartsWith:
+			ops = append(ops, opts.strOp(source.Startswith, source.IStartswith))
+		case EndsWith:
+			ops = append(ops, opts.strOp(source.Endswith, source.IEndswith))
+		case CIDR:
+			ops = append(ops, source.InCIDR)
+		default:
+			pc.errorf("modifier %s is not supported with lookup placeholder %%%s%%", m, l.Name)
+			return policy.False[R]()
+		}
+	}
+	var opPreds []policy.Criterion[R]
+	for _, op := range ops {
+		opPreds = append(opPreds, pc.first(lops.FoldLookup(attr, l, op)))
+	}
+	return policy.All(opPreds)
 }
 
-func (pc *PolicyCompiler[R]) visitTerm(ops []FieldModifier, attr string, value string) policy.Criterion[R] {
+func (o matchOptions) hasComparator(m FieldModifier) bool {
+	for _, c := range o.comparators {
+		if c == m {
+			return true
+		}
+	}
+	return false
+}
+
+// strOp returns the string operator op, or its case-insensitive variant unless matching is case-sensitive.
+func (o matchOptions) strOp(op source.Operator, iop source.Operator) source.Operator {
+	if o.cased {
+		return op
+	}
+	return iop
+}
+
+func (pc *PolicyCompiler[R]) visitTerm(opts matchOptions, attr string, value string) policy.Criterion[R] {
 	var opPreds []policy.Criterion[R]
 
 	// apply field mappings
-	if pc.sigmaConfig.FieldMappings != nil {
-		if mattr, ok := pc.sigmaConfig.FieldMappings[attr]; ok {
-			attr = mattr.TargetNames[0]
+	attr = pc.mapField(attr)
+	kind := pc.checkField(attr)
+
+	// values of field references are attributes
+	if opts.fieldref {
+		value = pc.mapField(value)
+		if schema, ok := pc.ops.(source.Schema); ok {
+			if _, ok := schema.Field(value); !ok {
+				pc.warnf("unknown field reference %s", value)
+				return policy.False[R]()
+			}
 		}
 	}
 
 	// build predicate expression
-	if len(ops) == 0 {
-		opPreds = append(opPreds, policy.First(pc.ops.Compare(attr, value, source.IEq)))
+	if len(opts.comparators) == 0 {
+		opPreds = append(opPreds, pc.first(pc.ops.Compare(attr, value, opts.strOp(source.Eq, source.IEq))))
 	} else {
-		for _, op := range ops {
+		for _, op := range opts.comparators {
+			if (op == Lt || op == Lte || op == Gt || op == Gte) && kind == source.StrKind {
+				pc.errorf("modifier %s cannot be applied to string field %s", op, attr)
+				continue
+			}
 			switch op {
 			case Contains:
-				opPreds = append(opPreds, policy.First(pc.ops.Compare(attr, value, source.IContains)))
+				opPreds = append(opPreds, pc.first(pc.ops.Compare(attr, value, opts.strOp(source.Contains, source.IContains))))
 			case StartsWith:
-				opPreds = append(opPreds, policy.First(pc.ops.Compare(attr, value, source.IStartswith)))
+				opPreds = append(opPreds, pc.first(pc.ops.Compare(attr, value, opts.strOp(source.Startswith, source.IStartswith))))
 			case EndsWith:
-				opPreds = append(opPreds, policy.First(pc.ops.Compare(attr, value, source.IEndswith)))
+				opPreds = append(opPreds, pc.first(pc.ops.Compare(attr, value, opts.strOp(source.Endswith, source.IEndswith))))
 			case RegExp:
-				opPreds = append(opPreds, policy.First(pc.ops.RegExp(attr, value)))
+				if opts.reFlags != "" {
+					value = "(?" + opts.reFlags + ")" + value
+				}
+				opPreds = append(opPreds, pc.first(pc.ops.RegExp(attr, value)))
+			case CIDR:
+				opPreds = append(opPreds, pc.first(pc.cidr(attr, value)))
+			case Exists:
+				opPreds = append(opPreds, pc.first(pc.exists(attr, value)))
 			case Lt:
-				opPreds = append(opPreds, policy.First(pc.ops.Compare(attr, value, source.Lt)))
+				opPreds = append(opPreds, pc.first(pc.ops.Compare(attr, value, source.Lt)))
 			case Lte:
-				opPreds = append(opPreds, policy.First(pc.ops.Compare(attr, value, source.LEq)))
+				opPreds = append(opPreds, pc.first(pc.ops.Compare(attr, value, source.LEq)))
 			case Gt:
-				opPreds = append(opPreds, policy.First(pc.ops.Compare(attr, value, source.Gt)))
+				opPreds = append(opPreds, pc.first(pc.ops.Compare(attr, value, source.Gt)))
 			case Gte:
-				opPreds = append(opPreds, policy.First(pc.ops.Compare(attr, value, source.GEq)))
-			default:
-				logger.Error.Printf("Unsupported operator %s", op)
+				opPreds = append(opPreds, pc.first(pc.ops.Compare(attr, value, source.GEq)))
 			}
 		}
 	}
 
 	return policy.All(opPreds)
 }
+
+// exists creates a criterion checking whether attr is set, or unset if value is false.
+func (pc *PolicyCompiler[R]) exists(attr string, value string) (policy.Criterion[R], error) {
+	set, err := strconv.ParseBool(value)
+	if err != nil {
+		return policy.False[R](), fmt.Errorf("invalid exists value %s", value)
+	}
+	c, err := pc.ops.Exists(attr)
+	if err != nil || set {
+		return c, err
+	}
+	return c.Not(), nil
+}
+
+// cidr creates a criterion checking whether the IP address of attr is in the network denoted by value.
+// Sources supporting network predicates match IP addresses natively.
+func (pc *PolicyCompiler[R]) cidr(attr string, value string) (policy.Criterion[R], error) {
+	if ops, ok := pc.ops.(source.NetOperations[R]); ok {
+		return ops.InCIDR(attr, []string{value})
+	}
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(value))
+	if err != nil {
+		return policy.False[R](), fmt.Errorf("invalid CIDR %s", value)
+	}
+	prefix = prefix.Masked()
+	m := pc.ops.MapStr(attr)
+	p := func(r R) bool {
+		for _, s := range strings.Split(m(r), common.LISTSEP) {
+			if ip, err := netip.ParseAddr(s); err == nil && prefix.Contains(ip.Unmap()) {
+				return true
+			}
+		}
+		return false
+	}
+	return policy.Leaf(source.LeafKey("CIDR", attr, prefix.String()), cidrEstimate, p), nil
+}
+
+// expand resolves the placeholders (%name%) of value with the placeholder values of the Sigma
+// config, and returns all resulting values.
+func (pc *PolicyCompiler[R]) expand(value string) []string {
+	values := []string{""}
+	for rest := value; ; {
+		i := strings.Index(rest, "%")
+		j := -1
+		if i >= 0 {
+			j = strings.Index(rest[i+1:], "%")
+		}
+		if j < 0 {
+			for k := range values {
+				values[k] += rest
+			}
+			return values
+		}
+		name := rest[i+1 : i+1+j]
+		subs, ok := pc.sigmaConfig.Placeholders[name]
+		if !ok {
+			pc.warnf("undefined placeholder %%%s%%", name)
+			return nil
+		}
+		if _, ok := pc.lookups[name]; ok {
+			pc.errorf("lookup placeholder %%%s%% must be the whole value", name)
+			return nil
+		}
+		var expanded []string
+		for _, v := range values {
+			for _, sub := range subs {
+				expanded = append(expanded, v+rest[:i]+fmt.Sprintf("%v", sub))
+			}
+		}
+		values = expanded
+		rest = rest[i+j+2:]
+	}
+}
+
+// checkField warns if attr is not an attribute of the source schema, and returns its kind.
+func (pc *PolicyCompiler[R]) checkField(attr string) source.FieldKind {
+	schema, ok := pc.ops.(source.Schema)
+	if !ok {
+		return source.AnyKind
+	}
+	kind, ok := schema.Field(attr)
+	if !ok {
+		pc.warnf("unknown field %s", attr)
+	}
+	return kind
+}
+
+// mapField applies field mappings to a Sigma field name.
+func (pc *PolicyCompiler[R]) mapField(attr string) string {
+	if pc.sigmaConfig.FieldMappings != nil {
+		if mattr, ok := pc.sigmaConfig.FieldMappings[attr]; ok {
+			return mattr.TargetNames[0]
+		}
+	}
+	return attr
+}
@@ -21,14 +21,19 @@
 package sigma_test
 
 import (
+	"encoding/base64"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/sysflow-telemetry/sf-apis/go/ioutils"
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy/sigma"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord/flatrecordtest"
 )
 
 var configPath string = "../../../../resources/policies/sigma/config/sysflow.yml"
@@ -46,3 +51,102 @@ func TestCompiler(t *testing.T) {
 	_, _, err = pc.Compile(paths...)
 	assert.NoError(t, err)
 }
+
+func TestCompileCorrelation(t *testing.T) {
+	pc := sigma.NewPolicyCompiler(flatrecord.NewOperations(), configPath)
+	paths, err := ioutils.ListFilePaths("../../../../resources/policies/tests/sigma", ".yml")
+	assert.NoError(t, err)
+	rules, _, err := pc.Compile(paths...)
+	assert.NoError(t, err)
+	assert.Len(t, rules, 5)
+	var seq *policy.Sequence[*flatrecord.Record]
+	ths := make(map[string]*policy.Threshold)
+	sups := make(map[string]*policy.Suppression)
+	for _, r := range rules {
+		if r.Suppress != nil {
+			sups[r.Desc] = r.Suppress
+		}
+		if r.Sequence != nil {
+			seq = r.Sequence
+		}
+		if r.Threshold != nil {
+			ths[r.Desc] = r.Threshold
+		}
+	}
+	assert.NotNil(t, seq)
+	assert.Len(t, seq.Steps, 2)
+	assert.Equal(t, []string{"sf.proc.pid"}, seq.GroupBy)
+	assert.Equal(t, 30*time.Second, seq.Window)
+	assert.Len(t, ths, 2)
+	assert.Equal(t, &policy.Threshold{Count: 21, GroupBy: []string{"sf.proc.pid"}, Window: time.Minute, Distinct: "sf.net.dip"}, ths["unit test aggregation"])
+	assert.Equal(t, &policy.Threshold{Count: 5, GroupBy: []string{"sf.pproc.pid"}, Window: 10 * time.Second}, ths["unit test event count correlation"])
+	assert.Equal(t, map[string]*policy.Suppression{"unit test shell execution": {GroupBy: []string{"sf.proc.exe"}, Window: 5 * time.Minute}}, sups)
+}
+
+func TestModifiers(t *testing.T) {
+	dir := "../../../../resources/policies/tests/sigma/modifiers"
+	pc := sigma.NewPolicyCompiler(flatrecord.NewOperations(), dir+"/config/sysflow.yml")
+	paths, err := ioutils.ListFilePaths(dir, ".yml")
+	assert.NoError(t, err)
+	rules, _, err := pc.Compile(paths...)
+	assert.NoError(t, err)
+	assert.Empty(t, pc.(policy.Summarizer).Summary().Diagnostics)
+	conds := make(map[string]policy.Criterion[*flatrecord.Record])
+	for _, r := range rules {
+		conds[r.Name] = r.Condition
+	}
+	assert.Len(t, conds, 9)
+	assert.Len(t, pc.(policy.LookupProvider).Lookups(), 2)
+
+	exec := func(exe string, args string, user string) *flatrecord.Record {
+		fr := flatrecordtest.NewFlatRecord(sfgo.PROC_EVT)
+		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXE_STR] = exe
+		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXEARGS_STR] = args
+		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_USERNAME_STR] = user
+		return flatrecord.NewRecord(fr)
+	}
+	connect := func(a, b, c, d int64) *flatrecord.Record {
+		fr := flatrecordtest.NewFlatRecord(sfgo.NET_FLOW)
+		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.FL_NETW_DIP_INT] = a | b<<8 | c<<16 | d<<24
+		return flatrecord.NewRecord(fr)
+	}
+	write := func(exe string, path string) *flatrecord.Record {
+		fr := flatrecordtest.NewFlatRecord(sfgo.FILE_EVT)
+		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXE_STR] = exe
+		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.FILE_PATH_STR] = path
+		return flatrecord.NewRecord(fr)
+	}
+	encoded := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+
+	for _, tc := range []struct {
+		rule    string
+		r       *flatrecord.Record
+		matched bool
+	}{
+		{"test-cidr", connect(10, 1, 2, 3), true},
+		{"test-cidr", connect(192, 168, 7, 1), true},
+		{"test-cidr", connect(172, 16, 0, 1), false},
+		{"test-exists", exec("/usr/sbin/cron", "", ""), true},
+		{"test-exists", exec("/usr/sbin/cron", "", "root"), false},
+		{"test-fieldref", write("/usr/bin/agent", "/usr/bin/agent"), true},
+		{"test-fieldref", write("/usr/bin/agent", "/tmp/agent"), false},
+		{"test-expand", exec("/bin/sh", "", "root"), true},
+		{"test-expand", exec("/bin/BASH", "", "root"), true},
+		{"test-expand", exec("/bin/zsh", "", "root"), false},
+		{"test-re", exec("/usr/bin/curl", "http://evil.example", "root"), true},
+		{"test-re", exec("/usr/bin/wget", "http://evil.example", "root"), false},
+		{"test-cased", exec("/usr/bin/Python3", "", "root"), true},
+		{"test-cased", exec("/usr/bin/python3", "", "root"), false},
+		{"test-base64offset", exec("/bin/sh", "-c echo "+encoded("curl http://evil.example"), "root"), true},
+		{"test-base64offset", exec("/bin/sh", "-c echo "+encoded(" curl http://evil.example"), "root"), true},
+		{"test-base64offset", exec("/bin/sh", "-c echo "+encoded("  curl http://good.example"), "root"), false},
+		{"test-keywords", exec("/usr/bin/nc", "-v -l 4444", "root"), false},
+		{"test-keywords", exec("/usr/bin/nc", "-l 4444", "root"), true},
+		{"test-lookup", connect(203, 0, 113, 5), true},
+		{"test-lookup", connect(10, 1, 2, 3), false},
+		{"test-lookup", exec("/opt/bin/usr/bin/HYDRA", "", "root"), true},
+		{"test-lookup", exec("/usr/bin/nc", "", "root"), false},
+	} {
+		assert.Equal(t, tc.matched, conds[tc.rule].Eval(tc.r), tc.rule)
+	}
+}
@@ -0,0 +1,97 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sigma implements a frontend for Sigma rules engine.
+package sigma
+
+import (
+	"fmt"
+
+	"github.com/bradleyjkemp/sigma-go"
+	"gopkg.in/yaml.v3"
+)
+
+// Sigma correlation rule attributes.
+const (
+	correlationAttr = "correlation"
+	nameAttr        = "name"
+)
+
+// CorrelationType type.
+type CorrelationType string
+
+// Sigma correlation types.
+const (
+	TemporalOrdered CorrelationType = "temporal_ordered"
+	EventCount      CorrelationType = "event_count"
+	ValueCount      CorrelationType = "value_count"
+)
+
+// Correlation defines a Sigma correlation rule, which combines the results of other
+// rules referenced by their names or identifiers.
+type Correlation struct {
+	Type      CorrelationType
+	Rules     []string
+	GroupBy   []string `yaml:"group-by"`
+	Timespan  string
+	Condition CorrelationCondition
+}
+
+// CorrelationCondition defines the count condition of event_count and value_count correlations.
+type CorrelationCondition struct {
+	Field string
+	Gt    *int
+	Gte   *int
+}
+
+// count returns the minimum count satisfying the condition.
+func (c CorrelationCondition) count() (int, error) {
+	switch {
+	case c.Gte != nil:
+		return *c.Gte, nil
+	case c.Gt != nil:
+		return *c.Gt + 1, nil
+	}
+	return 0, fmt.Errorf("unsupported correlation condition, expected gt or gte")
+}
+
+// getCorrelation extracts the correlation section from a Sigma rule, if present.
+func getCorrelation(rule sigma.Rule) (*Correlation, error) {
+	v, ok := rule.AdditionalFields[correlationAttr]
+	if !ok {
+		return nil, nil
+	}
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	c := new(Correlation)
+	if err := yaml.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// getName returns the name of a Sigma rule, used to reference it from correlation rules.
+func getName(rule sigma.Rule) string {
+	if name, ok := rule.AdditionalFields[nameAttr].(string); ok {
+		return name
+	}
+	return ""
+}
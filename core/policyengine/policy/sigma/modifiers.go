@@ -20,6 +20,8 @@
 // Package sigma implements a frontend for Sigma rules engine.
 package sigma
 
+import "strings"
+
 // FieldModifier type.
 type FieldModifier string
 
@@ -28,6 +30,9 @@ const (
 	// conjunctive modifier
 	All FieldModifier = "all"
 
+	// case-sensitive matching modifier
+	Cased FieldModifier = "cased"
+
 	// comparators
 	Contains   FieldModifier = "contains"
 	EndsWith   FieldModifier = "endswith"
@@ -36,17 +41,27 @@ const (
 	Lte        FieldModifier = "lte"
 	Gt         FieldModifier = "gt"
 	Gte        FieldModifier = "gte"
+	RegExp     FieldModifier = "re"
+	CIDR       FieldModifier = "cidr"
+	Exists     FieldModifier = "exists"
+
+	// value modifiers
+	FieldRef FieldModifier = "fieldref"
+	Expand   FieldModifier = "expand"
+
+	// regular expression flags
+	RegExpIgnoreCase FieldModifier = "i"
+	RegExpMultiLine  FieldModifier = "m"
+	RegExpDotAll     FieldModifier = "s"
 
 	// transformers
 	Base64       FieldModifier = "base64"
-	Base64Offset FieldModifier = "base64Offset"
+	Base64Offset FieldModifier = "base64offset"
 	UTF16        FieldModifier = "utf16"
 	UTF16LE      FieldModifier = "utf16le"
 	UTF16BE      FieldModifier = "utf16be"
 	Wide         FieldModifier = "wide"
 	WinDash      FieldModifier = "windash"
-	RegExp       FieldModifier = "re"
-	CIDR         FieldModifier = "cidr"
 )
 
 var exists = struct{}{}
@@ -59,17 +74,32 @@ var ComparatorsMap = map[FieldModifier]struct{}{
 	Lte:        exists,
 	Gt:         exists,
 	Gte:        exists,
+	RegExp:     exists,
+	CIDR:       exists,
+	Exists:     exists,
 }
 
+// TransformersMap maps transformers to the alternative transformations they apply to values.
 var TransformersMap = map[FieldModifier][]TransformerFlags{
 	Base64:       {Base64Flag},
 	Base64Offset: {Base64OffsetFlag},
-	UTF16:        {NoFlags},
-	UTF16LE:      {NoFlags},
-	UTF16BE:      {NoFlags},
-	Wide:         {NoFlags},
+	UTF16:        {UTF16Flag},
+	UTF16LE:      {UTF16LEFlag},
+	UTF16BE:      {UTF16BEFlag},
+	Wide:         {UTF16LEFlag},
 	WinDash:      {NoFlags, WinDashFlag},
-	CIDR:         {CIDRFlag},
+}
+
+// RegExpFlagsMap maps regular expression flags to their RE2 flags.
+var RegExpFlagsMap = map[FieldModifier]string{
+	RegExpIgnoreCase: "i",
+	RegExpMultiLine:  "m",
+	RegExpDotAll:     "s",
+}
+
+// parseModifier returns the field modifier named s. Modifier names are case-insensitive.
+func parseModifier(s string) FieldModifier {
+	return FieldModifier(strings.ToLower(s))
 }
 
 func (s FieldModifier) IsComparator() bool {
@@ -81,3 +111,8 @@ func (s FieldModifier) IsTransformer() bool {
 	_, ok := TransformersMap[s]
 	return ok
 }
+
+func (s FieldModifier) IsRegExpFlag() bool {
+	_, ok := RegExpFlagsMap[s]
+	return ok
+}
@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sigma implements a frontend for Sigma rules engine.
+package sigma
+
+import (
+	"github.com/bradleyjkemp/sigma-go"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/common"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"gopkg.in/yaml.v3"
+)
+
+// suppressAttr is the Sigma rule attribute defining the suppression of a rule's alerts.
+const suppressAttr = "suppress"
+
+// Suppression defines the suppression section of a Sigma rule.
+type Suppression struct {
+	GroupBy []string `yaml:"group-by"`
+	Window  string
+}
+
+// getSuppression extracts the suppression section from a Sigma rule, if present, and
+// translates it into a rule suppression with mapped field names.
+func (pc *PolicyCompiler[R]) getSuppression(rule sigma.Rule) *policy.Suppression {
+	v, ok := rule.AdditionalFields[suppressAttr]
+	if !ok {
+		return nil
+	}
+	s := new(Suppression)
+	b, err := yaml.Marshal(v)
+	if err == nil {
+		err = yaml.Unmarshal(b, s)
+	}
+	if err != nil {
+		pc.warnf("could not parse suppression: %v", err)
+		return nil
+	}
+	sup := &policy.Suppression{GroupBy: make([]string, 0)}
+	if sup.Window, err = common.ParseDuration(s.Window); err != nil {
+		pc.warnf("unrecognized window value %s, alerts will not be suppressed", s.Window)
+	}
+	for _, attr := range s.GroupBy {
+		sup.GroupBy = append(sup.GroupBy, pc.mapField(attr))
+	}
+	return sup
+}
@@ -22,7 +22,9 @@ package sigma
 
 import (
 	"encoding/base64"
+	"encoding/binary"
 	"strings"
+	"unicode/utf16"
 )
 
 // TransformerFlags defines a bitmap for transformer options.
@@ -36,7 +38,9 @@ const (
 	Base64Flag       TransformerFlags = 1 << iota // Base64 flag
 	Base64OffsetFlag                              // Base64 offset flag
 	WinDashFlag                                   // WinDash flag
-	CIDRFlag                                      // CIDR flag
+	UTF16Flag                                     // UTF-16 with byte order mark flag
+	UTF16LEFlag                                   // UTF-16 little-endian flag
+	UTF16BEFlag                                   // UTF-16 big-endian flag
 )
 
 // Set sets the bitmap flag.
@@ -55,6 +59,9 @@ func NewTransformer() *Transformer {
 	return &Transformer{}
 }
 
+// Transform applies the transformations set in flags to src, and returns the resulting values.
+// Transformations are applied in the order windash, UTF-16 encodings, and base64 encodings,
+// regardless of the order of the corresponding modifiers.
 func (s *Transformer) Transform(src string, flags TransformerFlags) (dst []string, err error) {
 	if flags == NoFlags {
 		return []string{src}, nil
@@ -62,41 +69,56 @@ func (s *Transformer) Transform(src string, flags TransformerFlags) (dst []strin
 	if flags.Has(WinDashFlag) {
 		return s.Transform(s.windash(src), flags.Clear(WinDashFlag))
 	}
-	if flags.Has(CIDRFlag) {
-		for _, cidr := range s.cidr(src) {
-			var r []string
-			r, err = s.Transform(cidr, flags.Clear(CIDRFlag))
-			if err != nil {
-				return
-			}
-			dst = append(dst, r...)
-		}
-		return
+	if flags.Has(UTF16Flag) {
+		return s.Transform(s.utf16(src, binary.LittleEndian, true), flags.Clear(UTF16Flag))
+	}
+	if flags.Has(UTF16LEFlag) {
+		return s.Transform(s.utf16(src, binary.LittleEndian, false), flags.Clear(UTF16LEFlag))
+	}
+	if flags.Has(UTF16BEFlag) {
+		return s.Transform(s.utf16(src, binary.BigEndian, false), flags.Clear(UTF16BEFlag))
 	}
 	if flags.Has(Base64Flag) {
-		dst = append(dst, s.base64(src, 0))
+		dst = append(dst, base64.StdEncoding.EncodeToString([]byte(src)))
 		return
 	}
 	if flags.Has(Base64OffsetFlag) {
-		dst = append(dst, s.base64(src, 0))
-		dst = append(dst, s.base64(src, 1))
-		dst = append(dst, s.base64(src, 2))
+		for offset := 0; offset < 3; offset++ {
+			if enc := s.base64Offset(src, offset); enc != "" {
+				dst = append(dst, enc)
+			}
+		}
 		return
 	}
 	return []string{src}, nil
 }
 
-func (s *Transformer) base64(src string, offset int) string {
-	if offset > 0 {
-		panic("Missing implementation for base64 offsets")
+// base64Offset encodes src as if it were preceded by offset bytes, and returns the part of the encoding
+// that only depends on src, so that it can be searched for in longer encoded strings.
+func (s *Transformer) base64Offset(src string, offset int) string {
+	startOffsets := [3]int{0, 2, 3}
+	endOffsets := [3]int{0, 3, 2}
+	enc := base64.StdEncoding.EncodeToString([]byte(strings.Repeat(" ", offset) + src))
+	start, end := startOffsets[offset], len(enc)-endOffsets[(len(src)+offset)%3]
+	if start >= end {
+		return ""
 	}
-	return base64.StdEncoding.EncodeToString([]byte(src))
+	return enc[start:end]
 }
 
 func (s *Transformer) windash(src string) string {
 	return strings.ReplaceAll(src, "-", "/")
 }
 
-func (s *Transformer) cidr(src string) []string {
-	panic("Missing implementation for CIDR transformer")
+// utf16 encodes src in UTF-16 with the given byte order, optionally preceded by a byte order mark.
+func (s *Transformer) utf16(src string, order binary.ByteOrder, bom bool) string {
+	units := utf16.Encode([]rune(src))
+	if bom {
+		units = append([]uint16{0xfeff}, units...)
+	}
+	b := make([]byte, 2*len(units))
+	for i, u := range units {
+		order.PutUint16(b[2*i:], u)
+	}
+	return string(b)
 }
@@ -49,3 +49,23 @@ func TestWinDashBase64(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, []string{"bXkvd2luZG93cy92YXJpYW50"}, v)
 }
+
+func TestBase64Offset(t *testing.T) {
+	transformer := NewTransformer()
+	v, err := transformer.Transform("http://", Base64OffsetFlag)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"aHR0cDovL", "h0dHA6Ly", "odHRwOi8v"}, v)
+}
+
+func TestUTF16(t *testing.T) {
+	transformer := NewTransformer()
+	v, err := transformer.Transform("ab", UTF16LEFlag)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a\x00b\x00"}, v)
+	v, err = transformer.Transform("ab", UTF16BEFlag)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"\x00a\x00b"}, v)
+	v, err = transformer.Transform("ab", UTF16Flag.Set(Base64Flag))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"//5hAGIA"}, v)
+}
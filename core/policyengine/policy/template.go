@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy implements input policy translation for the rules engine.
+package policy
+
+import "strings"
+
+// Template type
+//
+// A template is a rule output message in which %field placeholders are replaced by the
+// values of the corresponding record attributes. Templates are compiled once into a list
+// of literal and attribute segments, and rendered for each matching record.
+type Template[R any] struct {
+	Format   string
+	segments []segment[R]
+}
+
+// segment is either a literal string or an attribute value mapper.
+type segment[R any] struct {
+	lit   string
+	value func(r R) string
+}
+
+// NewTemplate compiles a template from a format string. Placeholders start with '%' and
+// extend over the characters allowed in attribute names; "%%" denotes a literal '%'.
+func NewTemplate[R any](format string, mapper func(attr string) func(r R) string) *Template[R] {
+	t := &Template[R]{Format: format}
+	var lit strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			lit.WriteByte(format[i])
+			continue
+		}
+		if i+1 < len(format) && format[i+1] == '%' {
+			lit.WriteByte('%')
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(format) && isAttrChar(format[j]) {
+			j++
+		}
+		// trailing periods are punctuation rather than part of the attribute name
+		for j > i+1 && format[j-1] == '.' {
+			j--
+		}
+		if j == i+1 {
+			lit.WriteByte('%')
+			continue
+		}
+		if lit.Len() > 0 {
+			t.segments = append(t.segments, segment[R]{lit: lit.String()})
+			lit.Reset()
+		}
+		t.segments = append(t.segments, segment[R]{value: mapper(format[i+1 : j])})
+		i = j - 1
+	}
+	if lit.Len() > 0 {
+		t.segments = append(t.segments, segment[R]{lit: lit.String()})
+	}
+	return t
+}
+
+// Render renders the template for record r.
+func (t *Template[R]) Render(r R) string {
+	var sb strings.Builder
+	for _, s := range t.segments {
+		if s.value != nil {
+			sb.WriteString(s.value(r))
+		} else {
+			sb.WriteString(s.lit)
+		}
+	}
+	return sb.String()
+}
+
+// isAttrChar checks whether c can be part of an attribute name.
+func isAttrChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '.' || c == '_' || c == '[' || c == ']'
+}
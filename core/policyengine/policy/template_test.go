@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy implements input policy translation for the rules engine.
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplate(t *testing.T) {
+	attrs := map[string]string{"sf.proc.name": "bash", "sf.container.id": "abc", "sf.proc.args[0]": "-c"}
+	mapper := func(attr string) func(r any) string {
+		return func(r any) string { return attrs[attr] }
+	}
+	tpl := NewTemplate("Shell %sf.proc.name (%sf.proc.args[0]) in %sf.container.id.", mapper)
+	assert.Equal(t, "Shell bash (-c) in abc.", tpl.Render(nil))
+	tpl = NewTemplate("100%% of %sf.proc.name % done", mapper)
+	assert.Equal(t, "100% of bash % done", tpl.Render(nil))
+	tpl = NewTemplate("no placeholders", mapper)
+	assert.Equal(t, "no placeholders", tpl.Render(nil))
+}
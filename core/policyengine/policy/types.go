@@ -20,6 +20,12 @@
 // Package policy implements input policy translation for the rules engine.
 package policy
 
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
 // EnrichmentTag denotes the type for enrichment tags.
 type EnrichmentTag interface{}
 
@@ -40,6 +46,16 @@ func (p Priority) String() string {
 	return [...]string{"informational", "low", "medium", "high", "critical"}[p]
 }
 
+// ParsePriority parses the string representation of a priority.
+func ParsePriority(s string) (Priority, error) {
+	for p := Informational; p <= Critical; p++ {
+		if strings.EqualFold(s, p.String()) {
+			return p, nil
+		}
+	}
+	return Low, fmt.Errorf("unknown priority %s", s)
+}
+
 // Rule type
 type Rule[R any] struct {
 	Name      string
@@ -51,6 +67,53 @@ type Rule[R any] struct {
 	Prefilter []string
 	Enabled   bool
 	IsAlert   bool
+	Sequence  *Sequence[R]
+	Threshold *Threshold
+	Suppress  *Suppression
+	Output    *Template[R]
+	Source    Source
+}
+
+// Source type
+//
+// Source records the policy language and file in which a rule is defined.
+type Source struct {
+	Language string
+	File     string
+}
+
+// Sequence type
+//
+// A sequence rule matches when its steps are satisfied in order by records sharing the
+// same values for the GroupBy attributes, and the last step is observed within Window
+// of the first one (a zero window never expires).
+type Sequence[R any] struct {
+	Steps   []Criterion[R]
+	GroupBy []string
+	Window  time.Duration
+}
+
+// Threshold type
+//
+// A threshold rule matches when the number of records satisfying its condition, and sharing the
+// same values for the GroupBy attributes, reaches Count within a sliding Window (a zero window
+// never expires). If Distinct is set, only distinct values of that attribute are counted.
+type Threshold struct {
+	Count    int
+	GroupBy  []string
+	Window   time.Duration
+	Distinct string
+}
+
+// Suppression type
+//
+// A suppression deduplicates the alerts of a rule. Once the rule fires for records sharing the same
+// values for the GroupBy attributes, further alerts are suppressed for Window, and the number of
+// suppressed alerts is reported with the next alert fired after the window. A zero window disables
+// suppression.
+type Suppression struct {
+	GroupBy []string
+	Window  time.Duration
 }
 
 // Filter type
@@ -23,18 +23,20 @@ package policyengine
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
-	"github.com/sysflow-telemetry/sf-apis/go/ioutils"
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
 	"github.com/sysflow-telemetry/sf-apis/go/plugins"
 	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/control"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/monitor"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy/falco"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy/sigma"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/common"
 )
 
@@ -49,6 +51,13 @@ type PolicyEngine struct {
 	outCh         []chan *common.Record
 	config        engine.Config
 	policyMonitor monitor.PolicyMonitor[*common.Record]
+	draining      sync.WaitGroup
+	// Guards pi against reads from the control API, and rule overrides
+	mu        sync.RWMutex
+	overrides map[string]bool
+	// Receives interpreters reloaded through the control API when no policy monitor is used
+	reloadCh chan *engine.PolicyInterpreter[*common.Record]
+	ctl      *control.Server
 }
 
 // NewEventChan creates a new event record channel instance.
@@ -74,22 +83,27 @@ func (s *PolicyEngine) Register(pc plugins.SFPluginCache) {
 
 // Init initializes the plugin.
 func (s *PolicyEngine) Init(conf map[string]interface{}) (err error) {
-	s.config, _ = engine.CreateConfig(conf) // no err check, assuming defaults
+	if s.config, err = engine.CreateConfig(conf); err != nil {
+		return
+	}
+	s.reloadCh = make(chan *engine.PolicyInterpreter[*common.Record], 1)
 
+	// Remote policy monitors fetch policies into their own cache directory
+	noPolicies := s.config.PoliciesPath == sfgo.Zeros.String && !s.config.Monitor.IsRemote()
 	if s.config.Mode == engine.EnrichMode {
 		logger.Trace.Println("Setting policy engine in 'enrich' mode")
-		if s.config.PoliciesPath == sfgo.Zeros.String {
+		if noPolicies {
 			return
 		}
 	} else {
 		logger.Trace.Println("Setting policy engine in 'alert' mode")
-		if s.config.PoliciesPath == sfgo.Zeros.String {
+		if noPolicies {
 			return errors.New("configuration attribute 'policies' missing from policy engine plugin settings")
 		}
 	}
 
 	if s.config.Monitor == engine.NoneType {
-		s.pi, err = s.createPolicyInterpreter()
+		s.pi, err = s.createPolicyInterpreter(s.config.PoliciesPath)
 		if err != nil {
 			logger.Error.Printf("Unable to compile local policies from directory %s, %v", s.config.PoliciesPath, err)
 			return
@@ -113,6 +127,14 @@ func (s *PolicyEngine) Init(conf map[string]interface{}) (err error) {
 		}
 		s.policyMonitor.StartMonitor()
 	}
+
+	if s.config.ControlAddr != "" {
+		if s.ctl, err = control.NewServer(s.config.ControlAddr, s.config.ControlToken, s); err != nil {
+			logger.Error.Printf("Unable to start policy engine control API on %s, %v", s.config.ControlAddr, err)
+			return
+		}
+		s.ctl.Start()
+	}
 	return
 }
 
@@ -127,33 +149,27 @@ func (s *PolicyEngine) Process(ch []interface{}, wg *sync.WaitGroup) {
 	defer wg.Done()
 	logger.Trace.Println("Starting policy engine with capacity: ", cap(in))
 
-	// set start and expiration time for checking for new policy interpreter
-	start := time.Now()
-	expiration := start.Add(s.config.MonitorInterval)
+	// without a policy monitor, interpreters are only reloaded through the control API
+	interCh := s.reloadCh
+	if s.policyMonitor != nil {
+		interCh = s.policyMonitor.GetInterpreterChan()
+	}
 
 	lastPerfTs := time.Now()
 	for {
-		if fc, ok := <-in; ok {
+		select {
+		// swap in new policy interpreters as soon as they are compiled (only happens when policies change)
+		case pi := <-interCh:
+			s.swap(pi)
+		case fc, ok := <-in:
+			if !ok {
+				logger.Trace.Println("Input channel closed. Shutting down.")
+				return
+			}
 			if s.pi == nil {
 				s.bypassPolicyEngine(fc)
 				continue
 			}
-			if s.policyMonitor != nil {
-				now := time.Now()
-				// check if another policy interpreter has been compiled (only happens when there are changes to the policy directory)
-				if now.After(expiration) {
-					select {
-					case pi := <-s.policyMonitor.GetInterpreterChan():
-						logger.Info.Println("Updated policy interpreter in main policy engine thread.")
-						// stop workers from old policy interpreter before assigning new one
-						s.pi.StopWorkers()
-						pi.StartWorkers()
-						s.pi = pi
-					default:
-					}
-					expiration = now.Add(s.config.MonitorInterval)
-				}
-			}
 			// Log the number of queued input elements
 			if logger.IsEnabled(logger.Perf) && time.Since(lastPerfTs) > 15*time.Second {
 				logger.Perf.Printf("Policy engine input channel queue: %d", len(in))
@@ -161,38 +177,50 @@ func (s *PolicyEngine) Process(ch []interface{}, wg *sync.WaitGroup) {
 			}
 			// Process record in interpreter's worker pool
 			s.processAsync(fc)
-		} else {
-			logger.Trace.Println("Input channel closed. Shutting down.")
-			break
 		}
 	}
 }
 
-// Creates a policy interpreter from configuration.
-func (s *PolicyEngine) createPolicyInterpreter() (*engine.PolicyInterpreter[*common.Record], error) {
-	dir := s.config.PoliciesPath
+// swap replaces the policy interpreter with pi. The workers of the previous interpreter are stopped in
+// the background, so that the records queued to them are processed without blocking ingestion.
+func (s *PolicyEngine) swap(pi *engine.PolicyInterpreter[*common.Record]) {
+	s.mu.Lock()
+	for name, enabled := range s.overrides {
+		pi.SetRuleEnabled(name, enabled)
+	}
+	pi.StartWorkers()
+	old := s.pi
+	s.pi = pi
+	s.mu.Unlock()
+	logger.Info.Println("Updated policy interpreter in main policy engine thread.")
+	if old == nil {
+		return
+	}
+	s.draining.Add(1)
+	go func() {
+		defer s.draining.Done()
+		old.StopWorkers()
+	}()
+}
 
+// Creates a policy interpreter from configuration for the policies found in dir.
+func (s *PolicyEngine) createPolicyInterpreter(dir string) (*engine.PolicyInterpreter[*common.Record], error) {
 	// check  policies
 	logger.Info.Println("Loading policies from: ", dir)
-	paths, err := ioutils.ListRecursiveFilePaths(dir, ".yaml", ".yml")
+	paths, err := engine.ListPolicies(dir)
 	if err != nil {
 		return nil, err
 	}
-	if len(paths) == 0 {
-		return nil, errors.New("no policy files with extension .yaml or .yml found in path: " + dir)
-	}
 
 	// build interpreter
 	logger.Info.Printf("Creating %s policy interpreter", s.config.Language.String())
-	var pc policy.PolicyCompiler[*common.Record]
-	if s.config.Language == engine.Falco {
-		pc = falco.NewPolicyCompiler(common.NewOperations())
-	} else {
-		pc = sigma.NewPolicyCompiler(common.NewOperations(), s.config.ConfigPath)
+	pc, ctx, err := newPolicyBackend(s.config, dir)
+	if err != nil {
+		return nil, err
 	}
 	pf := common.NewPrefilter()
-	ctx := common.NewContextualizer()
-	pi := engine.NewPolicyInterpreter(s.config, pc, pf, ctx, s.out)
+	cr := common.NewCorrelator()
+	pi := engine.NewPolicyInterpreter(s.config, pc, pf, ctx, cr, s.out)
 
 	// compile policies
 	err = pi.Compile(paths...)
@@ -200,9 +228,49 @@ func (s *PolicyEngine) createPolicyInterpreter() (*engine.PolicyInterpreter[*com
 		return nil, err
 	}
 
+	// record policy checksums
+	ps, err := engine.NewPolicySet(dir, paths)
+	if err != nil {
+		return nil, err
+	}
+	pi.SetPolicySet(ps)
+
 	return pi, nil
 }
 
+// newPolicyBackend creates a policy compiler for the policy language set in conf, and the contextualizer of
+// the policy interpreter compiling its rules. In mixed mode, the language of each policy file found in dir is
+// selected by the compiler, and defaults to Falco. The scripts of the script directory set in conf, if any,
+// are loaded once for both scripted predicates and actions.
+func newPolicyBackend(conf engine.Config, dir string) (policy.PolicyCompiler[*common.Record], source.Contextualizer[*common.Record], error) {
+	ops := common.NewOperations()
+	ctx := common.NewContextualizer()
+	if conf.ScriptDir != "" {
+		l, ok := ops.(source.ScriptLoader[*common.Record])
+		if !ok {
+			return nil, nil, errors.New("scripts are not supported by the policy engine backend")
+		}
+		logger.Info.Println("Loading scripts from: ", conf.ScriptDir)
+		actions, err := l.LoadScripts(conf.ScriptDir)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not load scripts from %s: %v", conf.ScriptDir, err)
+		}
+		if p, ok := ctx.(source.ScriptedActionProvider[*common.Record]); ok {
+			p.SetScriptedActions(actions)
+		}
+	}
+	switch conf.Language {
+	case engine.Sigma:
+		return sigma.NewPolicyCompiler(ops, conf.ConfigPath), ctx, nil
+	case engine.Mixed:
+		return policy.NewMultiCompiler(map[string]policy.PolicyCompiler[*common.Record]{
+			falco.Language: falco.NewPolicyCompiler(ops),
+			sigma.Language: sigma.NewPolicyCompiler(ops, conf.ConfigPath),
+		}, falco.Language, dir), ctx, nil
+	}
+	return falco.NewPolicyCompiler(ops), ctx, nil
+}
+
 // out sends a record to every output channel in the plugin.
 func (s *PolicyEngine) out(r *common.Record) {
 	for _, c := range s.outCh {
@@ -220,9 +288,13 @@ func (s *PolicyEngine) SetOutChan(ch []interface{}) {
 // Cleanup clean up the plugin resources.
 func (s *PolicyEngine) Cleanup() {
 	logger.Trace.Println("Exiting ", pluginName)
+	if s.ctl != nil {
+		s.ctl.Stop() //nolint:errcheck
+	}
 	if s.pi != nil {
 		s.pi.StopWorkers()
 	}
+	s.draining.Wait()
 	if s.outCh != nil {
 		for _, c := range s.outCh {
 			close(c)
@@ -26,17 +26,23 @@ package policyengine
 
 import (
 	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/common"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord"
 )
 
 // bypassPolicyEngine passes a record onto the exporter if there is no policy engine available.
 // note any record transformations can be done here.
 func (s *PolicyEngine) bypassPolicyEngine(rec *sfgo.FlatRecord) {
-	s.out(flatrecord.NewRecord(rec))
+	s.out(newRecord(rec))
 }
 
 // processAsync processes a record in the policy engine.
 // note any record transformations can be done here.
 func (s *PolicyEngine) processAsync(rec *sfgo.FlatRecord) {
-	s.pi.ProcessAsync(flatrecord.NewRecord(rec))
+	s.pi.ProcessAsync(newRecord(rec))
+}
+
+// newRecord wraps an input record into a policy engine record.
+func newRecord(rec *sfgo.FlatRecord) *common.Record {
+	return flatrecord.NewRecord(rec)
 }
@@ -20,6 +20,7 @@
 package policyengine
 
 import (
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/common"
 	logs "go.opentelemetry.io/proto/otlp/logs/v1"
 )
 
@@ -34,3 +35,8 @@ func (s *PolicyEngine) bypassPolicyEngine(rec *logs.ResourceLogs) {
 func (s *PolicyEngine) processAsync(rec *logs.ResourceLogs) {
 	s.pi.ProcessAsync(rec)
 }
+
+// newRecord wraps an input record into a policy engine record.
+func newRecord(rec *logs.ResourceLogs) *common.Record {
+	return rec
+}
@@ -0,0 +1,184 @@
+//go:build flatrecord
+// +build flatrecord
+
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policyengine implements a plugin for a rules engine for telemetry records.
+package policyengine
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/plugins"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/control"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/common"
+)
+
+// chanMonitor is a policy monitor that delivers the interpreters sent to its channel.
+type chanMonitor struct {
+	ch chan *engine.PolicyInterpreter[*common.Record]
+}
+
+func (m *chanMonitor) GetInterpreterChan() chan *engine.PolicyInterpreter[*common.Record] {
+	return m.ch
+}
+
+func (m *chanMonitor) StartMonitor() error         { return nil }
+func (m *chanMonitor) StopMonitor() error          { return nil }
+func (m *chanMonitor) CheckForPolicyUpdate() error { return nil }
+
+func TestSwapInterpreter(t *testing.T) {
+	s := new(PolicyEngine)
+	s.config, _ = engine.CreateConfig(map[string]interface{}{engine.PoliciesConfigKey: "../../resources/policies/tests/replay"})
+	out := &plugins.Channel[*common.Record]{In: make(chan *common.Record, 100)}
+	s.SetOutChan([]interface{}{out})
+	mon := &chanMonitor{ch: make(chan *engine.PolicyInterpreter[*common.Record], 1)}
+	s.policyMonitor = mon
+	var err error
+	s.pi, err = s.createPolicyInterpreter(s.config.PoliciesPath)
+	assert.NoError(t, err)
+	s.pi.StartWorkers()
+
+	var records []*sfgo.FlatRecord
+	for fr := range readJSONRecords(t, "../../resources/traces/replay.jsonl").In {
+		records = append(records, fr)
+	}
+	in := &common.Channel{In: make(chan *sfgo.FlatRecord)}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go s.Process([]interface{}{in}, &wg)
+	for i := 0; i < 30; i++ {
+		if i%10 == 5 {
+			pi, err := s.createPolicyInterpreter(s.config.PoliciesPath)
+			assert.NoError(t, err)
+			mon.ch <- pi
+		}
+		in.In <- records[i%len(records)]
+	}
+	close(in.In)
+	wg.Wait()
+	s.Cleanup()
+
+	// the records queued to replaced interpreters are processed before the output channels close
+	alerts := 0
+	for range out.In {
+		alerts++
+	}
+	assert.Equal(t, 20, alerts)
+}
+
+func TestControl(t *testing.T) {
+	dir := t.TempDir()
+	policy, err := os.ReadFile("../../resources/policies/tests/replay/policy.yaml")
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "policy.yaml"), policy, 0600))
+	socket := filepath.Join(t.TempDir(), "control.sock")
+
+	s := new(PolicyEngine)
+	assert.NoError(t, s.Init(map[string]interface{}{engine.PoliciesConfigKey: dir, engine.ControlAddrKey: "unix:" + socket}))
+	out := &plugins.Channel[*common.Record]{In: make(chan *common.Record, 100)}
+	s.SetOutChan([]interface{}{out})
+	assert.FileExists(t, socket)
+
+	status, err := s.Status()
+	assert.NoError(t, err)
+	assert.Equal(t, "none", status.Monitor)
+	assert.Contains(t, status.Files, "policy.yaml")
+	rules, err := s.Rules()
+	assert.NoError(t, err)
+	assert.Len(t, rules, 2)
+	assert.ErrorIs(t, s.SetRuleEnabled("Missing", false), control.ErrRuleNotFound)
+	assert.NoError(t, s.SetRuleEnabled("Shell spawned", false))
+
+	in := &common.Channel{In: make(chan *sfgo.FlatRecord)}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go s.Process([]interface{}{in}, &wg)
+
+	// rule settings are kept across reloads
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "policy.yaml"), append(policy, "  tags: [test]\n"...), 0600))
+	assert.NoError(t, s.Reload())
+	assert.Eventually(t, func() bool {
+		st, _ := s.Status()
+		return st.Version != status.Version
+	}, 5*time.Second, 10*time.Millisecond)
+	rules, _ = s.Rules()
+	assert.False(t, rules[0].Enabled)
+	assert.Equal(t, []string{"test"}, rules[1].Tags)
+
+	for fr := range readJSONRecords(t, "../../resources/traces/replay.jsonl").In {
+		in.In <- fr
+	}
+	close(in.In)
+	wg.Wait()
+	s.Cleanup()
+	assert.NoFileExists(t, socket)
+
+	alerts := 0
+	for r := range out.In {
+		for _, rule := range r.Ctx.GetRules() {
+			assert.Equal(t, "Network tool spawned", rule.Name)
+		}
+		alerts++
+	}
+	assert.Greater(t, alerts, 0)
+}
+
+func TestMixedPolicies(t *testing.T) {
+	s := new(PolicyEngine)
+	s.config, _ = engine.CreateConfig(map[string]interface{}{engine.PoliciesConfigKey: "../../resources/policies/tests/mixed", engine.LanguageKey: "mixed",
+		engine.ConfigKey: "../../resources/policies/sigma/config/sysflow.yml"})
+	out := &plugins.Channel[*common.Record]{In: make(chan *common.Record, 100)}
+	s.SetOutChan([]interface{}{out})
+	var err error
+	s.pi, err = s.createPolicyInterpreter(s.config.PoliciesPath)
+	assert.NoError(t, err)
+
+	langs := make(map[string]string)
+	for _, r := range s.pi.Rules() {
+		langs[r.Name] = r.Source.Language
+		assert.FileExists(t, r.Source.File)
+	}
+	assert.Equal(t, map[string]string{
+		"Network tool spawned":                 "falco",
+		"5d0a7c1e-3b2f-4f6a-8e9d-1c2b3a4d5e6f": "sigma",
+		"9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b": "sigma",
+	}, langs)
+
+	s.pi.StartWorkers()
+	for fr := range readJSONRecords(t, "../../resources/traces/replay.jsonl").In {
+		s.processAsync(fr)
+	}
+	s.Cleanup()
+	matched := make(map[string]bool)
+	for r := range out.In {
+		for _, rule := range r.Ctx.GetRules() {
+			matched[rule.Source.Language] = true
+		}
+	}
+	assert.Equal(t, map[string]bool{"falco": true, "sigma": true}, matched)
+}
@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policyengine implements a plugin for a rules engine for telemetry records.
+package policyengine
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/common"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrMissingRecords is returned by Replay when expectations refer to records beyond the end of the replayed trace.
+var ErrMissingRecords = errors.New("missing records")
+
+// Expectations defines the rules expected to match the records of a replayed trace.
+// Records are numbered from 1 in the order they are read; records without an
+// expectation are expected not to match any rule.
+type Expectations struct {
+	Records []Expectation
+}
+
+// Expectation defines the rules expected to match a record.
+type Expectation struct {
+	Record int
+	Rules  []string
+}
+
+// LoadExpectations reads an expectations file in YAML format.
+func LoadExpectations(path string) (Expectations, error) {
+	var exp Expectations
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return exp, err
+	}
+	if err := yaml.Unmarshal(b, &exp); err != nil {
+		return exp, fmt.Errorf("unable to parse expectations file %s: %v", path, err)
+	}
+	for _, e := range exp.Records {
+		if e.Record < 1 {
+			return exp, fmt.Errorf("invalid record number %d in expectations file %s", e.Record, path)
+		}
+	}
+	return exp, nil
+}
+
+// Mismatch denotes a rule that unexpectedly matched, or failed to match, a record.
+type Mismatch struct {
+	Record int
+	Rule   string
+}
+
+// FiredAction denotes an action that a rule matching a record would have executed.
+type FiredAction struct {
+	Record int
+	Rule   string
+	Action string
+}
+
+// Report defines the outcome of a trace replay, the actions that would have been executed, and the
+// evaluation profile of the policies if profiling is enabled.
+type Report struct {
+	Records        int
+	FalsePositives []Mismatch
+	FalseNegatives []Mismatch
+	Actions        []FiredAction
+	Profile        *engine.Profile
+}
+
+// Passed returns true if the matched rules agree with the expectations.
+func (r Report) Passed() bool {
+	return len(r.FalsePositives) == 0 && len(r.FalseNegatives) == 0
+}
+
+// Replay compiles the policies set in conf, applies them in order to the records received
+// from channel ch until it is closed, and compares the rules matched by each record with
+// the expectations. Actions are not executed, but recorded in the report.
+func Replay(conf engine.Config, ch interface{}, exp Expectations) (Report, error) {
+	var report Report
+	in, ok := ch.(*common.Channel)
+	if !ok {
+		return report, errors.New("unsupported record channel type for policy engine backend")
+	}
+	paths, err := engine.ListPolicies(conf.PoliciesPath)
+	if err != nil {
+		return report, err
+	}
+	conf.DryRun = true
+	pc, ctx, err := newPolicyBackend(conf, conf.PoliciesPath)
+	if err != nil {
+		return report, err
+	}
+	pi := engine.NewPolicyInterpreter(conf, pc, common.NewPrefilter(), ctx, common.NewCorrelator(), nil)
+	if err := pi.Compile(paths...); err != nil {
+		return report, err
+	}
+
+	expected := make(map[int][]string)
+	for _, e := range exp.Records {
+		expected[e.Record] = append(expected[e.Record], e.Rules...)
+	}
+	for rec := range in.In {
+		r := newRecord(rec)
+		pi.Process(r)
+		report.Records++
+		matched := make(map[string]bool)
+		for _, rule := range ctx.GetRules(r) {
+			matched[rule.Name] = true
+			for _, a := range rule.Actions {
+				report.Actions = append(report.Actions, FiredAction{report.Records, rule.Name, a})
+			}
+		}
+		for _, name := range expected[report.Records] {
+			if !matched[name] {
+				report.FalseNegatives = append(report.FalseNegatives, Mismatch{report.Records, name})
+			}
+			delete(matched, name)
+		}
+		for _, name := range sortedKeys(matched) {
+			report.FalsePositives = append(report.FalsePositives, Mismatch{report.Records, name})
+		}
+	}
+	for _, e := range exp.Records {
+		if e.Record > report.Records {
+			return report, fmt.Errorf("%w: expectation for record %d exceeds the number of replayed records (%d)", ErrMissingRecords, e.Record, report.Records)
+		}
+	}
+	if prof, ok := pi.Profile(); ok {
+		report.Profile = &prof
+	}
+	return report, nil
+}
+
+// sortedKeys returns the keys of a set in lexicographic order.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
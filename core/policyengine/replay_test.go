@@ -0,0 +1,133 @@
+//go:build flatrecord
+// +build flatrecord
+
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policyengine implements a plugin for a rules engine for telemetry records.
+package policyengine
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/common"
+)
+
+func readJSONRecords(t *testing.T, path string) *common.Channel {
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	ch := &common.Channel{In: make(chan *sfgo.FlatRecord, 10)}
+	go func() {
+		defer f.Close()
+		defer close(ch.In)
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			fr := new(sfgo.FlatRecord)
+			assert.NoError(t, json.Unmarshal(scanner.Bytes(), fr))
+			ch.In <- fr
+		}
+	}()
+	return ch
+}
+
+func TestReplay(t *testing.T) {
+	conf, _ := engine.CreateConfig(map[string]interface{}{engine.PoliciesConfigKey: "../../resources/policies/tests/replay"})
+	exp, err := LoadExpectations("../../resources/traces/expectations/replay.yaml")
+	assert.NoError(t, err)
+	assert.Len(t, exp.Records, 2)
+
+	report, err := Replay(conf, readJSONRecords(t, "../../resources/traces/replay.jsonl"), exp)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, report.Records)
+	assert.True(t, report.Passed())
+
+	exp = Expectations{Records: []Expectation{{Record: 1, Rules: []string{"Shell spawned"}}, {Record: 3, Rules: []string{"Shell spawned"}}}}
+	report, err = Replay(conf, readJSONRecords(t, "../../resources/traces/replay.jsonl"), exp)
+	assert.NoError(t, err)
+	assert.False(t, report.Passed())
+	assert.Equal(t, []Mismatch{{Record: 2, Rule: "Network tool spawned"}}, report.FalsePositives)
+	assert.Equal(t, []Mismatch{{Record: 3, Rule: "Shell spawned"}}, report.FalseNegatives)
+
+	exp = Expectations{Records: []Expectation{{Record: 4, Rules: []string{"Shell spawned"}}}}
+	_, err = Replay(conf, readJSONRecords(t, "../../resources/traces/replay.jsonl"), exp)
+	assert.ErrorIs(t, err, ErrMissingRecords)
+}
+
+func TestReplayDryRun(t *testing.T) {
+	var posts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { posts.Add(1) }))
+	defer srv.Close()
+	conf, _ := engine.CreateConfig(map[string]interface{}{engine.PoliciesConfigKey: "../../resources/policies/tests/dryrun"})
+	conf.Actions.WebhookURL = srv.URL
+	conf.Actions.QuarantinePath = filepath.Join(t.TempDir(), "quarantine.jsonl")
+	exp, err := LoadExpectations("../../resources/traces/expectations/replay.yaml")
+	assert.NoError(t, err)
+
+	report, err := Replay(conf, readJSONRecords(t, "../../resources/traces/replay.jsonl"), exp)
+	assert.NoError(t, err)
+	assert.True(t, report.Passed())
+	assert.Equal(t, []FiredAction{
+		{Record: 1, Rule: "Shell spawned", Action: "tag:shell"},
+		{Record: 1, Rule: "Shell spawned", Action: "quarantine"},
+		{Record: 2, Rule: "Network tool spawned", Action: "webhook"},
+	}, report.Actions)
+	assert.Zero(t, posts.Load())
+	assert.NoFileExists(t, conf.Actions.QuarantinePath)
+}
+
+func TestReplayLargeTrace(t *testing.T) {
+	b, err := os.ReadFile("../../resources/traces/replay.jsonl")
+	assert.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "large.jsonl")
+	assert.NoError(t, os.WriteFile(path, bytes.Repeat(b, 10), 0600))
+	conf, _ := engine.CreateConfig(map[string]interface{}{engine.PoliciesConfigKey: "../../resources/policies/tests/replay"})
+	report, err := Replay(conf, readJSONRecords(t, path), Expectations{})
+	assert.NoError(t, err)
+	assert.Equal(t, 30, report.Records)
+	assert.Len(t, report.FalsePositives, 20)
+}
+
+func TestReplayProfile(t *testing.T) {
+	conf, _ := engine.CreateConfig(map[string]interface{}{engine.PoliciesConfigKey: "../../resources/policies/tests/replay"})
+	report, err := Replay(conf, readJSONRecords(t, "../../resources/traces/replay.jsonl"), Expectations{})
+	assert.NoError(t, err)
+	assert.Nil(t, report.Profile)
+
+	conf.Profile = true
+	report, err = Replay(conf, readJSONRecords(t, "../../resources/traces/replay.jsonl"), Expectations{})
+	assert.NoError(t, err)
+	assert.NotNil(t, report.Profile)
+	assert.Equal(t, uint64(3), report.Profile.Records)
+	var matches uint64
+	for _, s := range report.Profile.Rules {
+		matches += s.Matches
+	}
+	assert.Equal(t, uint64(len(report.FalsePositives)), matches)
+}
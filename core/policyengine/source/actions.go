@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package source implements a backend for policy compilers.
+package source
+
+import (
+	"context"
+
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+)
+
+// ActionBuilder builds the function of a built-in action referenced by a rule. The argument of the
+// reference is the text following the action name and a colon (e.g., "high" in "priority:high"), or
+// an empty string if the reference has no argument. Action functions should return promptly once
+// their context is done, and may only modify the record if declared by a RecordModifier.
+type ActionBuilder[R any] func(rule policy.Rule[R], arg string) (func(ctx context.Context, r R) error, error)
+
+// ActionProvider is an optional interface implemented by contextualizers that provide built-in actions.
+type ActionProvider[R any] interface {
+	// Actions returns the builders of built-in actions, indexed by action name.
+	Actions(conf ActionConfig) map[string]ActionBuilder[R]
+}
+
+// RecordModifier is an optional interface implemented by action providers whose built-in actions
+// modify records. Actions that modify records cannot be executed asynchronously.
+type RecordModifier interface {
+	// ModifiesRecords returns true if the built-in action name modifies the records it is applied to.
+	ModifiesRecords(name string) bool
+}
+
+// ScriptedActionProvider is an optional interface implemented by action providers supporting scripted actions.
+type ScriptedActionProvider[R any] interface {
+	// SetScriptedActions sets the builders of the actions declared by scripts, which are provided along
+	// with built-in actions.
+	SetScriptedActions(builders map[string]ActionBuilder[R])
+}
+
+// ActionConfig defines the configuration of built-in actions.
+type ActionConfig struct {
+	// Default path of the file to which quarantined records are appended
+	QuarantinePath string
+	// Default URL of the endpoint to which webhook payloads are posted
+	WebhookURL string
+}
@@ -51,3 +51,7 @@ var NewPrefilter = flatrecord.NewPrefilter
 // NewContextualizer specifies a constructor for the backend specific contextualizer
 // object used with the policy engine
 var NewContextualizer = flatrecord.NewContextualizer
+
+// NewCorrelator specifies a constructor for the backend specific correlator
+// object used with the policy engine
+var NewCorrelator = flatrecord.NewCorrelator
@@ -50,3 +50,7 @@ var NewPrefilter = otel.NewPrefilter
 // NewContextualizer specifies a constructor for the backend specific contextualizer
 // object used with the policy engine
 var NewContextualizer = otel.NewContextualizer
+
+// NewCorrelator specifies a constructor for the backend specific correlator
+// object used with the policy engine
+var NewCorrelator = otel.NewCorrelator
@@ -33,6 +33,22 @@ type Contextualizer[R any] interface {
 	AddTags(r R, tags ...string)
 	// GetTags retrieves the list of tags associated with a record.
 	GetTags(r R) []string
+	// AddCorrelatedIDs adds the identifiers of records correlated with a record.
+	AddCorrelatedIDs(r R, ids ...string)
+	// GetCorrelatedIDs retrieves the identifiers of records correlated with a record.
+	GetCorrelatedIDs(r R) []string
+	// SetCount stores the aggregated count of a threshold rule matching a record.
+	SetCount(r R, rule string, count int)
+	// GetCount retrieves the aggregated count of a threshold rule matching a record.
+	GetCount(r R, rule string) int
+	// SetSuppressed stores the number of alerts of a rule suppressed before it matched a record.
+	SetSuppressed(r R, rule string, count int)
+	// GetSuppressed retrieves the number of alerts of a rule suppressed before it matched a record.
+	GetSuppressed(r R, rule string) int
+	// SetOutput stores the rendered output message of a rule matching a record.
+	SetOutput(r R, rule string, output string)
+	// GetOutput retrieves the rendered output message of a rule matching a record.
+	GetOutput(r R, rule string) string
 }
 
 // DefaultContextualizer is a default contextualizer object.
@@ -53,3 +69,27 @@ func (s *DefaultContextualizer[R]) AddTags(r R, tags ...string) {}
 
 // GetTags retrieves the list of tags associated with a record.
 func (s *DefaultContextualizer[R]) GetTags(r R) []string { return nil }
+
+// AddCorrelatedIDs adds the identifiers of records correlated with a record.
+func (s *DefaultContextualizer[R]) AddCorrelatedIDs(r R, ids ...string) {}
+
+// GetCorrelatedIDs retrieves the identifiers of records correlated with a record.
+func (s *DefaultContextualizer[R]) GetCorrelatedIDs(r R) []string { return nil }
+
+// SetCount stores the aggregated count of a threshold rule matching a record.
+func (s *DefaultContextualizer[R]) SetCount(r R, rule string, count int) {}
+
+// GetCount retrieves the aggregated count of a threshold rule matching a record.
+func (s *DefaultContextualizer[R]) GetCount(r R, rule string) int { return 0 }
+
+// SetSuppressed stores the number of alerts of a rule suppressed before it matched a record.
+func (s *DefaultContextualizer[R]) SetSuppressed(r R, rule string, count int) {}
+
+// GetSuppressed retrieves the number of alerts of a rule suppressed before it matched a record.
+func (s *DefaultContextualizer[R]) GetSuppressed(r R, rule string) int { return 0 }
+
+// SetOutput stores the rendered output message of a rule matching a record.
+func (s *DefaultContextualizer[R]) SetOutput(r R, rule string, output string) {}
+
+// GetOutput retrieves the rendered output message of a rule matching a record.
+func (s *DefaultContextualizer[R]) GetOutput(r R, rule string) string { return "" }
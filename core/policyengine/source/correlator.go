@@ -0,0 +1,51 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package source implements a backend for policy compilers.
+package source
+
+import "time"
+
+// Correlator interface defines the record accessors required by stateful rules.
+type Correlator[R any] interface {
+	// Key creates a function that computes a correlation key from a list of record attributes.
+	Key(attrs ...string) func(r R) string
+	// Timestamp returns the timestamp of a record in nanoseconds.
+	Timestamp(r R) int64
+	// ID returns an identifier of a record.
+	ID(r R) string
+}
+
+// DefaultCorrelator is a default correlator object.
+type DefaultCorrelator[R any] struct{}
+
+func NewDefaultCorrelator[R any]() Correlator[R] {
+	return &DefaultCorrelator[R]{}
+}
+
+// Key creates a function that computes a correlation key from a list of record attributes.
+func (s *DefaultCorrelator[R]) Key(attrs ...string) func(r R) string {
+	return func(r R) string { return "" }
+}
+
+// Timestamp returns the timestamp of a record in nanoseconds.
+func (s *DefaultCorrelator[R]) Timestamp(r R) int64 { return time.Now().UnixNano() }
+
+// ID returns an identifier of a record.
+func (s *DefaultCorrelator[R]) ID(r R) string { return "" }
@@ -0,0 +1,404 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flatrecord implements a flatrecord source for the policy compilers.
+package flatrecord
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
+)
+
+// Built-in action names.
+const (
+	HashAction       = "hash"
+	TagAction        = "tag"
+	PriorityAction   = "priority"
+	QuarantineAction = "quarantine"
+	WebhookAction    = "webhook"
+)
+
+// Hash action targets.
+const (
+	hashProc = "proc"
+	hashFile = "file"
+)
+
+const hashCacheSize = 4096
+
+// hashAttrs maps hash slots to the attributes holding the paths of the hashed files.
+var hashAttrs = map[HashType]string{HASH_TYPE_PROC: SF_PROC_EXE, HASH_TYPE_FILE: SF_FILE_PATH}
+
+// webhookAttrs lists the record attributes included in webhook payloads.
+var webhookAttrs = []string{
+	SF_TYPE, SF_TS, SF_OPFLAGS, SF_RET, SF_NODE_ID,
+	SF_PROC_PID, SF_PROC_EXE, SF_PROC_CMDLINE, SF_PROC_USER, SF_PPROC_PID, SF_PPROC_EXE,
+	SF_CONTAINER_ID, SF_FILE_PATH, SF_NET_SIP, SF_NET_SPORT, SF_NET_DIP, SF_NET_DPORT,
+}
+
+// Actions returns the builders of the built-in actions for flat records, including the scripted actions
+// set with SetScriptedActions.
+func (s *Contextualizer) Actions(conf source.ActionConfig) map[string]source.ActionBuilder[*Record] {
+	a := &actions{
+		conf:   conf,
+		hashes: &hashCache{max: hashCacheSize, sets: make(map[hashKey]*HashSet)},
+		// redirects are not followed, since their targets are not confined to loopback addresses
+		client: &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }},
+	}
+	builders := map[string]source.ActionBuilder[*Record]{
+		HashAction:       a.hash,
+		TagAction:        a.tag,
+		PriorityAction:   a.priority,
+		QuarantineAction: a.quarantine,
+		WebhookAction:    a.webhook,
+	}
+	for name, b := range s.scripted {
+		if _, ok := builders[name]; ok {
+			logger.Warn.Printf("Scripted action '%s' shadows a built-in action and is ignored", name)
+			continue
+		}
+		builders[name] = b
+	}
+	return builders
+}
+
+// SetScriptedActions sets the builders of the actions declared by scripts.
+func (s *Contextualizer) SetScriptedActions(builders map[string]source.ActionBuilder[*Record]) {
+	s.scripted = builders
+}
+
+// ModifiesRecords returns true if the built-in action name modifies records. Only the quarantine and
+// webhook actions leave records untouched; scripted actions may add tags or set priorities.
+func (s *Contextualizer) ModifiesRecords(name string) bool {
+	return name != QuarantineAction && name != WebhookAction
+}
+
+// actions holds the state shared by built-in action functions.
+type actions struct {
+	conf   source.ActionConfig
+	hashes *hashCache
+	client *http.Client
+	mu     sync.Mutex
+}
+
+// hash computes the hashes of the process executable and/or the file of a record, and stores them
+// into the record's context. The argument selects the target ("proc" or "file"); both are hashed by default.
+func (a *actions) hash(rule policy.Rule[*Record], arg string) (func(ctx context.Context, r *Record) error, error) {
+	var types []HashType
+	switch arg {
+	case "":
+		types = []HashType{HASH_TYPE_PROC, HASH_TYPE_FILE}
+	case hashProc:
+		types = []HashType{HASH_TYPE_PROC}
+	case hashFile:
+		types = []HashType{HASH_TYPE_FILE}
+	default:
+		return nil, fmt.Errorf("unknown hash target %s", arg)
+	}
+	return func(ctx context.Context, r *Record) error {
+		for _, ht := range types {
+			if r.Ctx.GetHash(ht) != nil {
+				continue
+			}
+			path := Mapper.MapStr(hashAttrs[ht])(r)
+			if path == sfgo.Zeros.String {
+				continue
+			}
+			hs, err := a.hashes.get(ctx, path)
+			if err != nil {
+				return err
+			}
+			r.Ctx.SetHashes(ht, hs)
+		}
+		return nil
+	}, nil
+}
+
+// tag adds a static or templated tag to the record's context.
+func (a *actions) tag(rule policy.Rule[*Record], arg string) (func(ctx context.Context, r *Record) error, error) {
+	if arg == "" {
+		return nil, errors.New("missing tag value")
+	}
+	t := policy.NewTemplate(arg, func(attr string) func(r *Record) string { return Mapper.MapStr(attr) })
+	return func(ctx context.Context, r *Record) error {
+		r.Ctx.AddTags(t.Render(r))
+		return nil
+	}, nil
+}
+
+// priority overrides the priority of the rule for the matching record.
+func (a *actions) priority(rule policy.Rule[*Record], arg string) (func(ctx context.Context, r *Record) error, error) {
+	p, err := policy.ParsePriority(arg)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, r *Record) error {
+		r.Ctx.SetPriority(rule.Name, p)
+		return nil
+	}, nil
+}
+
+// quarantine appends the JSON encoding of the record's flat record to a file, one record per line.
+// Quarantine files can be replayed with the policy test subcommand. The argument names a file in the
+// directory of the configured quarantine file, so that policies cannot append records to arbitrary paths.
+func (a *actions) quarantine(rule policy.Rule[*Record], arg string) (func(ctx context.Context, r *Record) error, error) {
+	path := a.conf.QuarantinePath
+	if path == "" {
+		return nil, errors.New("no quarantine file configured")
+	}
+	if arg != "" {
+		name := filepath.Clean(arg)
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return nil, fmt.Errorf("quarantine file %s is not in the directory of the configured quarantine file", arg)
+		}
+		path = filepath.Join(filepath.Dir(path), name)
+	}
+	return func(ctx context.Context, r *Record) error {
+		b, err := encodeFlatRecord(r.Fr)
+		if err != nil {
+			return err
+		}
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		if _, err = f.Write(append(b, '\n')); err != nil {
+			f.Close()
+			return err
+		}
+		return f.Close()
+	}, nil
+}
+
+// encodeFlatRecord encodes a flat record in JSON. Null unions in the process tree, which the SysFlow
+// JSON codec cannot encode, are omitted.
+func encodeFlatRecord(fr *sfgo.FlatRecord) ([]byte, error) {
+	c := *fr
+	if fr.Ptree != nil {
+		c.Ptree = make([]*sfgo.Process, len(fr.Ptree))
+		for i, p := range fr.Ptree {
+			if p == nil {
+				continue
+			}
+			cp := *p
+			if cp.Poid != nil && cp.Poid.UnionType != sfgo.PoidUnionTypeEnumOID {
+				cp.Poid = nil
+			}
+			if cp.ContainerId != nil && cp.ContainerId.UnionType != sfgo.ContainerIdUnionTypeEnumString {
+				cp.ContainerId = nil
+			}
+			c.Ptree[i] = &cp
+		}
+	}
+	return json.Marshal(&c)
+}
+
+// WebhookPayload is the body of the requests posted by the webhook action.
+type WebhookPayload struct {
+	Rule     string                 `json:"rule"`
+	Desc     string                 `json:"desc,omitempty"`
+	Priority string                 `json:"priority"`
+	Output   string                 `json:"output,omitempty"`
+	Tags     []string               `json:"tags,omitempty"`
+	Record   map[string]interface{} `json:"record"`
+}
+
+// webhook posts a JSON payload describing the rule match to an HTTP endpoint. The argument overrides
+// the configured endpoint with a local endpoint, so that policies cannot send records to arbitrary hosts.
+func (a *actions) webhook(rule policy.Rule[*Record], arg string) (func(ctx context.Context, r *Record) error, error) {
+	endpoint := a.conf.WebhookURL
+	if arg != "" {
+		endpoint = arg
+	}
+	if endpoint == "" {
+		return nil, errors.New("no webhook URL configured")
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid webhook URL %s", endpoint)
+	}
+	if arg != "" && !isLoopbackHost(u.Hostname()) {
+		return nil, fmt.Errorf("webhook URL %s is not a loopback endpoint", arg)
+	}
+	return func(ctx context.Context, r *Record) error {
+		body, err := json.Marshal(newWebhookPayload(rule, r))
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := a.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		_, _ = io.Copy(io.Discard, resp.Body)
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("webhook %s returned status %s", endpoint, resp.Status)
+		}
+		return nil
+	}, nil
+}
+
+// isLoopbackHost returns true if host is localhost or a loopback address.
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// newWebhookPayload creates the webhook payload of a record matching rule.
+func newWebhookPayload(rule policy.Rule[*Record], r *Record) WebhookPayload {
+	p := WebhookPayload{
+		Rule:     rule.Name,
+		Desc:     rule.Desc,
+		Priority: r.Ctx.GetPriority(rule).String(),
+		Output:   r.Ctx.GetOutput(rule.Name),
+		Record:   make(map[string]interface{}),
+	}
+	for _, tag := range rule.Tags {
+		switch v := tag.(type) {
+		case []string:
+			p.Tags = append(p.Tags, v...)
+		default:
+			p.Tags = append(p.Tags, fmt.Sprintf("%v", v))
+		}
+	}
+	p.Tags = append(p.Tags, r.Ctx.GetTags()...)
+	for _, attr := range webhookAttrs {
+		v := Mapper.Map(attr)(r)
+		switch val := v.(type) {
+		case string:
+			if val == "" {
+				continue
+			}
+		case int64:
+			if val == 0 {
+				continue
+			}
+		case int32:
+			if val == 0 {
+				continue
+			}
+		}
+		p.Record[attr] = v
+	}
+	return p
+}
+
+// hashKey identifies the content of a file by its path, size, and modification time.
+type hashKey struct {
+	path  string
+	size  int64
+	mtime int64
+}
+
+// hashCache is a bounded cache of file hashes.
+type hashCache struct {
+	mu   sync.Mutex
+	max  int
+	sets map[hashKey]*HashSet
+}
+
+// get returns the hashes of the regular file at path.
+func (c *hashCache) get(ctx context.Context, path string) (*HashSet, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.Mode().IsRegular() {
+		return nil, fmt.Errorf("%s is not a regular file", path)
+	}
+	key := hashKey{path: path, size: fi.Size(), mtime: fi.ModTime().UnixNano()}
+	c.mu.Lock()
+	hs, ok := c.sets[key]
+	c.mu.Unlock()
+	if ok {
+		return hs, nil
+	}
+	if hs, err = hashFileContent(ctx, path); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	if len(c.sets) >= c.max {
+		c.sets = make(map[hashKey]*HashSet)
+	}
+	c.sets[key] = hs
+	c.mu.Unlock()
+	return hs, nil
+}
+
+// hashFileContent computes the md5, sha1, and sha256 hashes of the file at path.
+// Hashing is aborted once ctx is done.
+func hashFileContent(ctx context.Context, path string) (*HashSet, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	h5, h1, h256 := md5.New(), sha1.New(), sha256.New()
+	if _, err := io.Copy(io.MultiWriter(h5, h1, h256), ctxReader{ctx, f}); err != nil {
+		return nil, err
+	}
+	return &HashSet{
+		Md5:    hex.EncodeToString(h5.Sum(nil)),
+		Sha1:   hex.EncodeToString(h1.Sum(nil)),
+		Sha256: hex.EncodeToString(h256.Sum(nil)),
+	}, nil
+}
+
+// ctxReader is a reader that fails once its context is done.
+type ctxReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c ctxReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
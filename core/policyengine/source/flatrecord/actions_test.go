@@ -0,0 +1,185 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flatrecord implements a flatrecord source for the policy compilers.
+package flatrecord
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord/flatrecordtest"
+)
+
+func newActionRecord(exe string) *Record {
+	r := NewRecord(flatrecordtest.NewFlatRecord(sfgo.PROC_EVT))
+	r.Fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXE_STR] = exe
+	return r
+}
+
+func buildAction(t *testing.T, conf source.ActionConfig, name string, rule policy.Rule[*Record], arg string) func(ctx context.Context, r *Record) error {
+	b, ok := new(Contextualizer).Actions(conf)[name]
+	assert.True(t, ok)
+	f, err := b(rule, arg)
+	assert.NoError(t, err)
+	return f
+}
+
+func TestTagAction(t *testing.T) {
+	r := newActionRecord("/bin/bash")
+	f := buildAction(t, source.ActionConfig{}, TagAction, policy.Rule[*Record]{Name: "r"}, "exe=%sf.proc.exe")
+	assert.NoError(t, f(context.Background(), r))
+	assert.Equal(t, []string{"exe=/bin/bash"}, r.Ctx.GetTags())
+
+	_, err := new(Contextualizer).Actions(source.ActionConfig{})[TagAction](policy.Rule[*Record]{}, "")
+	assert.Error(t, err)
+}
+
+func TestPriorityAction(t *testing.T) {
+	r := newActionRecord("/bin/bash")
+	rule := policy.Rule[*Record]{Name: "r", Priority: policy.Low}
+	assert.Equal(t, policy.Low, r.Ctx.GetPriority(rule))
+	f := buildAction(t, source.ActionConfig{}, PriorityAction, rule, "High")
+	assert.NoError(t, f(context.Background(), r))
+	assert.Equal(t, policy.High, r.Ctx.GetPriority(rule))
+	assert.Equal(t, policy.Low, r.Ctx.GetPriority(policy.Rule[*Record]{Name: "other", Priority: policy.Low}))
+
+	_, err := new(Contextualizer).Actions(source.ActionConfig{})[PriorityAction](rule, "urgent")
+	assert.Error(t, err)
+}
+
+func TestHashAction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "exe")
+	assert.NoError(t, os.WriteFile(path, []byte("hello"), 0600))
+	r := newActionRecord(path)
+	f := buildAction(t, source.ActionConfig{}, HashAction, policy.Rule[*Record]{Name: "r"}, "proc")
+	assert.NoError(t, f(context.Background(), r))
+	assert.Equal(t, &HashSet{
+		Md5:    "5d41402abc4b2a76b9719d911017c592",
+		Sha1:   "aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d",
+		Sha256: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+	}, r.Ctx.GetHash(HASH_TYPE_PROC))
+	assert.Nil(t, r.Ctx.GetHash(HASH_TYPE_FILE))
+
+	assert.Error(t, f(context.Background(), newActionRecord(filepath.Join(t.TempDir(), "missing"))))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r = newActionRecord(path + "2")
+	assert.NoError(t, os.WriteFile(path+"2", []byte("hello"), 0600))
+	assert.ErrorIs(t, f(ctx, r), context.Canceled)
+	assert.Nil(t, r.Ctx.GetHash(HASH_TYPE_PROC))
+	_, err := new(Contextualizer).Actions(source.ActionConfig{})[HashAction](policy.Rule[*Record]{}, "net")
+	assert.Error(t, err)
+}
+
+func TestQuarantineAction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quarantine.jsonl")
+	f := buildAction(t, source.ActionConfig{QuarantinePath: path}, QuarantineAction, policy.Rule[*Record]{Name: "r"}, "")
+	assert.NoError(t, f(context.Background(), newActionRecord("/bin/bash")))
+	r := newActionRecord("/bin/sh")
+	r.Fr.Ptree = []*sfgo.Process{{Exe: "/bin/sh", Poid: sfgo.NewPoidUnion(), ContainerId: sfgo.NewContainerIdUnion()}}
+	assert.NoError(t, f(context.Background(), r))
+
+	file, err := os.Open(path)
+	assert.NoError(t, err)
+	defer file.Close()
+	var exes []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fr := new(sfgo.FlatRecord)
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), fr))
+		exes = append(exes, Mapper.MapStr(SF_PROC_EXE)(NewRecord(fr)))
+	}
+	assert.Equal(t, []string{"/bin/bash", "/bin/sh"}, exes)
+
+	_, err = new(Contextualizer).Actions(source.ActionConfig{})[QuarantineAction](policy.Rule[*Record]{}, "")
+	assert.Error(t, err)
+	_, err = new(Contextualizer).Actions(source.ActionConfig{})[QuarantineAction](policy.Rule[*Record]{}, "other.jsonl")
+	assert.Error(t, err)
+
+	other := filepath.Join(filepath.Dir(path), "other.jsonl")
+	f = buildAction(t, source.ActionConfig{QuarantinePath: path}, QuarantineAction, policy.Rule[*Record]{Name: "r"}, "other.jsonl")
+	assert.NoError(t, f(context.Background(), newActionRecord("/bin/bash")))
+	assert.FileExists(t, other)
+	for _, arg := range []string{"/tmp/other.jsonl", "../other.jsonl", "sub/../../other.jsonl"} {
+		_, err = new(Contextualizer).Actions(source.ActionConfig{QuarantinePath: path})[QuarantineAction](policy.Rule[*Record]{}, arg)
+		assert.Error(t, err, arg)
+	}
+}
+
+func TestWebhookAction(t *testing.T) {
+	payloads := make(chan WebhookPayload, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/redirect" {
+			http.Redirect(w, req, "http://example.com/alerts", http.StatusTemporaryRedirect)
+			return
+		}
+		if req.URL.Path != "/" {
+			http.NotFound(w, req)
+			return
+		}
+		var p WebhookPayload
+		if err := json.NewDecoder(req.Body).Decode(&p); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		payloads <- p
+	}))
+	defer srv.Close()
+
+	rule := policy.Rule[*Record]{Name: "r", Desc: "test", Priority: policy.Medium, Tags: []policy.EnrichmentTag{[]string{"t1"}}}
+	r := newActionRecord("/bin/bash")
+	r.Ctx.SetOutput(rule.Name, "shell spawned")
+	f := buildAction(t, source.ActionConfig{WebhookURL: srv.URL}, WebhookAction, rule, "")
+	assert.NoError(t, f(context.Background(), r))
+	p := <-payloads
+	assert.Equal(t, "r", p.Rule)
+	assert.Equal(t, policy.Medium.String(), p.Priority)
+	assert.Equal(t, "shell spawned", p.Output)
+	assert.Equal(t, []string{"t1"}, p.Tags)
+	assert.Equal(t, "/bin/bash", p.Record[SF_PROC_EXE])
+	assert.Equal(t, sfgo.TyPEStr, p.Record[SF_TYPE])
+
+	f = buildAction(t, source.ActionConfig{}, WebhookAction, rule, srv.URL+"/missing")
+	assert.Error(t, f(context.Background(), r))
+	f = buildAction(t, source.ActionConfig{}, WebhookAction, rule, srv.URL+"/redirect")
+	assert.ErrorContains(t, f(context.Background(), r), "307")
+	_, err := new(Contextualizer).Actions(source.ActionConfig{})[WebhookAction](rule, "")
+	assert.Error(t, err)
+	_, err = new(Contextualizer).Actions(source.ActionConfig{})[WebhookAction](rule, "ftp://localhost")
+	assert.Error(t, err)
+	_, err = new(Contextualizer).Actions(source.ActionConfig{})[WebhookAction](rule, "http://example.com/alerts")
+	assert.Error(t, err)
+	_, err = new(Contextualizer).Actions(source.ActionConfig{WebhookURL: "http://example.com/alerts"})[WebhookAction](rule, "https://10.0.0.1/alerts")
+	assert.Error(t, err)
+	_, err = new(Contextualizer).Actions(source.ActionConfig{WebhookURL: "http://example.com/alerts"})[WebhookAction](rule, "")
+	assert.NoError(t, err)
+	_, err = new(Contextualizer).Actions(source.ActionConfig{})[WebhookAction](rule, "http://localhost:8080/alerts")
+	assert.NoError(t, err)
+}
@@ -25,7 +25,9 @@ import (
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
 )
 
-type Contextualizer struct{}
+type Contextualizer struct {
+	scripted map[string]source.ActionBuilder[*Record]
+}
 
 func NewContextualizer() source.Contextualizer[*Record] {
 	return &Contextualizer{}
@@ -46,3 +48,35 @@ func (s *Contextualizer) AddTags(r *Record, tags ...string) {
 func (s *Contextualizer) GetTags(r *Record) []string {
 	return r.Ctx.GetTags()
 }
+
+func (s *Contextualizer) AddCorrelatedIDs(r *Record, ids ...string) {
+	r.Ctx.AddCorrelatedIDs(ids...)
+}
+
+func (s *Contextualizer) GetCorrelatedIDs(r *Record) []string {
+	return r.Ctx.GetCorrelatedIDs()
+}
+
+func (s *Contextualizer) SetCount(r *Record, rule string, count int) {
+	r.Ctx.SetCount(rule, count)
+}
+
+func (s *Contextualizer) GetCount(r *Record, rule string) int {
+	return r.Ctx.GetCount(rule)
+}
+
+func (s *Contextualizer) SetSuppressed(r *Record, rule string, count int) {
+	r.Ctx.SetSuppressed(rule, count)
+}
+
+func (s *Contextualizer) GetSuppressed(r *Record, rule string) int {
+	return r.Ctx.GetSuppressed(rule)
+}
+
+func (s *Contextualizer) SetOutput(r *Record, rule string, output string) {
+	r.Ctx.SetOutput(rule, output)
+}
+
+func (s *Contextualizer) GetOutput(r *Record, rule string) string {
+	return r.Ctx.GetOutput(rule)
+}
@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flatrecord implements a flatrecord source for the policy compilers.
+package flatrecord
+
+import (
+	"strings"
+
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
+)
+
+// Correlation key separator.
+const keySep = "\x1f"
+
+type Correlator struct{}
+
+func NewCorrelator() source.Correlator[*Record] {
+	return &Correlator{}
+}
+
+// Key creates a function that computes a correlation key from a list of record attributes.
+// The key is empty if any of the attributes is unset in the record.
+func (c *Correlator) Key(attrs ...string) func(r *Record) string {
+	mappers := make([]StrFieldMap, len(attrs))
+	for i, attr := range attrs {
+		mappers[i] = Mapper.MapStr(attr)
+	}
+	return func(r *Record) string {
+		var sb strings.Builder
+		for i, m := range mappers {
+			v := m(r)
+			if v == sfgo.Zeros.String {
+				return sfgo.Zeros.String
+			}
+			if i > 0 {
+				sb.WriteString(keySep)
+			}
+			sb.WriteString(v)
+		}
+		return sb.String()
+	}
+}
+
+// Timestamp returns the timestamp of a record in nanoseconds.
+func (c *Correlator) Timestamp(r *Record) int64 {
+	return r.GetInt(sfgo.TS_INT, sfgo.SYSFLOW_SRC)
+}
+
+// ID returns an identifier of a record.
+func (c *Correlator) ID(r *Record) string {
+	return r.ID()
+}
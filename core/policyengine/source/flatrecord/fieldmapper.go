@@ -22,6 +22,7 @@ package flatrecord
 
 import (
 	"fmt"
+	"net/netip"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -51,6 +52,7 @@ const (
 	MapSpecialStr  MappingType = 6
 	MapSpecialBool MappingType = 7
 	MapArraySvc    MappingType = 8
+	MapAny         MappingType = 9 // type known when mapped (e.g., scripted attributes)
 )
 
 // SectionType defines a section type
@@ -99,6 +101,18 @@ type SvcArrayFieldMap func(r *Record) *[]*sfgo.Service
 // StrFieldMap is a functional type denoting a string attribute mapper.
 type StrFieldMap func(r *Record) string
 
+// BoolFieldMap is a functional type denoting a boolean attribute mapper.
+type BoolFieldMap func(r *Record) bool
+
+// StrListFieldMap is a functional type denoting a string list attribute mapper.
+type StrListFieldMap func(r *Record) []string
+
+// IntListFieldMap is a functional type denoting a numerical list attribute mapper.
+type IntListFieldMap func(r *Record) []int64
+
+// IPFieldMap is a functional type denoting an IP address list attribute mapper.
+type IPFieldMap func(r *Record) []netip.Addr
+
 // VoidFieldMap is a functional type denoting a void attribute mapper.
 type VoidFieldMap func(r *Record)
 
@@ -115,33 +129,60 @@ func (m FieldMapper) Map(attr string) FieldMap {
 	return func(r *Record) interface{} { return attr }
 }
 
-// MapInt retrieves a numerical field map based on a SysFlow attribute.
+// MapInt retrieves a numerical field map based on a SysFlow attribute. Attributes that are not
+// record attributes are parsed once as numerical constants.
 func (m FieldMapper) MapInt(attr string) IntFieldMap {
-	return func(r *Record) int64 {
-		if v, ok := m.Map(attr)(r).(int64); ok {
-			return v
-		}
-		if v, err := strconv.ParseInt(attr, 10, 64); err == nil {
-			return v
-		}
-		return sfgo.Zeros.Int64
+	if f, ok := m.Mappers[attr]; ok {
+		return f.intMap()
+	}
+	v, err := strconv.ParseInt(attr, 10, 64)
+	if err != nil {
+		v = sfgo.Zeros.Int64
 	}
+	return func(r *Record) int64 { return v }
 }
 
-// MapIntArray retrieves a numerical array field map based on a SysFlow attribute.
+// MapBool retrieves a boolean field map based on a SysFlow attribute. Numerical values hold if they
+// are not zero, and strings and lists if they are not empty. Attributes that are not record attributes
+// are parsed once as boolean constants.
+func (m FieldMapper) MapBool(attr string) BoolFieldMap {
+	if f, ok := m.Mappers[attr]; ok {
+		return f.boolMap(attr)
+	}
+	v, _ := strconv.ParseBool(common.TrimBoundingQuotes(attr))
+	return func(r *Record) bool { return v }
+}
+
+// MapIntArray retrieves a numerical array field map based on a SysFlow attribute. Arrays of plain
+// attributes are read from the flat record; attributes that are not record attributes map to nil.
 func (m FieldMapper) MapIntArray(attr string) IntArrayFieldMap {
+	f, ok := m.Mappers[attr]
+	if !ok {
+		return func(r *Record) *[]int64 { return nil }
+	}
+	if f.Type == MapArrayInt && f.FlatIndex != A_IDS {
+		return func(r *Record) *[]int64 { return r.GetIntArray(f.FlatIndex, f.Source) }
+	}
 	return func(r *Record) *[]int64 {
-		if v, ok := m.Map(attr)(r).(*[]int64); ok {
+		if v, ok := f.Map(r).(*[]int64); ok {
 			return v
 		}
 		return nil
 	}
 }
 
-// MapSvcArray retrieves a numerical array field map based on a SysFlow attribute.
+// MapSvcArray retrieves a numerical array field map based on a SysFlow attribute. Service arrays are
+// read from the flat record; attributes that are not record attributes map to nil.
 func (m FieldMapper) MapSvcArray(attr string) SvcArrayFieldMap {
+	f, ok := m.Mappers[attr]
+	if !ok {
+		return func(r *Record) *[]*sfgo.Service { return nil }
+	}
+	if f.Type == MapArraySvc {
+		return func(r *Record) *[]*sfgo.Service { return r.GetSvcArray(f.FlatIndex, f.Source) }
+	}
 	return func(r *Record) *[]*sfgo.Service {
-		if v, ok := m.Map(attr)(r).(*[]*sfgo.Service); ok {
+		if v, ok := f.Map(r).(*[]*sfgo.Service); ok {
 			return v
 		}
 		return nil
@@ -157,40 +198,250 @@ func cut(s string, sep string) (before string, after string, found bool) {
 	return s[:separatorIndex], s[separatorIndex+1:], true
 }
 
-// MapStr retrieves a string field map based on a SysFlow attribute.
-func (m FieldMapper) MapStr(attr string) StrFieldMap {
-	return func(r *Record) string {
-		baseattr, jsonpath, isPathExp := cut(attr, "[")
-		if isPathExp { // check if baseattr is field name
-			_, isPathExp = m.Mappers[baseattr]
-		} else {
-			baseattr = attr
-		}
-		if isPathExp { // trim ']'
-			jsonpath = jsonpath[:len(jsonpath)-1]
+// field returns the entry of a SysFlow attribute, and the JSON path of attr if it is a path
+// expression over a string attribute (e.g., sf.pod.services[0.name]).
+func (m FieldMapper) field(attr string) (*FieldEntry, string, bool) {
+	if baseattr, jsonpath, isPathExp := cut(attr, "["); isPathExp {
+		if f, ok := m.Mappers[baseattr]; ok {
+			return f, strings.TrimSuffix(jsonpath, "]"), true
 		}
+	}
+	f, ok := m.Mappers[attr]
+	return f, "", ok
+}
 
-		o := m.Map(baseattr)(r)
-		if v, ok := o.(string); ok {
-			if isPathExp && v != "" && jsonpath != "" {
+// MapStr retrieves a string field map based on a SysFlow attribute. Values of list attributes are
+// joined with LISTSEP. Attributes that are not record attributes are string constants.
+func (m FieldMapper) MapStr(attr string) StrFieldMap {
+	f, jsonpath, ok := m.field(attr)
+	if !ok {
+		v := common.TrimBoundingQuotes(attr)
+		return func(r *Record) string { return v }
+	}
+	s := f.strMap(attr)
+	if jsonpath != "" {
+		return func(r *Record) string {
+			if v := s(r); v != "" {
 				return gjson.Get(v, jsonpath).String()
 			}
-			return common.TrimBoundingQuotes(v)
-		} else if v, ok := o.(int64); ok {
-			if baseattr == SF_PROC_TTY || baseattr == SF_PROC_ENTRY {
-				return strconv.FormatBool(v != 0)
+			return sfgo.Zeros.String
+		}
+	}
+	return func(r *Record) string { return common.TrimBoundingQuotes(s(r)) }
+}
+
+// MapStrList retrieves a string list field map based on a SysFlow attribute. Scalar attributes are
+// mapped to lists of one value.
+func (m FieldMapper) MapStrList(attr string) StrListFieldMap {
+	if f, ok := m.Mappers[attr]; ok && f.IsList() {
+		return f.strListMap(attr)
+	}
+	s := m.MapStr(attr)
+	return func(r *Record) []string { return []string{s(r)} }
+}
+
+// MapIntList retrieves a numerical list field map based on a SysFlow attribute. Scalar attributes are
+// mapped to lists of one value.
+func (m FieldMapper) MapIntList(attr string) IntListFieldMap {
+	if f, ok := m.Mappers[attr]; ok && f.IsList() {
+		return f.intListMap(attr)
+	}
+	i := m.MapInt(attr)
+	return func(r *Record) []int64 { return []int64{i(r)} }
+}
+
+// MapIP retrieves an IP address list field map based on a SysFlow attribute. Addresses of network
+// attributes are read from their integer attributes; addresses of other attributes are parsed from
+// their string values, skipping values that are not IP addresses.
+func (m FieldMapper) MapIP(attr string) IPFieldMap {
+	_, isIP := ipAttrs[attr]
+	if f, ok := m.Mappers[attr]; ok && (isIP || (f.Type == MapArrayInt && f.FlatIndex != A_IDS)) {
+		l := f.intListMap(attr)
+		return func(r *Record) []netip.Addr {
+			v := l(r)
+			ips := make([]netip.Addr, len(v))
+			for i, ip := range v {
+				ips[i] = ipv4(ip)
+			}
+			return ips
+		}
+	}
+	l := m.MapStrList(attr)
+	return func(r *Record) []netip.Addr {
+		var ips []netip.Addr
+		for _, s := range l(r) {
+			if ip, err := netip.ParseAddr(strings.TrimSpace(s)); err == nil {
+				ips = append(ips, ip)
 			}
+		}
+		return ips
+	}
+}
+
+// IsList checks whether the values of the attribute are lists.
+func (f *FieldEntry) IsList() bool {
+	return f.Type == MapArrayStr || f.Type == MapArrayInt
+}
+
+// intMap returns a numerical mapper for the attribute. Values of plain attributes are read from
+// the flat record; values of other attributes are converted from their mapped values.
+func (f *FieldEntry) intMap() IntFieldMap {
+	switch f.Type {
+	case MapIntVal, MapBoolVal:
+		return func(r *Record) int64 { return r.GetInt(f.FlatIndex, f.Source) }
+	}
+	return func(r *Record) int64 {
+		switch v := f.Map(r).(type) {
+		case int64:
+			return v
+		case int32:
+			return int64(v)
+		case bool:
+			if v {
+				return 1
+			}
+		}
+		return sfgo.Zeros.Int64
+	}
+}
+
+// boolMap returns a boolean mapper for attribute attr.
+func (f *FieldEntry) boolMap(attr string) BoolFieldMap {
+	switch f.Type {
+	case MapIntVal, MapBoolVal:
+		return func(r *Record) bool { return r.GetInt(f.FlatIndex, f.Source) != 0 }
+	case MapStrVal:
+		return func(r *Record) bool { return r.GetStr(f.FlatIndex, f.Source) != "" }
+	case MapArrayStr:
+		l := f.strListMap(attr)
+		return func(r *Record) bool { return len(l(r)) > 0 }
+	case MapArrayInt:
+		l := f.intListMap(attr)
+		return func(r *Record) bool { return len(l(r)) > 0 }
+	}
+	return func(r *Record) bool {
+		switch v := f.Map(r).(type) {
+		case string:
+			return v != ""
+		case int64:
+			return v != 0
+		case int32:
+			return v != 0
+		case bool:
+			return v
+		case *[]int64:
+			return v != nil
+		case *[]*sfgo.Service:
+			return v != nil
+		}
+		return false
+	}
+}
+
+// strMap returns a string mapper for attribute attr.
+func (f *FieldEntry) strMap(attr string) StrFieldMap {
+	switch f.Type {
+	case MapStrVal:
+		return func(r *Record) string { return r.GetStr(f.FlatIndex, f.Source) }
+	case MapIntVal:
+		return func(r *Record) string { return strconv.FormatInt(r.GetInt(f.FlatIndex, f.Source), 10) }
+	case MapBoolVal:
+		return func(r *Record) string { return strconv.FormatBool(r.GetInt(f.FlatIndex, f.Source) != 0) }
+	case MapArrayStr, MapArrayInt:
+		l := f.strListMap(attr)
+		return func(r *Record) string { return strings.Join(l(r), common.LISTSEP) }
+	}
+	return func(r *Record) string {
+		switch v := f.Map(r).(type) {
+		case string:
+			return v
+		case int64:
 			return strconv.FormatInt(v, 10)
-		} else if v, ok := o.(int32); ok { // sf.pproc.* int fields
+		case int32: // sf.pproc.* int fields
 			return strconv.FormatInt(int64(v), 10)
-		} else if v, ok := o.(bool); ok { // sf.pproc.tty, sf.pproc.entry field
+		case bool: // sf.pproc.tty, sf.pproc.entry fields
 			return strconv.FormatBool(v)
 		}
-
 		return sfgo.Zeros.String
 	}
 }
 
+// strListMap returns a string list mapper for list attribute attr. Lists are built from the flat record
+// attributes they are derived from.
+func (f *FieldEntry) strListMap(attr string) StrListFieldMap {
+	if _, ok := ipAttrs[attr]; ok {
+		return f.ipStrListMap(attr)
+	}
+	switch {
+	case f.FlatIndex == A_IDS:
+		return func(r *Record) []string { return r.GetCachedValues(f.AuxAttr) }
+	case f.Type == MapArrayInt:
+		return f.ipStrListMap(attr)
+	case f.FlatIndex == sfgo.EV_PROC_OPFLAGS_INT:
+		return func(r *Record) []string {
+			rtype, _ := sfgo.ParseRecordType(r.GetInt(sfgo.SF_REC_TYPE, f.Source))
+			return sfgo.GetOpFlags(int32(r.GetInt(sfgo.EV_PROC_OPFLAGS_INT, f.Source)), rtype)
+		}
+	case f.FlatIndex == sfgo.FL_FILE_OPENFLAGS_INT:
+		return func(r *Record) []string { return sfgo.GetOpenFlags(r.GetInt(f.FlatIndex, f.Source)) }
+	case f.FlatIndex == sfgo.FL_NETW_SPORT_INT:
+		l := f.intListMap(attr)
+		return func(r *Record) []string {
+			ports := l(r)
+			s := make([]string, len(ports))
+			for i, port := range ports {
+				s[i] = strconv.FormatInt(port, 10)
+			}
+			return s
+		}
+	}
+	// lists without flat record attributes (e.g., evt.dir) are constant
+	values := strings.Split(f.Map(nil).(string), common.LISTSEP)
+	return func(r *Record) []string { return values }
+}
+
+// ipStrListMap returns a string list mapper for list attribute attr, whose numerical values are IPv4 addresses.
+func (f *FieldEntry) ipStrListMap(attr string) StrListFieldMap {
+	l := f.intListMap(attr)
+	return func(r *Record) []string {
+		ips := l(r)
+		s := make([]string, len(ips))
+		for i, ip := range ips {
+			s[i] = sfgo.GetIPStr(int32(ip))
+		}
+		return s
+	}
+}
+
+// intListMap returns a numerical list mapper for list attribute attr. Lists of strings are empty.
+func (f *FieldEntry) intListMap(attr string) IntListFieldMap {
+	if attrs, ok := ipAttrs[attr]; ok {
+		return func(r *Record) []int64 {
+			ips := make([]int64, len(attrs))
+			for i, a := range attrs {
+				ips[i] = r.GetInt(a, f.Source)
+			}
+			return ips
+		}
+	}
+	switch {
+	case f.FlatIndex == A_IDS && f.Type == MapArrayInt:
+		return func(r *Record) []int64 { return r.GetCachedInts(f.AuxAttr) }
+	case f.Type == MapArrayInt:
+		return func(r *Record) []int64 {
+			if v := r.GetIntArray(f.FlatIndex, f.Source); v != nil {
+				return *v
+			}
+			return nil
+		}
+	case f.FlatIndex == sfgo.FL_NETW_SPORT_INT:
+		return func(r *Record) []int64 {
+			return []int64{r.GetInt(sfgo.FL_NETW_SPORT_INT, f.Source), r.GetInt(sfgo.FL_NETW_DPORT_INT, f.Source)}
+		}
+	}
+	return func(r *Record) []int64 { return nil }
+}
+
 // Fields defines a sorted array of all exported field mapper keys.
 var Fields = getFields()
 
@@ -314,7 +565,7 @@ func getExportedMappers() map[string]*FieldEntry {
 		SF_FILE_OID:           &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.FILE_OID_STR), FlatIndex: sfgo.FILE_OID_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC, Section: SectFile},
 		SF_FILE_DIRECTORY:     &FieldEntry{Map: mapDir(sfgo.SYSFLOW_SRC, sfgo.FILE_PATH_STR), FlatIndex: sfgo.FILE_PATH_STR, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, Section: SectFile},
 		SF_FILE_NEWNAME:       &FieldEntry{Map: mapName(sfgo.SYSFLOW_SRC, sfgo.SEC_FILE_PATH_STR), FlatIndex: sfgo.SEC_FILE_PATH_STR, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, Section: SectFile},
-		SF_FILE_NEWPATH:       &FieldEntry{Map: mapPath(sfgo.SYSFLOW_SRC, sfgo.SEC_FILE_PATH_STR), FlatIndex: sfgo.SEC_FILE_PATH_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC, Section: SectFile},
+		SF_FILE_NEWPATH:       &FieldEntry{Map: mapPath(sfgo.SYSFLOW_SRC, sfgo.SEC_FILE_PATH_STR), FlatIndex: sfgo.SEC_FILE_PATH_STR, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, Section: SectFile},
 		SF_FILE_NEWSYMLINK:    &FieldEntry{Map: mapSymlink(sfgo.SYSFLOW_SRC, sfgo.SEC_FILE_PATH_STR), FlatIndex: sfgo.SEC_FILE_PATH_STR, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, Section: SectFile},
 		SF_FILE_NEWOID:        &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.SEC_FILE_OID_STR), FlatIndex: sfgo.SEC_FILE_OID_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC, Section: SectFile},
 		SF_FILE_NEWDIRECTORY:  &FieldEntry{Map: mapDir(sfgo.SYSFLOW_SRC, sfgo.SEC_FILE_PATH_STR), FlatIndex: sfgo.SEC_FILE_PATH_STR, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, Section: SectFile},
@@ -448,62 +699,62 @@ func getExtendedMappers() map[string]FieldMap {
 func getNonExportedMappers() map[string]*FieldEntry {
 	return map[string]*FieldEntry{
 		// Falco
-		FALCO_EVT_TYPE:          &FieldEntry{Map: mapOpFlags(sfgo.SYSFLOW_SRC)},
-		FALCO_EVT_RAW_RES:       &FieldEntry{Map: mapRecType(sfgo.SYSFLOW_SRC)},
-		FALCO_EVT_RAW_TIME:      &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.TS_INT)},
-		FALCO_EVT_DIR:           &FieldEntry{Map: mapConsts(FALCO_ENTER_EVENT, FALCO_EXIT_EVENT)},
-		FALCO_EVT_IS_OPEN_READ:  &FieldEntry{Map: mapIsOpenRead(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_OPENFLAGS_INT)},
-		FALCO_EVT_IS_OPEN_WRITE: &FieldEntry{Map: mapIsOpenWrite(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_OPENFLAGS_INT)},
-		FALCO_EVT_UID:           &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_UID_INT)},
-		FALCO_FD_TYPECHAR:       &FieldEntry{Map: mapFileType(sfgo.SYSFLOW_SRC, sfgo.FILE_RESTYPE_INT)},
-		FALCO_FD_DIRECTORY:      &FieldEntry{Map: mapDir(sfgo.SYSFLOW_SRC, sfgo.FILE_PATH_STR)},
-		FALCO_FD_NAME:           &FieldEntry{Map: mapName(sfgo.SYSFLOW_SRC, sfgo.FILE_PATH_STR)},
-		FALCO_FD_FILENAME:       &FieldEntry{Map: mapName(sfgo.SYSFLOW_SRC, sfgo.FILE_PATH_STR)},
-		FALCO_FD_PROTO:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_PROTO_INT)},
-		FALCO_FD_LPROTO:         &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_PROTO_INT)},
-		FALCO_FD_L4PROTO:        &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_PROTO_INT)},
-		FALCO_FD_RPROTO:         &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_PROTO_INT)},
-		FALCO_FD_SPROTO:         &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_PROTO_INT)},
-		FALCO_FD_CPROTO:         &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_PROTO_INT)},
-		FALCO_FD_SPORT:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_SPORT_INT)},
-		FALCO_FD_DPORT:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_DPORT_INT)},
-		FALCO_FD_SIP:            &FieldEntry{Map: mapIP(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_SIP_INT)},
-		FALCO_FD_DIP:            &FieldEntry{Map: mapIP(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_DIP_INT)},
-		FALCO_FD_IP:             &FieldEntry{Map: mapIP(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_SIP_INT, sfgo.FL_NETW_DIP_INT)},
-		FALCO_FD_PORT:           &FieldEntry{Map: mapPort(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_SPORT_INT, sfgo.FL_NETW_DPORT_INT)},
-		FALCO_FD_NUM:            &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_FD_INT)},
-		FALCO_USER_NAME:         &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.PROC_USERNAME_STR)},
-		FALCO_PROC_PID:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_OID_HPID_INT)},
-		FALCO_PROC_TID:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.TID_INT)},
-		FALCO_PROC_GID:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_GID_INT)},
-		FALCO_PROC_UID:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_UID_INT)},
-		FALCO_PROC_GROUP:        &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.PROC_GROUPNAME_STR)},
-		FALCO_PROC_TTY:          &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcTTY)},
-		FALCO_PROC_USER:         &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.PROC_USERNAME_STR)},
-		FALCO_PROC_EXE:          &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.PROC_EXE_STR)},
-		FALCO_PROC_NAME:         &FieldEntry{Map: mapName(sfgo.SYSFLOW_SRC, sfgo.PROC_EXE_STR)},
-		FALCO_PROC_ARGS:         &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.PROC_EXEARGS_STR)},
-		FALCO_PROC_CREATE_TIME:  &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_POID_CREATETS_INT)},
-		FALCO_PROC_CMDLINE:      &FieldEntry{Map: mapJoin(sfgo.SYSFLOW_SRC, sfgo.PROC_EXE_STR, sfgo.PROC_EXEARGS_STR)},
-		FALCO_PROC_ANAME:        &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, ProcAName)},
-		FALCO_PROC_APID:         &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, ProcAPID)},
-		FALCO_PROC_PPID:         &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_POID_HPID_INT)},
-		FALCO_PROC_PGID:         &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcGID)},
-		FALCO_PROC_PUID:         &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcUID)},
-		FALCO_PROC_PGROUP:       &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcGroup)},
-		FALCO_PROC_PTTY:         &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcTTY)},
-		FALCO_PROC_PUSER:        &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcUser)},
-		FALCO_PROC_PEXE:         &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcExe)},
-		FALCO_PROC_PARGS:        &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcArgs)},
-		FALCO_PROC_PCREATE_TIME: &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_POID_CREATETS_INT)},
-		FALCO_PROC_PNAME:        &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcName)},
-		FALCO_PROC_PCMDLINE:     &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcCmdLine)},
-		FALCO_CONT_ID:           &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.CONT_ID_STR)},
-		FALCO_CONT_IMAGE_ID:     &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.CONT_IMAGEID_STR)},
-		FALCO_CONT_IMAGE:        &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.CONT_IMAGE_STR)},
-		FALCO_CONT_NAME:         &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.CONT_NAME_STR)},
-		FALCO_CONT_TYPE:         &FieldEntry{Map: mapContType(sfgo.SYSFLOW_SRC, sfgo.CONT_TYPE_INT)},
-		FALCO_CONT_PRIVILEGED:   &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.CONT_PRIVILEGED_INT)},
+		FALCO_EVT_TYPE:          &FieldEntry{Map: mapOpFlags(sfgo.SYSFLOW_SRC), FlatIndex: sfgo.EV_PROC_OPFLAGS_INT, Type: MapArrayStr, Source: sfgo.SYSFLOW_SRC},
+		FALCO_EVT_RAW_RES:       &FieldEntry{Map: mapRecType(sfgo.SYSFLOW_SRC), FlatIndex: sfgo.SF_REC_TYPE, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC},
+		FALCO_EVT_RAW_TIME:      &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.TS_INT), FlatIndex: sfgo.TS_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_EVT_DIR:           &FieldEntry{Map: mapConsts(FALCO_ENTER_EVENT, FALCO_EXIT_EVENT), Type: MapArrayStr},
+		FALCO_EVT_IS_OPEN_READ:  &FieldEntry{Map: mapIsOpenRead(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_OPENFLAGS_INT), FlatIndex: sfgo.FL_FILE_OPENFLAGS_INT, Type: MapSpecialBool, Source: sfgo.SYSFLOW_SRC},
+		FALCO_EVT_IS_OPEN_WRITE: &FieldEntry{Map: mapIsOpenWrite(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_OPENFLAGS_INT), FlatIndex: sfgo.FL_FILE_OPENFLAGS_INT, Type: MapSpecialBool, Source: sfgo.SYSFLOW_SRC},
+		FALCO_EVT_UID:           &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_UID_INT), FlatIndex: sfgo.PROC_UID_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_TYPECHAR:       &FieldEntry{Map: mapFileType(sfgo.SYSFLOW_SRC, sfgo.FILE_RESTYPE_INT), FlatIndex: sfgo.FILE_RESTYPE_INT, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_DIRECTORY:      &FieldEntry{Map: mapDir(sfgo.SYSFLOW_SRC, sfgo.FILE_PATH_STR), FlatIndex: sfgo.FILE_PATH_STR, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_NAME:           &FieldEntry{Map: mapName(sfgo.SYSFLOW_SRC, sfgo.FILE_PATH_STR), FlatIndex: sfgo.FILE_PATH_STR, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_FILENAME:       &FieldEntry{Map: mapName(sfgo.SYSFLOW_SRC, sfgo.FILE_PATH_STR), FlatIndex: sfgo.FILE_PATH_STR, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_PROTO:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_PROTO_INT), FlatIndex: sfgo.FL_NETW_PROTO_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_LPROTO:         &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_PROTO_INT), FlatIndex: sfgo.FL_NETW_PROTO_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_L4PROTO:        &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_PROTO_INT), FlatIndex: sfgo.FL_NETW_PROTO_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_RPROTO:         &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_PROTO_INT), FlatIndex: sfgo.FL_NETW_PROTO_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_SPROTO:         &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_PROTO_INT), FlatIndex: sfgo.FL_NETW_PROTO_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_CPROTO:         &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_PROTO_INT), FlatIndex: sfgo.FL_NETW_PROTO_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_SPORT:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_SPORT_INT), FlatIndex: sfgo.FL_NETW_SPORT_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_DPORT:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_DPORT_INT), FlatIndex: sfgo.FL_NETW_DPORT_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_SIP:            &FieldEntry{Map: mapIP(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_SIP_INT), FlatIndex: sfgo.FL_NETW_SIP_INT, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_DIP:            &FieldEntry{Map: mapIP(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_DIP_INT), FlatIndex: sfgo.FL_NETW_DIP_INT, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_IP:             &FieldEntry{Map: mapIP(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_SIP_INT, sfgo.FL_NETW_DIP_INT), FlatIndex: sfgo.FL_NETW_SIP_INT, Type: MapArrayStr, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_PORT:           &FieldEntry{Map: mapPort(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_SPORT_INT, sfgo.FL_NETW_DPORT_INT), FlatIndex: sfgo.FL_NETW_SPORT_INT, Type: MapArrayStr, Source: sfgo.SYSFLOW_SRC},
+		FALCO_FD_NUM:            &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_FD_INT), FlatIndex: sfgo.FL_FILE_FD_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_USER_NAME:         &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.PROC_USERNAME_STR), FlatIndex: sfgo.PROC_USERNAME_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_PID:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_OID_HPID_INT), FlatIndex: sfgo.PROC_OID_HPID_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_TID:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.TID_INT), FlatIndex: sfgo.TID_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_GID:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_GID_INT), FlatIndex: sfgo.PROC_GID_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_UID:          &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_UID_INT), FlatIndex: sfgo.PROC_UID_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_GROUP:        &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.PROC_GROUPNAME_STR), FlatIndex: sfgo.PROC_GROUPNAME_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_TTY:          &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcTTY), FlatIndex: PARENT_IDS, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, AuxAttr: PProcTTY},
+		FALCO_PROC_USER:         &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.PROC_USERNAME_STR), FlatIndex: sfgo.PROC_USERNAME_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_EXE:          &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.PROC_EXE_STR), FlatIndex: sfgo.PROC_EXE_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_NAME:         &FieldEntry{Map: mapName(sfgo.SYSFLOW_SRC, sfgo.PROC_EXE_STR), FlatIndex: sfgo.PROC_EXE_STR, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_ARGS:         &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.PROC_EXEARGS_STR), FlatIndex: sfgo.PROC_EXEARGS_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_CREATE_TIME:  &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_POID_CREATETS_INT), FlatIndex: sfgo.PROC_POID_CREATETS_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_CMDLINE:      &FieldEntry{Map: mapJoin(sfgo.SYSFLOW_SRC, sfgo.PROC_EXE_STR, sfgo.PROC_EXEARGS_STR), FlatIndex: sfgo.PROC_EXE_STR, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_ANAME:        &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, ProcAName), FlatIndex: A_IDS, Type: MapArrayStr, Source: sfgo.SYSFLOW_SRC, AuxAttr: ProcAName},
+		FALCO_PROC_APID:         &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, ProcAPID), FlatIndex: A_IDS, Type: MapArrayInt, Source: sfgo.SYSFLOW_SRC, AuxAttr: ProcAPID},
+		FALCO_PROC_PPID:         &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_POID_HPID_INT), FlatIndex: sfgo.PROC_POID_HPID_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_PGID:         &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcGID), FlatIndex: PARENT_IDS, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, AuxAttr: PProcGID},
+		FALCO_PROC_PUID:         &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcUID), FlatIndex: PARENT_IDS, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, AuxAttr: PProcUID},
+		FALCO_PROC_PGROUP:       &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcGroup), FlatIndex: PARENT_IDS, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, AuxAttr: PProcGroup},
+		FALCO_PROC_PTTY:         &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcTTY), FlatIndex: PARENT_IDS, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, AuxAttr: PProcTTY},
+		FALCO_PROC_PUSER:        &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcUser), FlatIndex: PARENT_IDS, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, AuxAttr: PProcUser},
+		FALCO_PROC_PEXE:         &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcExe), FlatIndex: PARENT_IDS, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, AuxAttr: PProcExe},
+		FALCO_PROC_PARGS:        &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcArgs), FlatIndex: PARENT_IDS, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, AuxAttr: PProcArgs},
+		FALCO_PROC_PCREATE_TIME: &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_POID_CREATETS_INT), FlatIndex: sfgo.PROC_POID_CREATETS_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_PROC_PNAME:        &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcName), FlatIndex: PARENT_IDS, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, AuxAttr: PProcName},
+		FALCO_PROC_PCMDLINE:     &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, PProcCmdLine), FlatIndex: PARENT_IDS, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, AuxAttr: PProcCmdLine},
+		FALCO_CONT_ID:           &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.CONT_ID_STR), FlatIndex: sfgo.CONT_ID_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_CONT_IMAGE_ID:     &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.CONT_IMAGEID_STR), FlatIndex: sfgo.CONT_IMAGEID_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_CONT_IMAGE:        &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.CONT_IMAGE_STR), FlatIndex: sfgo.CONT_IMAGE_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_CONT_NAME:         &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.CONT_NAME_STR), FlatIndex: sfgo.CONT_NAME_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC},
+		FALCO_CONT_TYPE:         &FieldEntry{Map: mapContType(sfgo.SYSFLOW_SRC, sfgo.CONT_TYPE_INT), FlatIndex: sfgo.CONT_TYPE_INT, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC},
+		FALCO_CONT_PRIVILEGED:   &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.CONT_PRIVILEGED_INT), FlatIndex: sfgo.CONT_PRIVILEGED_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC},
 	}
 }
 
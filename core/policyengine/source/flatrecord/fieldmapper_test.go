@@ -0,0 +1,162 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flatrecord implements a flatrecord source for the policy compilers.
+package flatrecord
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/common"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord/flatrecordtest"
+)
+
+// newTestRecords creates records of each record type with non-zero attribute values.
+func newTestRecords() []*Record {
+	var recs []*Record
+	for _, rtype := range []int64{sfgo.PROC_EVT, sfgo.FILE_FLOW, sfgo.FILE_EVT, sfgo.NET_FLOW, sfgo.K8S_EVT} {
+		r := NewRecord(flatrecordtest.NewFlatRecord(rtype))
+		ints, strs := r.Fr.Ints[sfgo.SYSFLOW_IDX], r.Fr.Strs[sfgo.SYSFLOW_IDX]
+		ints[sfgo.PROC_OID_HPID_INT] = 42
+		ints[sfgo.PROC_UID_INT] = 1000
+		ints[sfgo.PROC_TTY_INT] = 1
+		ints[sfgo.EV_PROC_OPFLAGS_INT] = sfgo.OP_EXEC
+		strs[sfgo.PROC_EXE_STR] = "/bin/bash"
+		strs[sfgo.PROC_EXEARGS_STR] = "-c ls,cat"
+		strs[sfgo.CONT_ID_STR] = `"abc"`
+		switch rtype {
+		case sfgo.FILE_FLOW, sfgo.FILE_EVT:
+			ints[sfgo.FL_FILE_OPENFLAGS_INT] = 0x42
+			strs[sfgo.FILE_PATH_STR] = "/etc/passwd"
+			strs[sfgo.SEC_FILE_PATH_STR] = "/etc/shadow"
+		case sfgo.NET_FLOW:
+			ints[sfgo.FL_NETW_SIP_INT] = 0x0100007f
+			ints[sfgo.FL_NETW_DIP_INT] = 0x0101a8c0
+			ints[sfgo.FL_NETW_SPORT_INT] = 4242
+			ints[sfgo.FL_NETW_DPORT_INT] = 80
+		}
+		r.Fr.Ptree = []*sfgo.Process{
+			{Oid: &sfgo.OID{Hpid: 42}, Exe: "/bin/bash", ExeArgs: "-c ls"},
+			{Oid: &sfgo.OID{Hpid: 1}, Exe: "/sbin/init", Uid: 7, Tty: true},
+		}
+		recs = append(recs, r, NewRecord(flatrecordtest.NewFlatRecord(rtype)))
+	}
+	return recs
+}
+
+func TestTypedAccessors(t *testing.T) {
+	for _, r := range newTestRecords() {
+		for attr, f := range Mapper.Mappers {
+			v := f.Map(r)
+			switch val := v.(type) {
+			case string:
+				assert.Equal(t, common.TrimBoundingQuotes(val), Mapper.MapStr(attr)(r), attr)
+				assert.Equal(t, val != "", Mapper.MapBool(attr)(r), attr)
+				if f.IsList() {
+					assert.Equal(t, val, strings.Join(Mapper.MapStrList(attr)(r), common.LISTSEP), attr)
+				}
+			case int64:
+				assert.Equal(t, val, Mapper.MapInt(attr)(r), attr)
+				assert.Equal(t, val != 0, Mapper.MapBool(attr)(r), attr)
+				if f.Type == MapBoolVal {
+					assert.Equal(t, strconv.FormatBool(val != 0), Mapper.MapStr(attr)(r), attr)
+				} else {
+					assert.Equal(t, strconv.FormatInt(val, 10), Mapper.MapStr(attr)(r), attr)
+				}
+			case int32:
+				assert.Equal(t, int64(val), Mapper.MapInt(attr)(r), attr)
+				assert.Equal(t, strconv.FormatInt(int64(val), 10), Mapper.MapStr(attr)(r), attr)
+			case bool:
+				assert.Equal(t, val, Mapper.MapBool(attr)(r), attr)
+				assert.Equal(t, strconv.FormatBool(val), Mapper.MapStr(attr)(r), attr)
+			case *[]int64:
+				assert.Equal(t, val, Mapper.MapIntArray(attr)(r), attr)
+			case *[]*sfgo.Service:
+				assert.Equal(t, val, Mapper.MapSvcArray(attr)(r), attr)
+			}
+		}
+	}
+
+	r := newTestRecords()[6]
+	assert.Equal(t, []int64{42, 1}, Mapper.MapIntList(SF_PROC_APID)(r))
+	assert.Equal(t, []string{"bash", "init"}, Mapper.MapStrList(SF_PROC_ANAME)(r))
+	assert.Equal(t, []int64{4242, 80}, Mapper.MapIntList(SF_NET_PORT)(r))
+	assert.Equal(t, []string{"4242", "80"}, Mapper.MapStrList(FALCO_FD_PORT)(r))
+	assert.Equal(t, []string{"127.0.0.1", "192.168.1.1"}, Mapper.MapStrList(SF_NET_IP)(r))
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("192.168.1.1")}, Mapper.MapIP(SF_NET_DIP)(r))
+	assert.Equal(t, []string{sfgo.OpFlagExec}, Mapper.MapStrList(SF_OPFLAGS)(newTestRecords()[0]))
+	assert.Equal(t, []string{FALCO_ENTER_EVENT, FALCO_EXIT_EVENT}, Mapper.MapStrList(FALCO_EVT_DIR)(r))
+	assert.Equal(t, []string{"-c ls,cat"}, Mapper.MapStrList(SF_PROC_ARGS)(r))
+	assert.Equal(t, []int64{42}, Mapper.MapIntList(SF_PROC_PID)(r))
+	assert.Equal(t, int64(7), Mapper.MapInt(SF_PPROC_UID)(r))
+	assert.Equal(t, int64(1), Mapper.MapInt(SF_PPROC_TTY)(r))
+	hostIPs := &[]int64{0x0100000a}
+	r.Fr.Anys[sfgo.SYSFLOW_IDX][sfgo.POD_HOSTIP_ANY] = hostIPs
+	assert.Same(t, hostIPs, Mapper.MapIntArray(SF_POD_HOSTIP)(r))
+	assert.Nil(t, Mapper.MapIntArray(SF_POD_INTERNALIP)(r))
+
+	// constants are parsed once
+	assert.Equal(t, int64(80), Mapper.MapInt("80")(nil))
+	assert.Equal(t, int64(0), Mapper.MapInt("http")(nil))
+	assert.Equal(t, "a[0]", Mapper.MapStr(`"a[0]"`)(nil))
+	assert.True(t, Mapper.MapBool("true")(nil))
+	assert.Nil(t, Mapper.MapIntArray("10.0.0.1")(nil))
+	assert.Nil(t, Mapper.MapSvcArray("svc")(nil))
+	assert.Equal(t, []string{"a,b"}, Mapper.MapStrList(`"a,b"`)(nil))
+}
+
+func TestTypedPredicates(t *testing.T) {
+	op := NewOperations()
+	r := newTestRecords()[6]
+	eval := func(lattr string, rattr string, operator source.Operator) bool {
+		c, err := op.Compare(lattr, rattr, operator)
+		assert.NoError(t, err)
+		return c.Eval(r)
+	}
+	assert.True(t, eval(SF_NET_PORT, "80", source.Eq))
+	assert.True(t, eval(SF_PROC_APID, "1", source.Eq))
+	assert.True(t, eval(SF_PROC_ANAME, "init", source.Eq))
+	assert.True(t, eval(SF_PPROC_UID, "7", source.Eq))
+	assert.True(t, eval(SF_PPROC_UID, "5", source.Gt))
+	assert.True(t, eval(SF_PROC_ARGS, "-c ls,cat", source.Eq))
+	assert.False(t, eval(SF_PROC_ARGS, "cat", source.Eq))
+	assert.True(t, eval(SF_PROC_ARGS, "cat", source.Endswith))
+	assert.True(t, eval(SF_PROC_PID, SF_PROC_APID, source.Eq))
+
+	in, err := op.FoldAny(SF_PROC_AEXE, []string{"/sbin/init", "/bin/sh"}, source.Eq)
+	assert.NoError(t, err)
+	assert.True(t, in.Eval(r))
+	all, err := op.FoldAll(SF_PROC_ANAME, []string{"bash", "init"}, source.Eq)
+	assert.NoError(t, err)
+	assert.True(t, all.Eval(r))
+	all, err = op.FoldAll(SF_PROC_ANAME, []string{"bash", "sh"}, source.Eq)
+	assert.NoError(t, err)
+	assert.False(t, all.Eval(r))
+
+	exists, err := op.Exists(SF_PROC_ANAME)
+	assert.NoError(t, err)
+	assert.True(t, exists.Eval(r))
+	assert.False(t, exists.Eval(NewRecord(flatrecordtest.NewFlatRecord(sfgo.NET_FLOW))))
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flatrecordtest provides utilities for testing policies over flat records.
+package flatrecordtest
+
+import (
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+// NewFlatRecord creates a SysFlow flat record of type rtype whose other attributes are zero values.
+// Tests set the attributes they exercise in the record's SYSFLOW_IDX arrays.
+func NewFlatRecord(rtype int64) *sfgo.FlatRecord {
+	fr := &sfgo.FlatRecord{
+		Sources: []sfgo.Source{sfgo.SYSFLOW_SRC},
+		Ints:    [][]int64{make([]int64, sfgo.INT_ARRAY_SIZE)},
+		Strs:    [][]string{make([]string, sfgo.STR_ARRAY_SIZE)},
+		Anys:    [][]interface{}{make([]interface{}, sfgo.ANY_ARRAY_SIZE)},
+	}
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE] = rtype
+	return fr
+}
@@ -21,11 +21,14 @@
 package flatrecord
 
 import (
-	"reflect"
+	"net/netip"
 	"regexp"
 	"strings"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/common"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
@@ -34,17 +37,18 @@ import (
 type Operations struct {
 	strOps source.StrOps
 	intOps source.IntOps[int64]
+	ipOps  source.IPv6Ops
+	mapper FieldMapper
 }
 
 func NewOperations() source.Operations[*Record] {
-	return &Operations{strOps: source.StrOps{}, intOps: source.IntOps[int64]{}}
+	return &Operations{strOps: source.StrOps{}, intOps: source.IntOps[int64]{}, ipOps: source.IPv6Ops{}, mapper: Mapper}
 }
 
 // Exists creates a criterion for an existential predicate.
 func (op *Operations) Exists(attr string) (policy.Criterion[*Record], error) {
-	m := Mapper.Map(attr)
-	p := func(r *Record) bool { return !reflect.ValueOf(m(r)).IsZero() }
-	return policy.Criterion[*Record]{Pred: p}, nil
+	p := policy.Predicate[*Record](op.mapper.MapBool(attr))
+	return policy.Leaf(source.LeafKey("Exists", attr), op.estimate(source.ExistsEstimate, attr), p).WithPrefilter(inferPrefilter(attr, p, nil, nil)), nil
 }
 
 // Compare creates a criterion for a binary predicate.
@@ -52,82 +56,306 @@ func (op *Operations) Compare(lattr string, rattr string, operator source.Operat
 	switch operator {
 	case source.Lt, source.LEq, source.Gt, source.GEq:
 		return op.compareInt(lattr, rattr, operator)
+	case source.Eq, source.IEq:
+		if ip, err := netip.ParseAddr(common.TrimBoundingQuotes(rattr)); err == nil && ipAttrs[lattr] != nil {
+			return op.compareIP(lattr, ip)
+		}
 	}
 	return op.compareStr(lattr, rattr, operator)
 }
 
+// compareIP creates a criterion for an equality predicate over IP addresses, which holds for equivalent
+// representations of the same address (e.g., IPv4 addresses and their IPv4-mapped IPv6 addresses).
+func (op *Operations) compareIP(attr string, ip netip.Addr) (policy.Criterion[*Record], error) {
+	o, _ := op.ipOps.OpFunc(source.Eq)
+	v := ip.As16()
+	p := op.anyAddr(attr, func(a netip.Addr) bool { return o(a.As16(), v) })
+	c := policy.Leaf(source.LeafKey("IPEq", attr, ip.String()), op.estimate(source.Eq.Estimate(), attr), p)
+	return c.WithPrefilter(inferPrefilter(attr, p, nil, nil)), nil
+}
+
 // compareStr creates a criterion for a binary predicate over strings.
 func (op *Operations) compareStr(lattr string, rattr string, operator source.Operator) (policy.Criterion[*Record], error) {
-	ml := Mapper.MapStr(lattr)
-	mr := Mapper.MapStr(rattr)
 	o, _ := op.strOps.OpFunc(operator)
-	p := func(r *Record) bool { return compareStr(ml(r), mr(r), o) }
-	return policy.Criterion[*Record]{Pred: p}, nil
+	if _, ok := op.Field(rattr); ok {
+		mr := op.mapper.MapStrList(rattr)
+		p := op.anyStr(lattr, func(r *Record, v string) bool {
+			for _, rv := range mr(r) {
+				if o(v, rv) {
+					return true
+				}
+			}
+			return false
+		})
+		return policy.Leaf(source.LeafKey(operator.String(), lattr, rattr), op.estimate(operator.Estimate(), lattr, rattr), p), nil
+	}
+	p := op.anyStr(lattr, anyOf([]string{common.TrimBoundingQuotes(rattr)}, o))
+	c := policy.Leaf(source.LeafKey(operator.String(), lattr, rattr), op.estimate(operator.Estimate(), lattr, rattr), p)
+	return c.WithPrefilter(inferPrefilter(lattr, p, []string{rattr}, op.eqFunc(operator))), nil
 }
 
 // compareInt creates a criterion for a binary predicate over integers.
 func (op *Operations) compareInt(lattr string, rattr string, operator source.Operator) (policy.Criterion[*Record], error) {
-	ml := Mapper.MapInt(lattr)
-	mr := Mapper.MapInt(rattr)
+	ml := op.mapper.MapInt(lattr)
+	mr := op.mapper.MapInt(rattr)
 	o, _ := op.intOps.OpFunc(operator)
 	p := func(r *Record) bool { return compareInt(ml(r), mr(r), o) }
-	return policy.Criterion[*Record]{Pred: p}, nil
+	c := policy.Leaf(source.LeafKey(operator.String(), lattr, rattr), op.estimate(operator.Estimate(), lattr, rattr), p)
+	if _, ok := op.Field(rattr); ok {
+		return c, nil
+	}
+	return c.WithPrefilter(inferPrefilter(lattr, p, nil, nil)), nil
 }
 
 // FoldAny creates a disjunctive criterion for a binary predicate over a list of strings.
 func (op *Operations) FoldAny(attr string, list []string, operator source.Operator) (policy.Criterion[*Record], error) {
-	m := Mapper.MapStr(attr)
-	o, _ := op.strOps.OpFunc(operator)
-	p := func(r *Record) bool {
-		for _, v := range list {
-			if compareStr(m(r), v, o) {
-				return true
-			}
-		}
-		return false
+	if (operator == source.Eq || operator == source.IEq) && ipAttrs[attr] != nil && isIPList(list) {
+		return op.InCIDR(attr, list)
+	}
+	match, err := source.NewListMatcher(list, operator)
+	if err != nil {
+		return policy.False[*Record](), err
 	}
-	return policy.Criterion[*Record]{Pred: p}, nil
+	p := op.anyStr(attr, func(r *Record, v string) bool { return match(v) })
+	c := policy.Leaf(source.LeafKey("FoldAny", append([]string{operator.String(), attr}, list...)...), op.estimate(source.FoldEstimate(operator, len(list), false), attr), p)
+	return c.WithPrefilter(inferPrefilter(attr, p, list, op.eqFunc(operator))), nil
 }
 
 // FoldAll creates a conjunctive criterion for a binary predicate over a list of strings.
 func (op *Operations) FoldAll(attr string, list []string, operator source.Operator) (policy.Criterion[*Record], error) {
-	m := Mapper.MapStr(attr)
 	o, _ := op.strOps.OpFunc(operator)
+	ps := make([]func(r *Record) bool, 0, len(list))
+	for _, v := range list {
+		ps = append(ps, op.anyStr(attr, anyOf([]string{v}, o)))
+	}
 	p := func(r *Record) bool {
-		for _, v := range list {
-			if !compareStr(m(r), v, o) {
+		for _, p := range ps {
+			if !p(r) {
 				return false
 			}
 		}
 		return true
 	}
-	return policy.Criterion[*Record]{Pred: p}, nil
+	c := policy.Leaf(source.LeafKey("FoldAll", append([]string{operator.String(), attr}, list...)...), op.estimate(source.FoldEstimate(operator, len(list), true), attr), p)
+	return c.WithPrefilter(inferPrefilter(attr, p, nil, nil)), nil
 }
 
 // RegExp creates a criterion for a regular-expression predicate.
 func (op *Operations) RegExp(attr string, re string) (policy.Criterion[*Record], error) {
-	m := Mapper.MapStr(attr)
+	m := op.mapper.MapStr(attr)
 	if regexp, err := regexp.Compile(re); err == nil {
 		p := func(r *Record) bool {
 			return regexp.FindString(m(r)) != ""
 		}
-		return policy.Criterion[*Record]{Pred: p}, nil
+		return policy.Leaf(source.LeafKey("RegExp", attr, re), op.estimate(source.RegExpEstimate, attr), p).WithPrefilter(inferPrefilter(attr, p, nil, nil)), nil
 	}
 	return policy.False[*Record](), errors.Errorf("could not compile regular expression %s", re)
 }
 
-// compareStr compares two string values based on an operator.
-func compareStr(l string, r string, op source.OpFunc[string]) bool {
-	lattrs := strings.Split(l, common.LISTSEP)
-	rattrs := strings.Split(r, common.LISTSEP)
-	for _, lattr := range lattrs {
-		for _, rattr := range rattrs {
-			if op(lattr, rattr) {
+// Glob creates a criterion for a glob pattern predicate.
+func (op *Operations) Glob(attr string, pattern string) (policy.Criterion[*Record], error) {
+	re, err := source.CompileGlob(pattern)
+	if err != nil {
+		return policy.False[*Record](), err
+	}
+	p := op.anyStr(attr, func(r *Record, v string) bool { return re.MatchString(v) })
+	return policy.Leaf(source.LeafKey("Glob", attr, pattern), op.estimate(source.GlobEstimate, attr), p).WithPrefilter(inferPrefilter(attr, p, nil, nil)), nil
+}
+
+// InCIDR creates a criterion for a predicate that holds if an IP address of attr belongs to any of a list
+// of networks in CIDR notation. Networks are compiled into a prefix trie.
+func (op *Operations) InCIDR(attr string, cidrs []string) (policy.Criterion[*Record], error) {
+	trie, err := source.NewPrefixTrie(cidrs...)
+	if err != nil {
+		return policy.False[*Record](), err
+	}
+	p := op.anyAddr(attr, trie.Contains)
+	c := policy.Leaf(source.LeafKey("InCIDR", append([]string{attr}, cidrs...)...), op.estimate(source.InCIDR.Estimate(), attr), p)
+	return c.WithPrefilter(inferPrefilter(attr, p, nil, nil)), nil
+}
+
+// FoldLookup creates a disjunctive criterion for a binary predicate over the values of a lookup list. The
+// predicate is compiled again whenever the lookup is reloaded, and swapped atomically; values that fail to
+// compile on reload are logged, and the previous predicate is kept.
+func (op *Operations) FoldLookup(attr string, lookup *policy.Lookup, operator source.Operator) (policy.Criterion[*Record], error) {
+	var p func(r *Record) bool
+	var err error
+	if operator == source.InCIDR {
+		var trie atomic.Pointer[source.PrefixTrie]
+		err = subscribe(lookup, func(values []string) error {
+			t, e := source.NewPrefixTrie(values...)
+			return swap(&trie, t, e, lookup)
+		})
+		p = op.anyAddr(attr, func(ip netip.Addr) bool { return trie.Load().Contains(ip) })
+	} else {
+		var match atomic.Pointer[source.ListMatcher]
+		err = subscribe(lookup, func(values []string) error {
+			m, e := source.NewListMatcher(values, operator)
+			return swap(&match, &m, e, lookup)
+		})
+		p = op.anyStr(attr, func(r *Record, v string) bool { return (*match.Load())(v) })
+	}
+	if err != nil {
+		return policy.False[*Record](), err
+	}
+	c := policy.Leaf(source.LeafKey("FoldLookup", operator.String(), attr, lookup.Name, lookup.Path), op.estimate(operator.Estimate(), attr), p)
+	if attr == SF_TYPE {
+		// the record types matched by p change with the lookup values
+		return c, nil
+	}
+	return c.WithPrefilter(inferPrefilter(attr, p, nil, nil)), nil
+}
+
+// subscribe subscribes compile to the values of lookup, and returns the error of compiling its current
+// values. Only the initial call writes the returned error; errors of later reloads are handled by compile.
+func subscribe(lookup *policy.Lookup, compile func(values []string) error) (err error) {
+	initial := &err
+	lookup.Subscribe(func(values []string) {
+		e := compile(values)
+		if initial != nil {
+			*initial, initial = e, nil
+		}
+	})
+	return
+}
+
+// swap stores the state compiled from the values of a lookup, unless compilation failed. Errors are only
+// logged once the state is set, so that reloads with invalid values keep the previous state.
+func swap[T any](state *atomic.Pointer[T], v *T, err error, lookup *policy.Lookup) error {
+	if err != nil {
+		if state.Load() != nil {
+			logger.Warn.Printf("Keeping previous values of lookup %s, %v", lookup.Name, err)
+		}
+		return err
+	}
+	state.Store(v)
+	return nil
+}
+
+// anyAddr creates a predicate that holds if any IP address of attr satisfies p.
+func (op *Operations) anyAddr(attr string, p func(netip.Addr) bool) func(r *Record) bool {
+	m := op.mapper.MapIP(attr)
+	return func(r *Record) bool {
+		for _, ip := range m(r) {
+			if p(ip) {
 				return true
 			}
 		}
+		return false
+	}
+}
+
+// anyStr creates a predicate that holds if any string value of attr satisfies p. Elements of list
+// attributes are read from their lists, and values of scalar attributes are not split.
+func (op *Operations) anyStr(attr string, p func(r *Record, v string) bool) func(r *Record) bool {
+	if f, ok := op.mapper.Mappers[attr]; ok && f.IsList() {
+		m := op.mapper.MapStrList(attr)
+		return func(r *Record) bool {
+			for _, v := range m(r) {
+				if p(r, v) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	m := op.mapper.MapStr(attr)
+	return func(r *Record) bool { return p(r, m(r)) }
+}
+
+// estimate returns the estimate of a predicate over attrs, accounting for the cost of evaluating scripted attributes.
+func (op *Operations) estimate(est policy.Estimate, attrs ...string) policy.Estimate {
+	for _, attr := range attrs {
+		if strings.HasPrefix(attr, ScriptAttrPrefix) {
+			if _, ok := op.mapper.Mappers[attr]; ok {
+				est.Cost += scriptCost
+			}
+		}
+	}
+	return est
+}
+
+// eqFunc returns the function of an equality operator over strings, or nil for other operators.
+func (op *Operations) eqFunc(operator source.Operator) source.OpFunc[string] {
+	if operator != source.Eq && operator != source.IEq {
+		return nil
+	}
+	o, _ := op.strOps.OpFunc(operator)
+	return o
+}
+
+// MapStr creates a function that retrieves the string value of an attribute.
+func (op *Operations) MapStr(attr string) func(r *Record) string {
+	return op.mapper.MapStr(attr)
+}
+
+// Field returns the kind of attribute attr, and false if attr is not a record attribute.
+func (op *Operations) Field(attr string) (source.FieldKind, bool) {
+	baseattr, _, _ := cut(attr, "[")
+	if baseattr == "" {
+		baseattr = attr
+	}
+	if _, ok := op.mapper.Mappers[baseattr]; !ok {
+		return source.AnyKind, false
+	}
+	return fieldKinds[baseattr], true
+}
+
+// ipAttrs maps network attributes to the integer attributes holding their IPv4 addresses.
+var ipAttrs = map[string][]sfgo.Attribute{
+	SF_NET_SIP:   {sfgo.FL_NETW_SIP_INT},
+	SF_NET_DIP:   {sfgo.FL_NETW_DIP_INT},
+	SF_NET_IP:    {sfgo.FL_NETW_SIP_INT, sfgo.FL_NETW_DIP_INT},
+	FALCO_FD_SIP: {sfgo.FL_NETW_SIP_INT},
+	FALCO_FD_DIP: {sfgo.FL_NETW_DIP_INT},
+	FALCO_FD_IP:  {sfgo.FL_NETW_SIP_INT, sfgo.FL_NETW_DIP_INT},
+}
+
+// ipv4 converts an IPv4 address encoded in an integer attribute (first octet in the lowest byte) into an IP address.
+func ipv4(v int64) netip.Addr {
+	return netip.AddrFrom4([4]byte{byte(v), byte(v >> 8), byte(v >> 16), byte(v >> 24)})
+}
+
+// isIPList checks whether all values of list are IP addresses.
+func isIPList(list []string) bool {
+	for _, v := range list {
+		if _, err := netip.ParseAddr(strings.TrimSpace(v)); err != nil {
+			return false
+		}
+	}
+	return len(list) > 0
+}
+
+// fieldKinds maps exported attributes to their value kinds.
+var fieldKinds = getFieldKinds()
+
+// getFieldKinds returns the value kinds of exported attributes. Kinds of non-exported
+// and array attributes are left unspecified.
+func getFieldKinds() map[string]source.FieldKind {
+	kinds := make(map[string]source.FieldKind)
+	for k, v := range getExportedMappers() {
+		switch v.Type {
+		case MapIntVal, MapSpecialInt:
+			kinds[k] = source.IntKind
+		case MapStrVal, MapSpecialStr, MapArrayStr:
+			kinds[k] = source.StrKind
+		case MapBoolVal, MapSpecialBool:
+			kinds[k] = source.BoolKind
+		}
+	}
+	return kinds
+}
+
+// anyOf returns a predicate that holds if a string value satisfies o with any of values.
+func anyOf(values []string, o source.OpFunc[string]) func(r *Record, v string) bool {
+	return func(r *Record, v string) bool {
+		for _, value := range values {
+			if o(v, value) {
+				return true
+			}
+		}
+		return false
 	}
-	return false
 }
 
 // compareInt compares two int64 values based on an operator.
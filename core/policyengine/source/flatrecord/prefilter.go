@@ -21,6 +21,7 @@
 package flatrecord
 
 import (
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
 )
@@ -36,7 +37,7 @@ func (s *Prefilter) IsApplicable(r *Record, rule policy.Rule[*Record]) bool {
 	if rule.Prefilter == nil || len(rule.Prefilter) == 0 {
 		return true
 	}
-	rtype := Mapper.MapStr(SF_TYPE)(r)
+	rtype := s.Value(r)
 	for _, pf := range rule.Prefilter {
 		if rtype == pf {
 			return true
@@ -44,3 +45,131 @@ func (s *Prefilter) IsApplicable(r *Record, rule policy.Rule[*Record]) bool {
 	}
 	return false
 }
+
+// Value returns the record type of r.
+func (s *Prefilter) Value(r *Record) string {
+	return Mapper.MapStr(SF_TYPE)(r)
+}
+
+// sectionTypes lists the record types on which the attributes of a section exist. Records of other types
+// do not carry meaningful values for these attributes.
+var sectionTypes = map[SectionType][]string{
+	SectFile:   {sfgo.TyFFStr, sfgo.TyFEStr},
+	SectNet:    {sfgo.TyNFStr},
+	SectFlow:   {sfgo.TyNFStr, sfgo.TyFFStr},
+	SectK8sEvt: {sfgo.TyKEStr},
+}
+
+var (
+	procEvtTypes = []string{sfgo.TyPEStr}
+	fileEvtTypes = []string{sfgo.TyFEStr}
+	flowTypes    = []string{sfgo.TyFFStr, sfgo.TyNFStr}
+)
+
+// opTypes maps the names of operations reported by sf.opflags and evt.type to the record types on which
+// they occur. Operations not listed may occur on any record type.
+var opTypes = map[string][]string{
+	sfgo.OpFlagClone:    procEvtTypes,
+	sfgo.OpFlagExec:     procEvtTypes,
+	sfgo.OpFlagExit:     procEvtTypes,
+	sfgo.OpFlagSetuid:   procEvtTypes,
+	sfgo.EvTypeClone:    procEvtTypes,
+	sfgo.EvTypeExec:     procEvtTypes,
+	sfgo.EvTypeExit:     procEvtTypes,
+	sfgo.EvTypeSetuid:   procEvtTypes,
+	sfgo.OpFlagMkdir:    fileEvtTypes,
+	sfgo.OpFlagRmdir:    fileEvtTypes,
+	sfgo.OpFlagLink:     fileEvtTypes,
+	sfgo.OpFlagSymlink:  fileEvtTypes,
+	sfgo.OpFlagUnlink:   fileEvtTypes,
+	sfgo.OpFlagRename:   fileEvtTypes,
+	sfgo.EvTypeMkdir:    fileEvtTypes,
+	sfgo.EvTypeRmdir:    fileEvtTypes,
+	sfgo.EvTypeLink:     fileEvtTypes,
+	sfgo.EvTypeSymlink:  fileEvtTypes,
+	sfgo.EvTypeUnlink:   fileEvtTypes,
+	sfgo.EvTypeRename:   fileEvtTypes,
+	sfgo.OpFlagOpen:     flowTypes,
+	sfgo.OpFlagAccept:   flowTypes,
+	sfgo.OpFlagConnect:  flowTypes,
+	sfgo.OpFlagWrite:    flowTypes,
+	sfgo.OpFlagSend:     flowTypes,
+	sfgo.OpFlagRead:     flowTypes,
+	sfgo.OpFlagReceive:  flowTypes,
+	sfgo.OpFlagMmap:     flowTypes,
+	sfgo.OpFlagShutdown: flowTypes,
+	sfgo.OpFlagClose:    flowTypes,
+	sfgo.OpFlagTruncate: flowTypes,
+	sfgo.OpFlagDigest:   flowTypes,
+	sfgo.EvTypeOpen:     flowTypes,
+	sfgo.EvTypeAccept:   flowTypes,
+	sfgo.EvTypeConnect:  flowTypes,
+	sfgo.EvTypeWrite:    flowTypes,
+	sfgo.EvTypeSend:     flowTypes,
+	sfgo.EvTypeRead:     flowTypes,
+	sfgo.EvTypeReceive:  flowTypes,
+	sfgo.EvTypeMmap:     flowTypes,
+	sfgo.EvTypeShutdown: flowTypes,
+	sfgo.EvTypeClose:    flowTypes,
+}
+
+// probeRecords holds a record of each type whose other attributes are zero values. Predicates are evaluated
+// on probe records to infer the record types on which they may hold.
+var probeRecords = newProbeRecords()
+
+func newProbeRecords() []*Record {
+	var recs []*Record
+	for t := sfgo.SF_HEADER; t < sfgo.SF_UNKNOWN; t++ {
+		ints := make([]int64, sfgo.INT_ARRAY_SIZE)
+		ints[sfgo.SF_REC_TYPE] = int64(t)
+		recs = append(recs, NewRecord(&sfgo.FlatRecord{
+			Sources: []sfgo.Source{sfgo.SYSFLOW_SRC},
+			Ints:    [][]int64{ints},
+			Strs:    [][]string{make([]string, sfgo.STR_ARRAY_SIZE)},
+			Anys:    [][]interface{}{make([]interface{}, sfgo.ANY_ARRAY_SIZE)},
+		}))
+	}
+	return recs
+}
+
+// inferPrefilter returns the record types on which predicate p over attribute attr may hold, or nil if it
+// may hold on records of any type. If p compares attr with a list of values using an equality operator o,
+// values and o are used to infer the record types of operation names; they are nil otherwise.
+func inferPrefilter(attr string, p func(*Record) bool, values []string, o source.OpFunc[string]) []string {
+	switch attr {
+	case SF_TYPE:
+		// the record type is the only attribute p depends on
+		types := []string{}
+		for _, r := range probeRecords {
+			if p(r) {
+				types = append(types, Mapper.MapStr(SF_TYPE)(r))
+			}
+		}
+		return types
+	case SF_OPFLAGS, FALCO_EVT_TYPE:
+		if o == nil {
+			return nil
+		}
+		types := []string{}
+		for _, v := range values {
+			found := false
+			for name, t := range opTypes {
+				if o(name, v) {
+					types = append(types, t...)
+					found = true
+				}
+			}
+			if !found {
+				return nil
+			}
+		}
+		return types
+	}
+	// predicates that do not hold on zero values may only hold on the record types of their attribute's section
+	if f, ok := Mapper.Mappers[attr]; ok {
+		if types, ok := sectionTypes[f.Section]; ok && !p(probeRecords[0]) {
+			return types
+		}
+	}
+	return nil
+}
@@ -22,10 +22,13 @@
 package flatrecord
 
 import (
+	"encoding/binary"
+	"fmt"
 	"path/filepath"
 	"strconv"
 	"strings"
 
+	"github.com/cespare/xxhash/v2"
 	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/common"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
@@ -41,7 +44,7 @@ type Record struct {
 func NewRecord(fr *sfgo.FlatRecord) *Record {
 	var r = new(Record)
 	r.Fr = fr
-	r.Ctx = make(Context, 4)
+	r.Ctx = make(Context, numCtxKeys)
 	return r
 }
 
@@ -112,6 +115,38 @@ func (r Record) GetStr(attr sfgo.Attribute, src sfgo.Source) string {
 	return sfgo.Zeros.String
 }
 
+// ID returns a unique identifier for the record.
+func (r *Record) ID() string {
+	var b [8]byte
+	writeInt := func(h *xxhash.Digest, attr sfgo.Attribute) {
+		binary.LittleEndian.PutUint64(b[:], uint64(r.GetInt(attr, sfgo.SYSFLOW_SRC)))
+		h.Write(b[:])
+	}
+	h := xxhash.New()
+	t := Mapper.MapStr(SF_TYPE)(r)
+	h.Write([]byte(Mapper.MapStr(SF_NODE_ID)(r)))
+	h.Write([]byte(Mapper.MapStr(SF_CONTAINER_ID)(r)))
+	writeInt(h, sfgo.TS_INT)
+	writeInt(h, sfgo.TID_INT)
+	writeInt(h, sfgo.PROC_OID_CREATETS_INT)
+	writeInt(h, sfgo.EV_PROC_OPFLAGS_INT)
+	switch t {
+	case sfgo.TyFFStr, sfgo.TyFEStr:
+		h.Write([]byte(Mapper.MapStr(SF_FILE_OID)(r)))
+	case sfgo.TyNFStr:
+		writeInt(h, sfgo.FL_NETW_SIP_INT)
+		writeInt(h, sfgo.FL_NETW_SPORT_INT)
+		writeInt(h, sfgo.FL_NETW_DIP_INT)
+		writeInt(h, sfgo.FL_NETW_DPORT_INT)
+		writeInt(h, sfgo.FL_NETW_PROTO_INT)
+	case sfgo.TyKEStr:
+		writeInt(h, sfgo.K8SE_ACTION_INT)
+		writeInt(h, sfgo.K8SE_KIND_INT)
+		h.Write([]byte(Mapper.MapStr(SF_K8SE_MESSAGE)(r)))
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
 // GetCachedValue returns the value of attr from cache for process ID.
 func (r Record) GetCachedValue(ID sfgo.OID, attr RecAttribute) interface{} {
 	if ptree := r.Fr.Ptree; ptree != nil {
@@ -159,41 +194,52 @@ func (r Record) GetCachedValue(ID sfgo.OID, attr RecAttribute) interface{} {
 				}
 				return ptree[1].Exe
 			}
+		case ProcAName, ProcAExe, ProcACmdLine, ProcAPID:
+			return strings.Join(r.GetCachedValues(attr), common.LISTSEP)
+		}
+	}
+	switch attr {
+	case PProcUID, PProcGID, PProcTTY, PProcEntry:
+		return sfgo.Zeros.Int64
+	}
+	return sfgo.Zeros.String
+}
+
+// GetCachedValues returns the values of a process ancestry attribute from cache, starting with the
+// process itself.
+func (r Record) GetCachedValues(attr RecAttribute) []string {
+	ptree := r.Fr.Ptree
+	s := make([]string, 0, len(ptree))
+	for _, p := range ptree {
+		switch attr {
 		case ProcAName:
-			var s []string
-			for _, p := range ptree {
-				s = append(s, filepath.Base(p.Exe))
-			}
-			return strings.Join(s, common.LISTSEP)
+			s = append(s, filepath.Base(p.Exe))
 		case ProcAExe:
-			var s []string
-			for _, p := range ptree {
-				s = append(s, p.Exe)
-			}
-			return strings.Join(s, common.LISTSEP)
+			s = append(s, p.Exe)
 		case ProcACmdLine:
-			var s []string
-			for _, p := range ptree {
-				if len(p.ExeArgs) > 0 {
-					s = append(s, p.Exe+common.SPACE+p.ExeArgs)
-				} else {
-					s = append(s, p.Exe)
-				}
+			if len(p.ExeArgs) > 0 {
+				s = append(s, p.Exe+common.SPACE+p.ExeArgs)
+			} else {
+				s = append(s, p.Exe)
 			}
-			return strings.Join(s, common.LISTSEP)
 		case ProcAPID:
-			var s []string
-			for _, p := range ptree {
-				s = append(s, strconv.FormatInt(p.Oid.Hpid, 10))
-			}
-			return strings.Join(s, common.LISTSEP)
+			s = append(s, strconv.FormatInt(p.Oid.Hpid, 10))
 		}
 	}
-	switch attr {
-	case PProcUID, PProcGID, PProcTTY, PProcEntry:
-		return sfgo.Zeros.Int64
+	return s
+}
+
+// GetCachedInts returns the values of a numerical process ancestry attribute from cache, starting with
+// the process itself.
+func (r Record) GetCachedInts(attr RecAttribute) []int64 {
+	ptree := r.Fr.Ptree
+	v := make([]int64, 0, len(ptree))
+	if attr == ProcAPID {
+		for _, p := range ptree {
+			v = append(v, p.Oid.Hpid)
+		}
 	}
-	return sfgo.Zeros.String
+	return v
 }
 
 // Context denotes the type for contextual information obtained during rule processing.
@@ -208,6 +254,12 @@ const (
 	ruleCtxKey
 	tagCtxKey
 	hashCtxKey
+	corrCtxKey
+	countCtxKey
+	outputCtxKey
+	priorityCtxKey
+	suppressedCtxKey
+	numCtxKeys
 )
 
 func (s Context) IsAlert() bool {
@@ -262,6 +314,90 @@ func (s Context) GetTags() []string {
 	return nil
 }
 
+// AddCorrelatedIDs adds the identifiers of correlated records to context object.
+func (s Context) AddCorrelatedIDs(ids ...string) {
+	if s[corrCtxKey] == nil {
+		s[corrCtxKey] = make([]string, 0)
+	}
+	s[corrCtxKey] = append(s[corrCtxKey].([]string), ids...)
+}
+
+// GetCorrelatedIDs retrieves the identifiers of correlated records from context object.
+func (s Context) GetCorrelatedIDs() []string {
+	if s[corrCtxKey] != nil {
+		return s[corrCtxKey].([]string)
+	}
+	return nil
+}
+
+// SetCount stores the aggregated count of a threshold rule into context object.
+func (s Context) SetCount(rule string, count int) {
+	if s[countCtxKey] == nil {
+		s[countCtxKey] = make(map[string]int)
+	}
+	s[countCtxKey].(map[string]int)[rule] = count
+}
+
+// GetCount retrieves the aggregated count of a threshold rule from context object.
+func (s Context) GetCount(rule string) int {
+	if s[countCtxKey] != nil {
+		return s[countCtxKey].(map[string]int)[rule]
+	}
+	return 0
+}
+
+// SetSuppressed stores the number of alerts of a rule suppressed before the record into context object.
+func (s Context) SetSuppressed(rule string, count int) {
+	if s[suppressedCtxKey] == nil {
+		s[suppressedCtxKey] = make(map[string]int)
+	}
+	s[suppressedCtxKey].(map[string]int)[rule] = count
+}
+
+// GetSuppressed retrieves the number of alerts of a rule suppressed before the record from context object.
+func (s Context) GetSuppressed(rule string) int {
+	if s[suppressedCtxKey] != nil {
+		return s[suppressedCtxKey].(map[string]int)[rule]
+	}
+	return 0
+}
+
+// SetOutput stores the rendered output message of a rule into context object.
+func (s Context) SetOutput(rule string, output string) {
+	if s[outputCtxKey] == nil {
+		s[outputCtxKey] = make(map[string]string)
+	}
+	s[outputCtxKey].(map[string]string)[rule] = output
+}
+
+// GetOutput retrieves the rendered output message of a rule from context object.
+func (s Context) GetOutput(rule string) string {
+	if s[outputCtxKey] != nil {
+		return s[outputCtxKey].(map[string]string)[rule]
+	}
+	return ""
+}
+
+// SetPriority overrides the priority of a rule matching the record.
+func (s Context) SetPriority(rule string, p policy.Priority) {
+	if s[priorityCtxKey] == nil {
+		s[priorityCtxKey] = make(map[string]policy.Priority)
+	}
+	s[priorityCtxKey].(map[string]policy.Priority)[rule] = p
+}
+
+// GetPriority retrieves the priority of a rule matching the record, which is the rule's priority
+// unless overridden.
+func (s Context) GetPriority(rule policy.Rule[*Record]) policy.Priority {
+	if s[priorityCtxKey] != nil {
+		if p, ok := s[priorityCtxKey].(map[string]policy.Priority)[rule.Name]; ok {
+			return p
+		}
+	}
+	return rule.Priority
+}
+
+// GetHash retrieves a hash set from context object.
 func (s Context) GetHash(ht HashType) *HashSet {
 	if s[hashCtxKey] == nil {
 		return nil
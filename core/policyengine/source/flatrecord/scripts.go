@@ -0,0 +1,389 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flatrecord implements a flatrecord source for the policy compilers.
+package flatrecord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/sysflow-telemetry/sf-apis/go/ioutils"
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
+	"go.starlark.net/starlark"
+)
+
+// ScriptAttrPrefix is the prefix of the attributes computed by scripted predicates.
+const ScriptAttrPrefix = "script."
+
+// Script globals declaring scripted predicates and actions.
+const (
+	scriptPredicates = "predicates"
+	scriptActions    = "actions"
+)
+
+const (
+	// scriptExt is the extension of script files.
+	scriptExt = ".star"
+	// scriptMaxSteps bounds the number of execution steps of script loading and of a scripted action call.
+	scriptMaxSteps = 1000000
+	// predicateMaxSteps bounds the number of execution steps of a scripted predicate evaluation, which
+	// runs for each record evaluated against the predicate, in the policy engine's workers.
+	predicateMaxSteps = 10000
+	// scriptCost is the estimated cost of evaluating a scripted attribute.
+	scriptCost = 50
+)
+
+// scripts holds the predicates and actions declared by a set of Starlark scripts.
+type scripts struct {
+	predicates map[string]*starlark.Function
+	actions    map[string]*starlark.Function
+}
+
+// loadScripts loads the Starlark scripts (*.star) found in path. Scripts declare predicates and
+// actions in the top-level dictionaries predicates and actions, which map names to functions.
+// Predicates take a record, and actions take a record and an optional string argument.
+func loadScripts(path string) (*scripts, error) {
+	paths, err := ioutils.ListFilePaths(path, scriptExt)
+	if err != nil {
+		return nil, err
+	}
+	s := &scripts{predicates: make(map[string]*starlark.Function), actions: make(map[string]*starlark.Function)}
+	for _, p := range paths {
+		logger.Info.Println("Loading script from file " + p)
+		globals, err := starlark.ExecFile(newScriptThread(p, scriptMaxSteps), p, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.declare(p, globals, scriptPredicates, s.predicates, 1, 1); err != nil {
+			return nil, err
+		}
+		if err := s.declare(p, globals, scriptActions, s.actions, 1, 2); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// declare adds the functions of dictionary global of a script to fns. Functions must take
+// between min and max parameters.
+func (s *scripts) declare(path string, globals starlark.StringDict, global string, fns map[string]*starlark.Function, min int, max int) error {
+	v, ok := globals[global]
+	if !ok {
+		return nil
+	}
+	d, ok := v.(*starlark.Dict)
+	if !ok {
+		return fmt.Errorf("%s: %s must be a dict, not %s", path, global, v.Type())
+	}
+	for _, item := range d.Items() {
+		name, ok := starlark.AsString(item[0])
+		if !ok || name == "" {
+			return fmt.Errorf("%s: %s keys must be non-empty strings, got %s", path, global, item[0])
+		}
+		fn, ok := item[1].(*starlark.Function)
+		if !ok {
+			return fmt.Errorf("%s: %s '%s' must be a function, not %s", path, global, name, item[1].Type())
+		}
+		if fn.NumParams() < min || fn.NumParams() > max || fn.HasVarargs() || fn.HasKwargs() {
+			return fmt.Errorf("%s: %s '%s' has an invalid signature", path, global, name)
+		}
+		if _, ok := fns[name]; ok {
+			return fmt.Errorf("%s: re-declaration of %s '%s'", path, global, name)
+		}
+		fns[name] = fn
+	}
+	return nil
+}
+
+// newScriptThread creates a Starlark thread whose execution steps are bounded by maxSteps. Scripts cannot
+// load modules.
+func newScriptThread(name string, maxSteps uint64) *starlark.Thread {
+	thread := &starlark.Thread{
+		Name:  name,
+		Print: func(t *starlark.Thread, msg string) { logger.Trace.Printf("%s: %s", t.Name, msg) },
+	}
+	thread.SetMaxExecutionSteps(maxSteps)
+	return thread
+}
+
+// callScript calls a script function with a budget of maxSteps execution steps, canceling its execution
+// once ctx is done.
+func callScript(ctx context.Context, fn *starlark.Function, args starlark.Tuple, maxSteps uint64) (starlark.Value, error) {
+	thread := newScriptThread(fn.Name(), maxSteps)
+	if ctx.Done() != nil {
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			select {
+			case <-ctx.Done():
+				thread.Cancel(ctx.Err().Error())
+			case <-stop:
+			}
+		}()
+	}
+	v, err := starlark.Call(thread, fn, args, nil)
+	if err != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return v, err
+}
+
+// LoadScripts loads the scripts found in path, exposes each predicate they declare as a record attribute
+// prefixed with ScriptAttrPrefix (e.g., script.is_shell), and returns the builders of the actions they
+// declare. Predicates are evaluated with read-only access to records.
+func (op *Operations) LoadScripts(path string) (map[string]source.ActionBuilder[*Record], error) {
+	s, err := loadScripts(path)
+	if err != nil {
+		return nil, err
+	}
+	mappers := make(map[string]*FieldEntry, len(op.mapper.Mappers)+len(s.predicates))
+	for k, v := range op.mapper.Mappers {
+		mappers[k] = v
+	}
+	for name, fn := range s.predicates {
+		mappers[ScriptAttrPrefix+name] = &FieldEntry{Map: mapScript(fn), Type: MapAny, Source: sfgo.SYSFLOW_SRC, Section: SectNone}
+	}
+	op.mapper = FieldMapper{mappers}
+	builders := make(map[string]source.ActionBuilder[*Record], len(s.actions))
+	for name, fn := range s.actions {
+		builders[name] = scriptAction(fn)
+	}
+	return builders, nil
+}
+
+// mapScript creates a field map evaluating a scripted predicate. Predicates returning booleans, integers,
+// or strings map to values of the same kind; failing predicates map to an empty value, and their first
+// error is logged.
+func mapScript(fn *starlark.Function) FieldMap {
+	var once sync.Once
+	return func(r *Record) interface{} {
+		v, err := callScript(context.Background(), fn, starlark.Tuple{&scriptRecord{r: r}}, predicateMaxSteps)
+		if err != nil {
+			once.Do(func() { logger.Error.Printf("Error in scripted predicate '%s': %v", fn.Name(), err) })
+			return sfgo.Zeros.String
+		}
+		switch val := v.(type) {
+		case starlark.Bool:
+			return bool(val)
+		case starlark.Int:
+			if i, ok := val.Int64(); ok {
+				return i
+			}
+		case starlark.String:
+			return string(val)
+		case starlark.NoneType:
+			return sfgo.Zeros.String
+		}
+		return v.String()
+	}
+}
+
+// scriptAction creates the builder of a scripted action. The action function is called with the
+// record, and with the argument of the action reference if it declares two parameters.
+func scriptAction(fn *starlark.Function) source.ActionBuilder[*Record] {
+	return func(rule policy.Rule[*Record], arg string) (func(ctx context.Context, r *Record) error, error) {
+		if fn.NumParams() == 1 && arg != "" {
+			return nil, fmt.Errorf("action takes no argument")
+		}
+		return func(ctx context.Context, r *Record) error {
+			args := starlark.Tuple{&scriptRecord{r: r, rule: &rule}}
+			if fn.NumParams() == 2 {
+				args = append(args, starlark.String(arg))
+			}
+			_, err := callScript(ctx, fn, args, scriptMaxSteps)
+			return err
+		}, nil
+	}
+}
+
+// scriptRecord exposes a record to scripts. Attributes are read by indexing the record with attribute
+// names (e.g., r["sf.proc.exe"]). Records passed to actions can be enriched with tags and priorities.
+type scriptRecord struct {
+	r *Record
+	// rule whose action is being executed, or nil for read-only records passed to predicates
+	rule *policy.Rule[*Record]
+}
+
+var (
+	_ starlark.Mapping  = (*scriptRecord)(nil)
+	_ starlark.HasAttrs = (*scriptRecord)(nil)
+)
+
+// scriptRecordMethods maps the names of record methods to their implementations.
+var scriptRecordMethods = map[string]func(s *scriptRecord, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error){
+	"get":          (*scriptRecord).get,
+	"tags":         (*scriptRecord).tags,
+	"rules":        (*scriptRecord).rules,
+	"add_tag":      (*scriptRecord).addTag,
+	"set_priority": (*scriptRecord).setPriority,
+}
+
+func (s *scriptRecord) String() string        { return "record(" + Mapper.MapStr(SF_TYPE)(s.r) + ")" }
+func (s *scriptRecord) Type() string          { return "record" }
+func (s *scriptRecord) Freeze()               {}
+func (s *scriptRecord) Truth() starlark.Bool  { return starlark.True }
+func (s *scriptRecord) Hash() (uint32, error) { return 0, errors.New("unhashable type: record") }
+
+// Get returns the value of a record attribute.
+func (s *scriptRecord) Get(k starlark.Value) (starlark.Value, bool, error) {
+	attr, ok := starlark.AsString(k)
+	if !ok {
+		return nil, false, fmt.Errorf("record attribute must be a string, not %s", k.Type())
+	}
+	f, ok := Mapper.Mappers[attr]
+	if !ok {
+		return nil, false, nil
+	}
+	return toStarlark(f, f.Map(s.r)), true, nil
+}
+
+// Attr returns a record method.
+func (s *scriptRecord) Attr(name string) (starlark.Value, error) {
+	m, ok := scriptRecordMethods[name]
+	if !ok {
+		return nil, nil
+	}
+	return starlark.NewBuiltin(name, func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		return m(s, b, args, kwargs)
+	}), nil
+}
+
+// AttrNames returns the names of record methods.
+func (s *scriptRecord) AttrNames() []string {
+	names := make([]string, 0, len(scriptRecordMethods))
+	for name := range scriptRecordMethods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// get returns the value of a record attribute, or a default value if the attribute does not exist.
+func (s *scriptRecord) get(b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var attr string
+	var def starlark.Value = starlark.None
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &attr, &def); err != nil {
+		return nil, err
+	}
+	if v, ok, _ := s.Get(starlark.String(attr)); ok {
+		return v, nil
+	}
+	return def, nil
+}
+
+// tags returns the tags of the record.
+func (s *scriptRecord) tags(b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+		return nil, err
+	}
+	return toStarlarkList(s.r.Ctx.GetTags()), nil
+}
+
+// rules returns the names of the rules matched by the record.
+func (s *scriptRecord) rules(b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, rule := range s.r.Ctx.GetRules() {
+		names = append(names, rule.Name)
+	}
+	return toStarlarkList(names), nil
+}
+
+// addTag adds tags to the record.
+func (s *scriptRecord) addTag(b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if s.rule == nil {
+		return nil, fmt.Errorf("%s: record is read-only", b.Name())
+	}
+	if len(kwargs) > 0 || len(args) == 0 {
+		return nil, fmt.Errorf("%s: expected one or more tags", b.Name())
+	}
+	tags := make([]string, 0, len(args))
+	for _, arg := range args {
+		tag, ok := starlark.AsString(arg)
+		if !ok {
+			return nil, fmt.Errorf("%s: tag must be a string, not %s", b.Name(), arg.Type())
+		}
+		tags = append(tags, tag)
+	}
+	s.r.Ctx.AddTags(tags...)
+	return starlark.None, nil
+}
+
+// setPriority overrides the priority of the rule for the record.
+func (s *scriptRecord) setPriority(b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if s.rule == nil {
+		return nil, fmt.Errorf("%s: record is read-only", b.Name())
+	}
+	var name string
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &name); err != nil {
+		return nil, err
+	}
+	p, err := policy.ParsePriority(name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", b.Name(), err)
+	}
+	s.r.Ctx.SetPriority(s.rule.Name, p)
+	return starlark.None, nil
+}
+
+// toStarlark converts the value of attribute f to a Starlark value.
+func toStarlark(f *FieldEntry, v interface{}) starlark.Value {
+	switch val := v.(type) {
+	case string:
+		return starlark.String(val)
+	case int64:
+		if f.Type == MapBoolVal {
+			return starlark.Bool(val != 0)
+		}
+		return starlark.MakeInt64(val)
+	case int32:
+		return starlark.MakeInt64(int64(val))
+	case bool:
+		return starlark.Bool(val)
+	case *[]int64:
+		l := starlark.NewList(nil)
+		if val != nil {
+			for _, i := range *val {
+				_ = l.Append(starlark.MakeInt64(i))
+			}
+		}
+		return l
+	case nil:
+		return starlark.None
+	}
+	return starlark.String(fmt.Sprintf("%v", v))
+}
+
+// toStarlarkList converts a list of strings to a Starlark list.
+func toStarlarkList(values []string) *starlark.List {
+	l := make([]starlark.Value, 0, len(values))
+	for _, v := range values {
+		l = append(l, starlark.String(v))
+	}
+	return starlark.NewList(l)
+}
@@ -0,0 +1,153 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flatrecord implements a flatrecord source for the policy compilers.
+package flatrecord
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source/flatrecord/flatrecordtest"
+)
+
+const testScripts = "../../../../resources/policies/tests/scripts"
+
+func TestMain(m *testing.M) {
+	logger.InitLoggers(logger.TRACE)
+	os.Exit(m.Run())
+}
+
+func newScriptRecord(name string, args string, tty bool) *Record {
+	r := NewRecord(flatrecordtest.NewFlatRecord(sfgo.PROC_EVT))
+	r.Fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXE_STR] = "/bin/" + name
+	r.Fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXEARGS_STR] = args
+	if tty {
+		r.Fr.Ints[sfgo.SYSFLOW_IDX][sfgo.PROC_TTY_INT] = 1
+	}
+	return r
+}
+
+func TestScriptedPredicates(t *testing.T) {
+	op := NewOperations().(*Operations)
+	_, err := op.LoadScripts(testScripts)
+	assert.NoError(t, err)
+	kind, ok := op.Field("script.is_shell")
+	assert.True(t, ok)
+	assert.Equal(t, source.AnyKind, kind)
+	_, ok = op.Field("script.missing")
+	assert.False(t, ok)
+	_, ok = NewOperations().(*Operations).Field("script.is_shell")
+	assert.False(t, ok)
+
+	isShell, err := op.Compare("script.is_shell", "true", source.Eq)
+	assert.NoError(t, err)
+	numArgs, err := op.Compare("script.num_args", "1", source.GEq)
+	assert.NoError(t, err)
+	exists, err := op.Exists("script.is_shell")
+	assert.NoError(t, err)
+	assert.Greater(t, op.estimate(source.Eq.Estimate(), "script.is_shell").Cost, source.Eq.Estimate().Cost)
+	assert.Equal(t, source.Eq.Estimate(), op.estimate(source.Eq.Estimate(), SF_PROC_NAME))
+
+	r := newScriptRecord("bash", "-c ls", false)
+	assert.True(t, isShell.Eval(r))
+	assert.True(t, numArgs.Eval(r))
+	assert.True(t, exists.Eval(r))
+	r = newScriptRecord("curl", "", false)
+	assert.False(t, isShell.Eval(r))
+	assert.False(t, numArgs.Eval(r))
+	assert.False(t, exists.Eval(r))
+}
+
+func TestScriptedPredicateErrors(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "p.star")
+	assert.NoError(t, os.WriteFile(path, []byte("def f(r):\n    r.add_tag('x')\n    return True\n\npredicates = {'tagged': f}\n"), 0600))
+	op := NewOperations().(*Operations)
+	_, err := op.LoadScripts(dir)
+	assert.NoError(t, err)
+	c, err := op.Exists("script.tagged")
+	assert.NoError(t, err)
+	r := newScriptRecord("bash", "", false)
+	assert.False(t, c.Eval(r))
+	assert.Empty(t, r.Ctx.GetTags())
+
+	// predicates exceeding their step budget evaluate to an empty value
+	assert.NoError(t, os.WriteFile(path, []byte("def f(r):\n    for i in range(100000):\n        pass\n    return True\n\npredicates = {'loop': f}\n"), 0600))
+	op = NewOperations().(*Operations)
+	_, err = op.LoadScripts(dir)
+	assert.NoError(t, err)
+	c, err = op.Exists("script.loop")
+	assert.NoError(t, err)
+	assert.False(t, c.Eval(r))
+
+	for _, src := range []string{
+		"load('x.star', 'f')\n",
+		"predicates = []\n",
+		"predicates = {'f': 1}\n",
+		"def f(r, a):\n    return True\n\npredicates = {'f': f}\n",
+		"def f():\n    pass\n\nactions = {'f': f}\n",
+	} {
+		assert.NoError(t, os.WriteFile(path, []byte(src), 0600))
+		_, err = NewOperations().(*Operations).LoadScripts(dir)
+		assert.Error(t, err, src)
+	}
+	_, err = NewOperations().(*Operations).LoadScripts(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestScriptedActions(t *testing.T) {
+	scripted, err := NewOperations().(*Operations).LoadScripts(testScripts)
+	assert.NoError(t, err)
+	c := new(Contextualizer)
+	c.SetScriptedActions(scripted)
+	actions := c.Actions(source.ActionConfig{})
+	build := func(name string, rule policy.Rule[*Record], arg string) func(ctx context.Context, r *Record) error {
+		f, err := actions[name](rule, arg)
+		assert.NoError(t, err)
+		return f
+	}
+	rule := policy.Rule[*Record]{Name: "r", Priority: policy.Low}
+	f := build("label", rule, "shell")
+	r := newScriptRecord("bash", "", true)
+	assert.NoError(t, f(context.Background(), r))
+	assert.Equal(t, []string{"script:shell"}, r.Ctx.GetTags())
+	assert.Equal(t, policy.High, r.Ctx.GetPriority(rule))
+	r = newScriptRecord("bash", "", false)
+	assert.NoError(t, f(context.Background(), r))
+	assert.Equal(t, policy.Low, r.Ctx.GetPriority(rule))
+
+	f = build("spin", rule, "")
+	assert.Error(t, f(context.Background(), r))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, f(ctx, r), context.Canceled)
+
+	_, err = actions["spin"](rule, "arg")
+	assert.Error(t, err)
+	_, ok := new(Contextualizer).Actions(source.ActionConfig{})["label"]
+	assert.False(t, ok)
+}
@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package source implements a backend for policy compilers.
+package source
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CompileGlob compiles a glob pattern into a regular expression matching whole strings. In glob patterns,
+// '*' matches any sequence of characters other than '/', '?' matches any character other than '/', '[...]'
+// matches a character class (negated by a leading '!' or '^'), and '\' escapes the next character.
+// A '**' path segment matches zero or more directories, and '**' elsewhere matches any sequence of characters.
+func CompileGlob(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				segment := i == 0 || pattern[i-1] == '/'
+				i++
+				if segment && i+1 < len(pattern) && pattern[i+1] == '/' {
+					sb.WriteString("(?:.*/)?")
+					i++
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			// a ']' following the opening bracket (and negation) is part of the class
+			j := i + 1
+			if j < len(pattern) && (pattern[j] == '!' || pattern[j] == '^') {
+				j++
+			}
+			if j < len(pattern) && pattern[j] == ']' {
+				j++
+			}
+			end := strings.IndexByte(pattern[j:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid glob pattern %s: unterminated character class", pattern)
+			}
+			class := pattern[i+1 : j+end]
+			if class[0] == '!' {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i = j + end
+		case '\\':
+			if i+1 == len(pattern) {
+				return nil, fmt.Errorf("invalid glob pattern %s: trailing escape character", pattern)
+			}
+			i++
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		default:
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	sb.WriteString("$")
+	re, err := regexp.Compile(sb.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob pattern %s: %v", pattern, err)
+	}
+	return re, nil
+}
@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package source implements a backend for policy compilers.
+package source
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileGlob(t *testing.T) {
+	for _, tc := range []struct {
+		pattern string
+		s       string
+		matched bool
+	}{
+		{"/etc/*.conf", "/etc/app.conf", true},
+		{"/etc/*.conf", "/etc/app/app.conf", false},
+		{"/etc/**/*.conf", "/etc/app.conf", true},
+		{"/etc/**/*.conf", "/etc/a/b/app.conf", true},
+		{"/etc/**", "/etc/a/b", true},
+		{"**/bin/sh", "/usr/bin/sh", true},
+		{"**/bin/sh", "bin/sh", true},
+		{"/tmp/**.sh", "/tmp/a/b.sh", true},
+		{"/tmp/file?", "/tmp/file1", true},
+		{"/tmp/file?", "/tmp/file/", false},
+		{"/tmp/[abc].txt", "/tmp/b.txt", true},
+		{"/tmp/[!abc].txt", "/tmp/b.txt", false},
+		{"/tmp/[]x].txt", "/tmp/].txt", true},
+		{"/tmp/a.b", "/tmp/axb", false},
+		{`/tmp/\*`, "/tmp/*", true},
+		{`/tmp/\*`, "/tmp/x", false},
+		{"/tmp/(x)+", "/tmp/(x)+", true},
+	} {
+		re, err := CompileGlob(tc.pattern)
+		assert.NoError(t, err, tc.pattern)
+		assert.Equal(t, tc.matched, re.MatchString(tc.s), "%s on %s", tc.pattern, tc.s)
+	}
+	for _, pattern := range []string{"/tmp/[a-z", `/tmp/\`, "/tmp/[]"} {
+		_, err := CompileGlob(pattern)
+		assert.Error(t, err, pattern)
+	}
+}
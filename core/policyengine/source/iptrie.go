@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package source implements a backend for policy compilers.
+package source
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// PrefixTrie is a binary trie of IP networks, supporting longest-prefix lookups of IPv4 and IPv6 addresses
+// in time proportional to the address length, regardless of the number of networks. IPv4 networks are stored
+// as IPv4-mapped IPv6 networks, so that IPv4-mapped IPv6 addresses match them.
+type PrefixTrie struct {
+	root trieNode
+}
+
+type trieNode struct {
+	children [2]*trieNode
+	leaf     bool
+}
+
+// NewPrefixTrie creates a trie from a list of networks in CIDR notation. Bare IP addresses denote single-host networks.
+func NewPrefixTrie(cidrs ...string) (*PrefixTrie, error) {
+	t := &PrefixTrie{}
+	for _, cidr := range cidrs {
+		prefix, err := ParsePrefix(cidr)
+		if err != nil {
+			return nil, err
+		}
+		t.Insert(prefix)
+	}
+	return t, nil
+}
+
+// ParsePrefix parses a network in CIDR notation, or an IP address denoting a single-host network.
+func ParsePrefix(cidr string) (netip.Prefix, error) {
+	cidr = strings.TrimSpace(cidr)
+	if !strings.Contains(cidr, "/") {
+		ip, err := netip.ParseAddr(cidr)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid CIDR %s", cidr)
+		}
+		return netip.PrefixFrom(ip, ip.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR %s", cidr)
+	}
+	return prefix, nil
+}
+
+// Insert adds a network to the trie.
+func (t *PrefixTrie) Insert(prefix netip.Prefix) {
+	bits := prefix.Bits()
+	if prefix.Addr().Is4() {
+		bits += 96
+	}
+	addr := prefix.Addr().As16()
+	n := &t.root
+	for i := 0; i < bits && !n.leaf; i++ {
+		b := bit(addr, i)
+		if n.children[b] == nil {
+			n.children[b] = &trieNode{}
+		}
+		n = n.children[b]
+	}
+	n.leaf = true
+	n.children = [2]*trieNode{}
+}
+
+// Contains checks whether ip belongs to any network of the trie.
+func (t *PrefixTrie) Contains(ip netip.Addr) bool {
+	if !ip.IsValid() {
+		return false
+	}
+	addr := ip.As16()
+	n := &t.root
+	for i := 0; i < 128; i++ {
+		if n.leaf {
+			return true
+		}
+		if n = n.children[bit(addr, i)]; n == nil {
+			return false
+		}
+	}
+	return n.leaf
+}
+
+// bit returns the i-th most significant bit of addr.
+func bit(addr [16]byte, i int) int {
+	return int(addr[i/8]>>(7-i%8)) & 1
+}
@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package source implements a backend for policy compilers.
+package source
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixTrie(t *testing.T) {
+	trie, err := NewPrefixTrie("10.0.0.0/8", "10.1.0.0/16", "192.168.1.7", "fe80::/10", "2001:db8::1")
+	assert.NoError(t, err)
+	for addr, contained := range map[string]bool{
+		"10.0.0.1":           true,
+		"10.255.255.255":     true,
+		"11.0.0.1":           false,
+		"192.168.1.7":        true,
+		"192.168.1.8":        false,
+		"::ffff:10.2.3.4":    true,
+		"fe80::1%eth0":       true,
+		"fec0::1":            false,
+		"2001:db8::1":        true,
+		"2001:db8::2":        false,
+		"::a00:1":            false,
+		"0.0.0.0":            false,
+		"::ffff:192.168.1.7": true,
+	} {
+		assert.Equal(t, contained, trie.Contains(netip.MustParseAddr(addr)), addr)
+	}
+	assert.False(t, trie.Contains(netip.Addr{}))
+
+	// shorter prefixes subsume longer ones, regardless of insertion order
+	trie, err = NewPrefixTrie("10.1.2.0/24", "10.0.0.0/8")
+	assert.NoError(t, err)
+	assert.True(t, trie.Contains(netip.MustParseAddr("10.200.0.1")))
+
+	trie, err = NewPrefixTrie("0.0.0.0/0")
+	assert.NoError(t, err)
+	assert.True(t, trie.Contains(netip.MustParseAddr("8.8.8.8")))
+	assert.False(t, trie.Contains(netip.MustParseAddr("2001:db8::1")))
+
+	_, err = NewPrefixTrie("10.0.0.0/33")
+	assert.Error(t, err)
+	_, err = NewPrefixTrie("not an address")
+	assert.Error(t, err)
+}
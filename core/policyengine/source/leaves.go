@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package source implements a backend for policy compilers.
+package source
+
+import (
+	"math"
+	"strings"
+
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+)
+
+// Estimates of predicates that do not depend on an operator.
+var (
+	ExistsEstimate = policy.Estimate{Cost: 1, Selectivity: 0.5}
+	RegExpEstimate = policy.Estimate{Cost: 20, Selectivity: 0.2}
+	GlobEstimate   = policy.Estimate{Cost: 10, Selectivity: 0.2}
+)
+
+// Estimate returns the estimated cost and selectivity of a binary predicate with operator s.
+// Case-insensitive operators are costlier since they convert their operands to lower case.
+func (s Operator) Estimate() policy.Estimate {
+	switch s {
+	case Eq:
+		return policy.Estimate{Cost: 1, Selectivity: 0.1}
+	case IEq:
+		return policy.Estimate{Cost: 2, Selectivity: 0.1}
+	case Contains, Startswith, Endswith:
+		return policy.Estimate{Cost: 2, Selectivity: 0.2}
+	case IContains, IStartswith, IEndswith:
+		return policy.Estimate{Cost: 6, Selectivity: 0.2}
+	case InCIDR, IsPrivate, IsLoopback, IsLinkLocal:
+		return policy.Estimate{Cost: 3, Selectivity: 0.2}
+	}
+	return policy.Estimate{Cost: 1, Selectivity: 0.5}
+}
+
+// FoldEstimate returns the estimated cost and selectivity of a predicate folding operator op over n values;
+// all denotes whether the predicate holds if all values (instead of any value) satisfy the operator.
+func FoldEstimate(op Operator, n int, all bool) policy.Estimate {
+	est := op.Estimate()
+	if all {
+		return policy.Estimate{Cost: est.Cost * float64(n), Selectivity: math.Pow(est.Selectivity, float64(n))}
+	}
+	return policy.Estimate{Cost: est.Cost * float64(n), Selectivity: 1 - math.Pow(1-est.Selectivity, float64(n))}
+}
+
+// LeafKey returns the key identifying a leaf predicate built by operation op over args.
+// Backends use it to let programs deduplicate identical predicates across rules.
+func LeafKey(op string, args ...string) string {
+	return op + "\x1f" + strings.Join(args, "\x1f")
+}
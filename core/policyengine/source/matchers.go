@@ -0,0 +1,243 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package source implements a backend for policy compilers.
+package source
+
+import "strings"
+
+// Minimum number of list values for which list matchers compile values into sets, automata, or tries.
+// Shorter lists are matched linearly.
+const minCompiledListSize = 4
+
+// ListMatcher checks whether a string satisfies an operator with any value of a list.
+type ListMatcher func(s string) bool
+
+// NewListMatcher compiles a list of values into a matcher for operator op. Equality operators are
+// compiled into hash sets, containment operators into Aho-Corasick automata, and prefix and suffix
+// operators into tries, so that matching does not depend on the number of values. Case-insensitive
+// operators convert values and matched strings to lower case.
+func NewListMatcher(list []string, op Operator) (ListMatcher, error) {
+	o, err := StrOps{}.OpFunc(op)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) < minCompiledListSize {
+		return func(s string) bool {
+			for _, v := range list {
+				if o(s, v) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+	var match ListMatcher
+	values := list
+	switch op {
+	case IEq, IContains, IStartswith, IEndswith:
+		values = make([]string, len(list))
+		for i, v := range list {
+			values[i] = strings.ToLower(v)
+		}
+	}
+	switch op {
+	case Eq, IEq:
+		set := make(map[string]struct{}, len(values))
+		for _, v := range values {
+			set[v] = struct{}{}
+		}
+		match = func(s string) bool {
+			_, ok := set[s]
+			return ok
+		}
+	case Contains, IContains:
+		match = NewAhoCorasick(values...).Match
+	case Startswith, IStartswith:
+		match = NewAffixTrie(false, values...).Match
+	case Endswith, IEndswith:
+		match = NewAffixTrie(true, values...).Match
+	}
+	switch op {
+	case IEq, IContains, IStartswith, IEndswith:
+		return func(s string) bool { return match(strings.ToLower(s)) }, nil
+	}
+	return match, nil
+}
+
+// byteTrie is a trie of strings, with sparse edges sorted by byte.
+type byteTrie struct {
+	nodes []byteNode
+}
+
+type byteNode struct {
+	edges []byteEdge
+	end   bool
+}
+
+type byteEdge struct {
+	b  byte
+	to int32
+}
+
+// child returns the child of node n reached by byte b, and false if it does not exist.
+func (t *byteTrie) child(n int32, b byte) (int32, bool) {
+	edges := t.nodes[n].edges
+	lo, hi := 0, len(edges)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if edges[mid].b < b {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < len(edges) && edges[lo].b == b {
+		return edges[lo].to, true
+	}
+	return 0, false
+}
+
+// insert adds string s, or its reverse, to the trie.
+func (t *byteTrie) insert(s string, reverse bool) {
+	var n int32
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+		if reverse {
+			b = s[len(s)-1-i]
+		}
+		next, ok := t.child(n, b)
+		if !ok {
+			next = int32(len(t.nodes))
+			t.nodes = append(t.nodes, byteNode{})
+			edges := t.nodes[n].edges
+			j := len(edges)
+			for j > 0 && edges[j-1].b > b {
+				j--
+			}
+			edges = append(edges, byteEdge{})
+			copy(edges[j+1:], edges[j:])
+			edges[j] = byteEdge{b: b, to: next}
+			t.nodes[n].edges = edges
+		}
+		n = next
+	}
+	t.nodes[n].end = true
+}
+
+// AffixTrie matches strings starting (or ending) with any of a set of prefixes (or suffixes).
+type AffixTrie struct {
+	byteTrie
+	suffix bool
+}
+
+// NewAffixTrie creates a trie matching strings with any of the given prefixes, or suffixes if suffix is set.
+func NewAffixTrie(suffix bool, values ...string) *AffixTrie {
+	t := &AffixTrie{suffix: suffix}
+	t.nodes = []byteNode{{}}
+	for _, v := range values {
+		t.insert(v, suffix)
+	}
+	return t
+}
+
+// Match checks whether s starts (or ends) with any value of the trie.
+func (t *AffixTrie) Match(s string) bool {
+	var n int32
+	for i := 0; i < len(s); i++ {
+		if t.nodes[n].end {
+			return true
+		}
+		b := s[i]
+		if t.suffix {
+			b = s[len(s)-1-i]
+		}
+		var ok bool
+		if n, ok = t.child(n, b); !ok {
+			return false
+		}
+	}
+	return t.nodes[n].end
+}
+
+// AhoCorasick is an Aho-Corasick automaton matching strings containing any of a set of values
+// in a single pass over the string.
+type AhoCorasick struct {
+	byteTrie
+	fail []int32
+}
+
+// NewAhoCorasick creates an automaton matching strings that contain any of the given values.
+func NewAhoCorasick(values ...string) *AhoCorasick {
+	ac := &AhoCorasick{}
+	ac.nodes = []byteNode{{}}
+	for _, v := range values {
+		ac.insert(v, false)
+	}
+	// compute failure links in breadth-first order, and propagate matches along them
+	ac.fail = make([]int32, len(ac.nodes))
+	queue := make([]int32, 0, len(ac.nodes))
+	for _, e := range ac.nodes[0].edges {
+		queue = append(queue, e.to)
+	}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, e := range ac.nodes[n].edges {
+			f := ac.fail[n]
+			for {
+				if to, ok := ac.child(f, e.b); ok {
+					ac.fail[e.to] = to
+					break
+				}
+				if f == 0 {
+					break
+				}
+				f = ac.fail[f]
+			}
+			ac.nodes[e.to].end = ac.nodes[e.to].end || ac.nodes[ac.fail[e.to]].end
+			queue = append(queue, e.to)
+		}
+	}
+	return ac
+}
+
+// Match checks whether s contains any value of the automaton.
+func (ac *AhoCorasick) Match(s string) bool {
+	var n int32
+	if ac.nodes[n].end {
+		return true
+	}
+	for i := 0; i < len(s); i++ {
+		for {
+			if to, ok := ac.child(n, s[i]); ok {
+				n = to
+				break
+			}
+			if n == 0 {
+				break
+			}
+			n = ac.fail[n]
+		}
+		if ac.nodes[n].end {
+			return true
+		}
+	}
+	return false
+}
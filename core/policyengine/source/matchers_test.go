@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package source implements a backend for policy compilers.
+package source
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMatcher(t *testing.T) {
+	lists := [][]string{
+		{"he", "she", "his", "hers"},
+		{"/bin/", "/usr/bin/", "/usr/local/bin/", "/sbin/", "/usr/sbin/"},
+		{".sh", ".py", ".tar.gz", ".gz", "bash"},
+		{"abcd", "bc", "bcde", "c", "xyz", "Nc -L"},
+		{"", "nothing", "matches", "else"},
+	}
+	inputs := []string{"", "he", "ushers", "hi", "HIS", "/usr/bin/python", "/usr/local/sbin/x", "/bin", "backup.tar.gz",
+		"script.SH", "/bin/bash", "abcde", "xbcy", "nc -l 4444", "c", "/tmp/xyz", "mattress", "elsewhere"}
+	for _, op := range []Operator{Eq, IEq, Contains, IContains, Startswith, IStartswith, Endswith, IEndswith} {
+		o, _ := StrOps{}.OpFunc(op)
+		for _, list := range lists {
+			match, err := NewListMatcher(list, op)
+			assert.NoError(t, err)
+			for _, s := range inputs {
+				expected := false
+				for _, v := range list {
+					expected = expected || o(s, v)
+				}
+				assert.Equal(t, expected, match(s), "%s %s %v", s, op, list)
+			}
+		}
+	}
+	_, err := NewListMatcher([]string{"1"}, Lt)
+	assert.Error(t, err)
+}
+
+func BenchmarkListMatcher(b *testing.B) {
+	list := make([]string, 5000)
+	for i := range list {
+		list[i] = fmt.Sprintf("ioc-%d.example.com", i)
+	}
+	s := "curl -s https://download.example.org/install.sh | sh"
+	for _, op := range []Operator{Eq, Contains, Startswith, Endswith} {
+		o, _ := StrOps{}.OpFunc(op)
+		b.Run("linear/"+op.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, v := range list {
+					if o(s, v) {
+						break
+					}
+				}
+			}
+		})
+		match, _ := NewListMatcher(list, op)
+		b.Run("compiled/"+op.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				match(s)
+			}
+		})
+	}
+}
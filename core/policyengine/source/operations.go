@@ -34,4 +34,49 @@ type Operations[R any] interface {
 	FoldAll(attr string, list []string, op Operator) (policy.Criterion[R], error)
 	// RegExp creates a criterion for a regular-expression predicate.
 	RegExp(attr string, re string) (policy.Criterion[R], error)
+	// Glob creates a criterion for a glob pattern predicate.
+	Glob(attr string, pattern string) (policy.Criterion[R], error)
+	// MapStr creates a function that retrieves the string value of an attribute.
+	MapStr(attr string) func(r R) string
+}
+
+// FieldKind denotes the value kind of a record attribute.
+type FieldKind int
+
+// FieldKind enumeration.
+const (
+	AnyKind FieldKind = iota
+	IntKind
+	StrKind
+	BoolKind
+)
+
+// Schema is an optional interface implemented by operations of sources whose record
+// attributes are known at compile time. Policy compilers use it to validate attribute references.
+type Schema interface {
+	// Field returns the kind of attribute attr, and false if attr is not a record attribute.
+	Field(attr string) (FieldKind, bool)
+}
+
+// NetOperations is an optional interface implemented by operations of sources supporting network predicates.
+type NetOperations[R any] interface {
+	// InCIDR creates a criterion for a predicate that holds if an IP address of attr belongs to any of a list
+	// of networks in CIDR notation.
+	InCIDR(attr string, cidrs []string) (policy.Criterion[R], error)
+}
+
+// LookupOperations is an optional interface implemented by operations of sources supporting lookup lists.
+type LookupOperations[R any] interface {
+	// FoldLookup creates a disjunctive criterion for a binary predicate over the values of a lookup list,
+	// which follows the values of the lookup when it is reloaded. Operator InCIDR matches the IP addresses
+	// of attr against the networks in the lookup.
+	FoldLookup(attr string, lookup *policy.Lookup, op Operator) (policy.Criterion[R], error)
+}
+
+// ScriptLoader is an optional interface implemented by operations of sources supporting scripted predicates
+// and actions.
+type ScriptLoader[R any] interface {
+	// LoadScripts loads the scripts found in path, exposes their predicates as record attributes, and
+	// returns the builders of the actions they declare.
+	LoadScripts(path string) (map[string]ActionBuilder[R], error)
 }
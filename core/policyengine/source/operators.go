@@ -44,10 +44,21 @@ const (
 	LEq
 	Gt
 	GEq
+	InCIDR
+	IsPrivate
+	IsLoopback
+	IsLinkLocal
 )
 
 func (s Operator) String() string {
-	return [...]string{"Eq", "IEq", "Contains", "IContains", "Startswith", "IStartswith", "Endswith", "IEndswith", "Lt", "LEq", "Gt", "GEq"}[s]
+	return [...]string{"Eq", "IEq", "Contains", "IContains", "Startswith", "IStartswith", "Endswith", "IEndswith", "Lt", "LEq", "Gt", "GEq", "InCIDR", "IsPrivate", "IsLoopback", "IsLinkLocal"}[s]
+}
+
+// IPClasses maps the IP address class operators to the networks of their classes.
+var IPClasses = map[Operator][]string{
+	IsPrivate:   {"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7"},
+	IsLoopback:  {"127.0.0.0/8", "::1/128"},
+	IsLinkLocal: {"169.254.0.0/16", "fe80::/10"},
 }
 
 // Operator function type.
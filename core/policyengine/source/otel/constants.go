@@ -18,8 +18,12 @@ package otel
 
 // Enrichment/tagging attribute names.
 const (
-	SF_PROCESSOR_TAGS  string = "sf.processor.tags"
-	SF_PROCESSOR_RULES string = "sf.processor.rules"
+	SF_PROCESSOR_TAGS       string = "sf.processor.tags"
+	SF_PROCESSOR_RULES      string = "sf.processor.rules"
+	SF_PROCESSOR_CORRELATED string = "sf.processor.correlated"
+	SF_PROCESSOR_COUNT      string = "sf.processor.count"
+	SF_PROCESSOR_OUTPUT     string = "sf.processor.output"
+	SF_PROCESSOR_SUPPRESSED string = "sf.processor.suppressed"
 )
 
 // Processor scope log name.
@@ -81,6 +81,50 @@ func (c *Contextualizer) GetTags(r *ResourceLogs) []string {
 	return nil
 }
 
+func (c *Contextualizer) AddCorrelatedIDs(logs *ResourceLogs, ids ...string) {
+	attrs := c.getOrCreateProcessorScopeAttributes(logs)
+	kvCorr := c.getOrCreateAttribute(attrs, SF_PROCESSOR_CORRELATED)
+	array := kvCorr.Value.Value.(*ArrayValue).ArrayValue
+	for _, id := range ids {
+		idValue := &otelcommon.AnyValue_StringValue{StringValue: id}
+		array.Values = append(array.Values, &otelcommon.AnyValue{Value: idValue})
+	}
+}
+
+func (c *Contextualizer) GetCorrelatedIDs(r *ResourceLogs) []string {
+	return nil
+}
+
+func (c *Contextualizer) SetCount(logs *ResourceLogs, rule string, count int) {
+	attrs := c.getOrCreateProcessorScopeAttributes(logs)
+	countValue := &otelcommon.AnyValue_IntValue{IntValue: int64(count)}
+	*attrs = append(*attrs, &otelcommon.KeyValue{Key: SF_PROCESSOR_COUNT + "." + rule, Value: &otelcommon.AnyValue{Value: countValue}})
+}
+
+func (c *Contextualizer) GetCount(r *ResourceLogs, rule string) int {
+	return 0
+}
+
+func (c *Contextualizer) SetSuppressed(logs *ResourceLogs, rule string, count int) {
+	attrs := c.getOrCreateProcessorScopeAttributes(logs)
+	countValue := &otelcommon.AnyValue_IntValue{IntValue: int64(count)}
+	*attrs = append(*attrs, &otelcommon.KeyValue{Key: SF_PROCESSOR_SUPPRESSED + "." + rule, Value: &otelcommon.AnyValue{Value: countValue}})
+}
+
+func (c *Contextualizer) GetSuppressed(r *ResourceLogs, rule string) int {
+	return 0
+}
+
+func (c *Contextualizer) SetOutput(logs *ResourceLogs, rule string, output string) {
+	attrs := c.getOrCreateProcessorScopeAttributes(logs)
+	outputValue := &otelcommon.AnyValue_StringValue{StringValue: output}
+	*attrs = append(*attrs, &otelcommon.KeyValue{Key: SF_PROCESSOR_OUTPUT + "." + rule, Value: &otelcommon.AnyValue{Value: outputValue}})
+}
+
+func (c *Contextualizer) GetOutput(r *ResourceLogs, rule string) string {
+	return ""
+}
+
 func (c *Contextualizer) getOrCreateProcessorScopeAttributes(logs *ResourceLogs) *[]*otelcommon.KeyValue {
 	for _, scopeLog := range logs.ScopeLogs {
 		if scopeLog.Scope != nil && scopeLog.Scope.Name == SF_PROCESSOR_SCOPE_NAME {
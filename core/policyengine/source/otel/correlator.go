@@ -0,0 +1,72 @@
+//
+// Copyright (C) 2024 IBM Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otel implements an open telemetry backend for the policy compilers.
+package otel
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/source"
+	"google.golang.org/protobuf/proto"
+)
+
+// Correlation key separator.
+const keySep = "\x1f"
+
+type Correlator struct{}
+
+func NewCorrelator() source.Correlator[*ResourceLogs] {
+	return &Correlator{}
+}
+
+// Key creates a function that computes a correlation key from a list of record attributes.
+// The key is empty if any of the attributes is missing from the record.
+func (c *Correlator) Key(attrs ...string) func(rl *ResourceLogs) string {
+	return func(rl *ResourceLogs) string {
+		allAttrs := getAllAttributes(rl)
+		values := make([]string, len(attrs))
+		for i, attr := range attrs {
+			if values[i] = getAttributeStr(allAttrs, attr); values[i] == "" {
+				return ""
+			}
+		}
+		return strings.Join(values, keySep)
+	}
+}
+
+// Timestamp returns the timestamp of a record in nanoseconds.
+func (c *Correlator) Timestamp(rl *ResourceLogs) int64 {
+	for _, sl := range rl.ScopeLogs {
+		for _, lr := range sl.LogRecords {
+			if lr.TimeUnixNano > 0 {
+				return int64(lr.TimeUnixNano)
+			}
+			return int64(lr.ObservedTimeUnixNano)
+		}
+	}
+	return 0
+}
+
+// ID returns an identifier of a record.
+func (c *Correlator) ID(rl *ResourceLogs) string {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(rl)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", xxhash.Sum64(b))
+}
@@ -17,6 +17,7 @@
 package otel
 
 import (
+	"fmt"
 	"regexp"
 
 	"github.com/pkg/errors"
@@ -59,7 +60,7 @@ func (ops *Operations) Exists(attr string) (policy.Criterion[*ResourceLogs], err
 		}
 		return false
 	}
-	return policy.Criterion[*ResourceLogs]{Pred: f}, nil
+	return policy.Leaf(source.LeafKey("Exists", attr), source.ExistsEstimate, f), nil
 }
 
 func (ops *Operations) compareHelper(lattr string, rattr string, op source.Operator, kvs []*KeyValue) bool {
@@ -101,7 +102,7 @@ func (ops *Operations) Compare(lattr string, rattr string, op source.Operator) (
 		return ops.compareHelper(lattr, rattr, op, allAttrs)
 	}
 
-	return policy.Criterion[*ResourceLogs]{Pred: f}, nil
+	return policy.Leaf(source.LeafKey(op.String(), lattr, rattr), op.Estimate(), f), nil
 }
 
 func (ops *Operations) FoldAny(attr string, list []string, op source.Operator) (policy.Criterion[*ResourceLogs], error) {
@@ -115,7 +116,7 @@ func (ops *Operations) FoldAny(attr string, list []string, op source.Operator) (
 		}
 		return false
 	}
-	return policy.Criterion[*ResourceLogs]{Pred: f}, nil
+	return policy.Leaf(source.LeafKey("FoldAny", append([]string{op.String(), attr}, list...)...), source.FoldEstimate(op, len(list), false), f), nil
 }
 
 func (ops *Operations) FoldAll(attr string, list []string, op source.Operator) (policy.Criterion[*ResourceLogs], error) {
@@ -129,7 +130,7 @@ func (ops *Operations) FoldAll(attr string, list []string, op source.Operator) (
 		}
 		return true
 	}
-	return policy.Criterion[*ResourceLogs]{Pred: f}, nil
+	return policy.Leaf(source.LeafKey("FoldAll", append([]string{op.String(), attr}, list...)...), source.FoldEstimate(op, len(list), true), f), nil
 }
 
 func (op *Operations) RegExp(attr string, re string) (policy.Criterion[*ResourceLogs], error) {
@@ -149,7 +150,38 @@ func (op *Operations) RegExp(attr string, re string) (policy.Criterion[*Resource
 			}
 			return false
 		}
-		return policy.Criterion[*ResourceLogs]{Pred: p}, nil
+		return policy.Leaf(source.LeafKey("RegExp", attr, re), source.RegExpEstimate, p), nil
 	}
 	return policy.False[*ResourceLogs](), errors.Errorf("could not compile regular expression %s", re)
 }
+
+// Glob creates a criterion for a glob pattern predicate.
+func (op *Operations) Glob(attr string, pattern string) (policy.Criterion[*ResourceLogs], error) {
+	re, err := source.CompileGlob(pattern)
+	if err != nil {
+		return policy.False[*ResourceLogs](), err
+	}
+	m := op.MapStr(attr)
+	p := func(r *ResourceLogs) bool { return re.MatchString(m(r)) }
+	return policy.Leaf(source.LeafKey("Glob", attr, pattern), source.GlobEstimate, p), nil
+}
+
+// MapStr creates a function that retrieves the string value of an attribute.
+func (ops *Operations) MapStr(attr string) func(rl *ResourceLogs) string {
+	return func(rl *ResourceLogs) string {
+		return getAttributeStr(getAllAttributes(rl), attr)
+	}
+}
+
+// getAttributeStr returns the string value of the first attribute with key attr.
+func getAttributeStr(kvs []*KeyValue, attr string) string {
+	for _, a := range kvs {
+		if a.Key == attr {
+			if sv, ok := a.Value.GetValue().(*StringValue); ok {
+				return sv.StringValue
+			}
+			return fmt.Sprintf("%v", a.Value.GetValue())
+		}
+	}
+	return ""
+}
@@ -27,6 +27,13 @@ type Prefilter[R any] interface {
 	IsApplicable(r R, rule policy.Rule[R]) bool
 }
 
+// PrefilterIndexer is an optional interface implemented by prefilters that select applicable rules by
+// comparing a single record attribute with the prefilter values of rules. It allows the engine to index
+// rules by prefilter value, including the values inferred from rule conditions.
+type PrefilterIndexer[R any] interface {
+	Value(r R) string
+}
+
 // DefaultPrefilter defines a prefilter object to be used as a default prefilter.
 type DefaultPrefilter[R any] struct{}
 
@@ -52,6 +52,11 @@ func main() { os.Exit(run()) }
 
 func run() int {
 
+	// run policy subcommand
+	if len(os.Args) > 1 && os.Args[1] == "policy" {
+		return runPolicy(os.Args[2:])
+	}
+
 	// setup interruption handler
 	initSigTerm()
 
@@ -71,7 +76,9 @@ func run() int {
 	flag.Usage = func() {
 		fmt.Println(`Usage: sfprocessor [-version
 		   |-test [-log <value>] [-config <value>] [-driverdir <value>] [-plugdir <value>]]
-		   |[-driver <value>] [-log <value>] [-perflog] [-config <value>] [-driverdir <value>] [-plugdir <value>] [-cpuprofile <value>] [-memprofile <value>] [-traceprofile <value>] path]`)
+		   |[-driver <value>] [-log <value>] [-perflog] [-config <value>] [-driverdir <value>] [-plugdir <value>] [-cpuprofile <value>] [-memprofile <value>] [-traceprofile <value>] path
+		   |policy lint [-language <value>] [-config <value>] [-actiondir <value>] [-scriptdir <value>] [-log <value>] path
+		   |policy test [-language <value>] [-config <value>] [-actiondir <value>] [-scriptdir <value>] [-log <value>] [-profile] -expect <value> path input]`)
 		fmt.Println()
 		fmt.Println("Positional arguments:")
 		fmt.Println("  path string\n\tInput path")
@@ -0,0 +1,215 @@
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/policy"
+)
+
+const (
+	policyUsage     = `Usage: sfprocessor policy {lint|test} [-h]`
+	policyLintUsage = `Usage: sfprocessor policy lint [-language <value>] [-config <value>] [-actiondir <value>] [-scriptdir <value>] [-log <value>] [-strict] path`
+	policyTestUsage = `Usage: sfprocessor policy test [-language <value>] [-config <value>] [-actiondir <value>] [-scriptdir <value>] [-log <value>] [-profile] -expect <value> path input`
+)
+
+// runPolicy runs the policy subcommands, which lint and test the policies found in a directory
+// without starting a pipeline. It returns a non-zero exit code if errors are found.
+func runPolicy(args []string) int {
+	if len(args) < 1 {
+		fmt.Println(policyUsage)
+		return 1
+	}
+	switch args[0] {
+	case "lint":
+		return runPolicyLint(args[1:])
+	case "test":
+		return runPolicyTest(args[1:])
+	}
+	fmt.Println(policyUsage)
+	return 1
+}
+
+// policyFlags defines the arguments shared by the policy subcommands.
+type policyFlags struct {
+	*flag.FlagSet
+	language   *string
+	configFile *string
+	actionDir  *string
+	scriptDir  *string
+	logLevel   *string
+}
+
+// newPolicyFlags creates the argument set of a policy subcommand; positional describes its positional arguments.
+func newPolicyFlags(name string, usage string, positional string) *policyFlags {
+	fs := &policyFlags{FlagSet: flag.NewFlagSet(name, flag.ContinueOnError)}
+	fs.language = fs.String("language", engine.Falco.String(), "Policy language {falco|sigma|mixed}")
+	fs.configFile = fs.String("config", "", "Path to Sigma configuration file")
+	fs.actionDir = fs.String("actiondir", "", "User-defined actions directory")
+	fs.scriptDir = fs.String("scriptdir", "", "Scripted predicates and actions directory")
+	fs.logLevel = fs.String("log", "quiet", "Log level {trace|info|warn|error|health|quiet}")
+	fs.Usage = func() {
+		fmt.Println(usage)
+		fmt.Println()
+		fmt.Println("Positional arguments:")
+		fmt.Println(positional)
+		fmt.Println()
+		fmt.Println("Arguments:")
+		fs.PrintDefaults()
+		fmt.Println()
+	}
+	return fs
+}
+
+// parse parses the arguments, checks that nargs positional arguments are set, and initializes the loggers.
+func (fs *policyFlags) parse(args []string, nargs int) bool {
+	if err := fs.Parse(args); err != nil {
+		return false
+	}
+	if fs.NArg() < nargs {
+		fs.Usage()
+		return false
+	}
+	logger.InitLoggers(logger.GetLogLevelFromValue(*fs.logLevel))
+	return true
+}
+
+// config creates a policy engine configuration for the policies found in path.
+func (fs *policyFlags) config(path string) (engine.Config, error) {
+	conf := map[string]interface{}{engine.PoliciesConfigKey: path, engine.LanguageKey: *fs.language, engine.ConfigKey: *fs.configFile}
+	if *fs.actionDir != "" {
+		conf[engine.ActionDirKey] = *fs.actionDir
+	}
+	if *fs.scriptDir != "" {
+		conf[engine.ScriptDirKey] = *fs.scriptDir
+	}
+	return engine.CreateConfig(conf)
+}
+
+// runPolicyLint lints the policies found in a directory.
+func runPolicyLint(args []string) int {
+	fs := newPolicyFlags("policy lint", policyLintUsage, "  path string\n\tPolicy directory")
+	strict := fs.Bool("strict", false, "Fail on warnings, such as duplicate rule names")
+	if !fs.parse(args, 1) {
+		return 1
+	}
+	conf, err := fs.config(fs.Arg(0))
+	if err != nil {
+		fmt.Println("Invalid policy engine configuration:", err.Error())
+		return 1
+	}
+	summary, err := policyengine.Lint(conf)
+	if err != nil {
+		fmt.Println("Unable to lint policies:", err.Error())
+		return 1
+	}
+	printSummary(summary)
+	if summary.Diagnostics.HasErrors() || *strict && summary.Diagnostics.Count(policy.SeverityWarning) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// runPolicyTest replays the records of a SysFlow trace or JSON-lines file through the policies found in a
+// directory, and compares the rules matched by each record with an expectations file.
+func runPolicyTest(args []string) int {
+	fs := newPolicyFlags("policy test", policyTestUsage, "  path string\n\tPolicy directory\n  input string\n\tSysFlow trace, or JSON-lines file (.json, .jsonl) of flat records")
+	expect := fs.String("expect", "", "Path to expectations file")
+	profile := fs.Bool("profile", false, "Print the evaluation profile of rules and filters")
+	if !fs.parse(args, 2) {
+		return 1
+	}
+	if *expect == "" {
+		fs.Usage()
+		return 1
+	}
+	conf, err := fs.config(fs.Arg(0))
+	if err != nil {
+		fmt.Println("Invalid policy engine configuration:", err.Error())
+		return 1
+	}
+	conf.Profile = *profile
+	exp, err := policyengine.LoadExpectations(*expect)
+	if err != nil {
+		fmt.Println("Unable to load expectations:", err.Error())
+		return 1
+	}
+	ch, wait, err := readRecords(fs.Arg(1))
+	if err != nil {
+		fmt.Println("Unable to read records:", err.Error())
+		return 1
+	}
+	report, err := policyengine.Replay(conf, ch, exp)
+	if err == nil || errors.Is(err, policyengine.ErrMissingRecords) {
+		// records are missing if reading stopped early, so reading errors are reported first
+		if rerr := wait(); rerr != nil {
+			err = rerr
+		}
+	}
+	if err != nil {
+		fmt.Println("Unable to replay records:", err.Error())
+		return 1
+	}
+	printReport(report)
+	if !report.Passed() {
+		return 1
+	}
+	return 0
+}
+
+// printSummary prints the diagnostics and definition counts of a policy summary.
+func printSummary(summary policy.Summary) {
+	for _, d := range summary.Diagnostics {
+		fmt.Println(d.Error())
+	}
+	if len(summary.Diagnostics) > 0 {
+		fmt.Println()
+	}
+	fmt.Printf("Rules: %d, Filters: %d, Macros: %d, Lists: %d\n", summary.Rules, summary.Filters, summary.Macros, summary.Lists)
+	fmt.Printf("Errors: %d, Warnings: %d\n", summary.Diagnostics.Count(policy.SeverityError), summary.Diagnostics.Count(policy.SeverityWarning))
+}
+
+// printReport prints the false positives and false negatives of a replay report, the actions that would
+// have been executed, and its profile if any.
+func printReport(report policyengine.Report) {
+	for _, a := range report.Actions {
+		fmt.Printf("record %d: rule %s would execute action %s\n", a.Record, a.Rule, a.Action)
+	}
+	for _, m := range report.FalsePositives {
+		fmt.Printf("record %d: unexpected match of rule %s\n", m.Record, m.Rule)
+	}
+	for _, m := range report.FalseNegatives {
+		fmt.Printf("record %d: expected match of rule %s\n", m.Record, m.Rule)
+	}
+	if len(report.Actions) > 0 || !report.Passed() {
+		fmt.Println()
+	}
+	fmt.Printf("Records: %d, False positives: %d, False negatives: %d\n", report.Records, len(report.FalsePositives), len(report.FalseNegatives))
+	if report.Profile != nil {
+		fmt.Println()
+		report.Profile.Log(log.New(os.Stdout, "", 0), 0)
+	}
+}
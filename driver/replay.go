@@ -0,0 +1,117 @@
+// Copyright (C) 2023 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/sysflow-telemetry/sf-apis/go/plugins"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/processor"
+	"github.com/sysflow-telemetry/sf-processor/driver/pipeline"
+	"github.com/sysflow-telemetry/sf-processor/driver/sysflow"
+)
+
+const (
+	replaySysFlowChan = "sysflow sysflowchan"
+	replayFlatChan    = "flat flattenerchan"
+	maxRecordLineSize = 16 * 1024 * 1024
+)
+
+// readRecords reads the flat records stored in path, which is either a SysFlow trace (or a directory of traces),
+// or a JSON-lines file (.json or .jsonl) of flat records. It returns the channel on which records are sent, and a
+// function that returns the first reading error once the channel is closed.
+func readRecords(path string) (interface{}, func() error, error) {
+	switch filepath.Ext(path) {
+	case ".json", ".jsonl":
+		return readJSONRecords(path)
+	default:
+		return readTrace(path)
+	}
+}
+
+// readTrace replays a SysFlow trace through the file driver and the flattener.
+func readTrace(path string) (interface{}, func() error, error) {
+	pl := pipeline.New("", "", "")
+	in, err := pl.GetChannel(replaySysFlowChan)
+	if err != nil {
+		return nil, nil, err
+	}
+	reader := processor.NewSysFlowReader()
+	if err := reader.Init(map[string]interface{}{pipeline.HdlConfig: "flattener"}); err != nil {
+		return nil, nil, err
+	}
+	out, err := pl.GetChannel(replayFlatChan)
+	if err != nil {
+		return nil, nil, err
+	}
+	reader.SetOutChan([]interface{}{out})
+	driver := sysflow.NewFileDriver()
+	if err := driver.Init(pl, map[string]interface{}{sysflow.OutChanConfig: replaySysFlowChan}); err != nil {
+		return nil, nil, err
+	}
+
+	// the reader closes the flattener channel once the driver closes the sysflow channel
+	go func() {
+		wg := new(sync.WaitGroup)
+		wg.Add(1)
+		reader.Process([]interface{}{in}, wg)
+		reader.Cleanup()
+	}()
+	errc := make(chan error, 1)
+	go func() {
+		running := true
+		err := driver.Run(path, &running)
+		if err != nil {
+			close(in.(*plugins.Channel[*sfgo.SysFlow]).In)
+		}
+		errc <- err
+	}()
+	return out, func() error { return <-errc }, nil
+}
+
+// readJSONRecords reads a file of JSON-encoded flat records, one record per line.
+func readJSONRecords(path string) (interface{}, func() error, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	out := &plugins.Channel[*sfgo.FlatRecord]{In: make(chan *sfgo.FlatRecord, pipeline.ChanSize)}
+	var readErr error
+	go func() {
+		defer f.Close()
+		defer close(out.In)
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxRecordLineSize)
+		for line := 1; scanner.Scan(); line++ {
+			fr := new(sfgo.FlatRecord)
+			if err := json.Unmarshal(scanner.Bytes(), fr); err != nil {
+				readErr = fmt.Errorf("%s:%d: %v", path, line, err)
+				return
+			}
+			out.In <- fr
+		}
+		readErr = scanner.Err()
+	}()
+	return out, func() error { return readErr }, nil
+}
@@ -8,17 +8,12 @@ fi
 
 DURATION=$1
 CONFIG=$2
-RULES=$3
-TRACES=$4
-OUTDIR=$5
+TRACES=$3
+OUTDIR=$4
 
 mkdir -p $OUTDIR
 
-n=0
-while [ "$n" -lt "$RULES" ]; do
-    export POLICYENGINE_BENCH_RULEINDEX=$n    
-    echo "Benchmarking rule at index $POLICYENGINE_BENCH_RULEINDEX"        
-    ( $tm $DURATION ../../driver/sfprocessor -perflog -log=quiet -config=$CONFIG -driver=file $TRACES > $OUTDIR/rate_rule_$POLICYENGINE_BENCH_RULEINDEX.out ) &
-    sleep 90
-    n=$(($n + 1))
-done
\ No newline at end of file
+export POLICYENGINE_PROFILE=true
+export POLICYENGINE_PROFILE_PATH=$(realpath $OUTDIR)/profile.json
+echo "Profiling rules in $POLICYENGINE_PROFILE_PATH"
+$tm $DURATION ../../driver/sfprocessor -log=info -config=$CONFIG -driver=file $TRACES > $OUTDIR/profile.out
@@ -13,10 +13,5 @@ OUTDIR=$4
 
 mkdir -p $OUTDIR
 
-for n in {1..10}
-do    
-    export POLICYENGINE_BENCH_RULESETSIZE=$(( $n*5 ))    
-    echo "Benchmarking with $POLICYENGINE_BENCH_RULESETSIZE rules"        
-    ( $tm $DURATION ../../driver/sfprocessor -perflog -log=quiet -config=$CONFIG -driver=file $TRACES > $OUTDIR/rate_$POLICYENGINE_BENCH_RULESETSIZE.out ) &
-    sleep 90
-done
\ No newline at end of file
+echo "Benchmarking policy engine rate"
+$tm $DURATION ../../driver/sfprocessor -perflog -log=quiet -config=$CONFIG -driver=file $TRACES > $OUTDIR/rate.out